@@ -16,19 +16,44 @@
 package arrow
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/magpierre/fyne-datatable/datatable"
 )
 
 // ArrowDataSource implements datatable.DataSource for Apache Arrow tables.
 type ArrowDataSource struct {
-	table  arrow.Table
+	table  arrow.Table // nil when created from a record
 	schema *arrow.Schema
-	reader *array.TableReader
 	record arrow.Record
+	cache  *rowCache      // nil when row caching is disabled
+	loc    *time.Location // display timezone override, nil to use the column's zone
+}
+
+// Config holds optional settings for an ArrowDataSource.
+type Config struct {
+	// CacheSize is the number of decoded rows kept in an LRU cache
+	// (0 = no caching).
+	CacheSize int
+
+	// Location overrides the timezone timestamps are displayed in. When nil,
+	// each timestamp column is shown in the zone its type declares, or UTC.
+	Location *time.Location
+}
+
+// DefaultConfig returns the default configuration: no row cache and
+// timestamps shown in their declared zones.
+func DefaultConfig() Config {
+	return Config{}
 }
 
 // NewFromArrowTable creates a DataSource from an Apache Arrow table.
@@ -47,52 +72,146 @@ func NewFromArrowTable(table arrow.Table) (*ArrowDataSource, error) {
 		return nil, fmt.Errorf("arrow table must have at least one column")
 	}
 
-	// Create reader to access records
-	reader := array.NewTableReader(table, table.NumRows())
-	reader.Retain()
-
-	// Read the first (and only) record containing all rows
-	if !reader.Next() {
-		reader.Release()
-		return nil, fmt.Errorf("failed to read arrow table record")
+	if err := checkTimestampZones(table.Schema()); err != nil {
+		return nil, err
 	}
 
-	record := reader.Record()
-	record.Retain()
+	record, err := tableRecord(table)
+	if err != nil {
+		return nil, err
+	}
 
 	return &ArrowDataSource{
 		table:  table,
 		schema: table.Schema(),
-		reader: reader,
 		record: record,
 	}, nil
 }
 
+// tableRecord returns a record holding every row of table. A column split
+// into several chunks is concatenated into one array; a single chunk is used
+// as is without copying.
+func tableRecord(table arrow.Table) (arrow.Record, error) {
+	cols := make([]arrow.Array, table.NumCols())
+	defer func() {
+		for _, col := range cols {
+			if col != nil {
+				col.Release()
+			}
+		}
+	}()
+
+	for i := range cols {
+		chunks := table.Column(i).Data().Chunks()
+		if len(chunks) == 1 {
+			chunks[0].Retain()
+			cols[i] = chunks[0]
+			continue
+		}
+		col, err := array.Concatenate(chunks, memory.DefaultAllocator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to combine chunks of column %q: %w", table.Schema().Field(i).Name, err)
+		}
+		cols[i] = col
+	}
+
+	// The record retains its columns; the deferred release drops ours
+	return array.NewRecord(table.Schema(), cols, table.NumRows()), nil
+}
+
+// NewFromRecord creates a DataSource serving the rows of a single Arrow
+// record, such as a batch read from a Flight stream, without wrapping it in
+// a Table. The record is retained and released by Release; the caller may
+// release its own reference once this returns.
+func NewFromRecord(rec arrow.Record) (*ArrowDataSource, error) {
+	if rec == nil {
+		return nil, fmt.Errorf("arrow record cannot be nil")
+	}
+
+	if rec.NumRows() == 0 {
+		return nil, fmt.Errorf("arrow record must have at least one row")
+	}
+
+	if rec.NumCols() == 0 {
+		return nil, fmt.Errorf("arrow record must have at least one column")
+	}
+
+	if err := checkTimestampZones(rec.Schema()); err != nil {
+		return nil, err
+	}
+
+	rec.Retain()
+
+	return &ArrowDataSource{
+		schema: rec.Schema(),
+		record: rec,
+	}, nil
+}
+
+// checkTimestampZones returns an error for the first timestamp column of
+// schema whose declared zone cannot be resolved.
+func checkTimestampZones(schema *arrow.Schema) error {
+	for _, field := range schema.Fields() {
+		if tsType, ok := field.Type.(*arrow.TimestampType); ok {
+			if _, err := tsType.GetZone(); err != nil {
+				return fmt.Errorf("column %q: %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// NewFromArrowTableWithCache creates a DataSource like NewFromArrowTable that
+// also keeps up to cacheSize decoded rows in an LRU cache, so repeated Row
+// calls for the same rows skip value extraction. A cacheSize of 0 disables
+// the cache.
+func NewFromArrowTableWithCache(table arrow.Table, cacheSize int) (*ArrowDataSource, error) {
+	config := DefaultConfig()
+	config.CacheSize = cacheSize
+	return NewFromArrowTableWithConfig(table, config)
+}
+
+// NewFromArrowTableWithConfig creates a DataSource from an Apache Arrow table
+// using the given configuration.
+func NewFromArrowTableWithConfig(table arrow.Table, config Config) (*ArrowDataSource, error) {
+	if config.CacheSize < 0 {
+		return nil, fmt.Errorf("cache size cannot be negative: %d", config.CacheSize)
+	}
+
+	ds, err := NewFromArrowTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.CacheSize > 0 {
+		ds.cache = newRowCache(config.CacheSize)
+	}
+	ds.loc = config.Location
+	return ds, nil
+}
+
 // Release releases the Arrow resources held by this DataSource.
 // This should be called when the DataSource is no longer needed.
 func (a *ArrowDataSource) Release() {
 	if a.record != nil {
 		a.record.Release()
 	}
-	if a.reader != nil {
-		a.reader.Release()
-	}
 }
 
 // ColumnCount returns the number of columns in the table.
 func (a *ArrowDataSource) ColumnCount() int {
-	return int(a.table.NumCols())
+	return int(a.record.NumCols())
 }
 
 // RowCount returns the number of rows in the table.
 func (a *ArrowDataSource) RowCount() int {
-	return int(a.table.NumRows())
+	return int(a.record.NumRows())
 }
 
 // ColumnName returns the name of the column at the given index.
 func (a *ArrowDataSource) ColumnName(col int) (string, error) {
-	if col < 0 || col >= int(a.table.NumCols()) {
-		return "", fmt.Errorf("column index %d out of range [0, %d)", col, a.table.NumCols())
+	if col < 0 || col >= int(a.record.NumCols()) {
+		return "", fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, a.record.NumCols())
 	}
 
 	return a.schema.Field(col).Name, nil
@@ -100,8 +219,8 @@ func (a *ArrowDataSource) ColumnName(col int) (string, error) {
 
 // ColumnType returns the datatable type of the column at the given index.
 func (a *ArrowDataSource) ColumnType(col int) (datatable.DataType, error) {
-	if col < 0 || col >= int(a.table.NumCols()) {
-		return datatable.TypeString, fmt.Errorf("column index %d out of range [0, %d)", col, a.table.NumCols())
+	if col < 0 || col >= int(a.record.NumCols()) {
+		return datatable.TypeString, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, a.record.NumCols())
 	}
 
 	arrowType := a.schema.Field(col).Type
@@ -110,37 +229,97 @@ func (a *ArrowDataSource) ColumnType(col int) (datatable.DataType, error) {
 
 // Cell returns the value of the cell at the given row and column.
 func (a *ArrowDataSource) Cell(row, col int) (datatable.Value, error) {
-	if row < 0 || row >= int(a.table.NumRows()) {
-		return datatable.Value{}, fmt.Errorf("row index %d out of range [0, %d)", row, a.table.NumRows())
+	if row < 0 || row >= int(a.record.NumRows()) {
+		return datatable.Value{}, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidRow, row, a.record.NumRows())
 	}
 
-	if col < 0 || col >= int(a.table.NumCols()) {
-		return datatable.Value{}, fmt.Errorf("column index %d out of range [0, %d)", col, a.table.NumCols())
+	if col < 0 || col >= int(a.record.NumCols()) {
+		return datatable.Value{}, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, a.record.NumCols())
 	}
 
 	column := a.record.Column(col)
-	return extractArrowValue(column, row)
+	return extractArrowValue(column, row, a.loc)
 }
 
 // Row returns all values in the given row.
 func (a *ArrowDataSource) Row(row int) ([]datatable.Value, error) {
-	if row < 0 || row >= int(a.table.NumRows()) {
-		return nil, fmt.Errorf("row index %d out of range [0, %d)", row, a.table.NumRows())
+	if row < 0 || row >= int(a.record.NumRows()) {
+		return nil, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidRow, row, a.record.NumRows())
+	}
+
+	if a.cache != nil {
+		if values, ok := a.cache.get(row); ok {
+			return values, nil
+		}
 	}
 
-	values := make([]datatable.Value, a.table.NumCols())
-	for col := 0; col < int(a.table.NumCols()); col++ {
+	values := make([]datatable.Value, a.record.NumCols())
+	for col := 0; col < int(a.record.NumCols()); col++ {
 		column := a.record.Column(col)
-		value, err := extractArrowValue(column, row)
+		value, err := extractArrowValue(column, row, a.loc)
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract value at row %d, col %d: %w", row, col, err)
 		}
 		values[col] = value
 	}
 
+	if a.cache != nil {
+		a.cache.put(row, values)
+	}
+
 	return values, nil
 }
 
+// Int64Values implements datatable.Int64ColumnSource for signed integer
+// columns and unsigned columns up to 32 bits. Int64 columns are returned
+// without copying.
+func (a *ArrowDataSource) Int64Values(col int) ([]int64, []bool, bool) {
+	if col < 0 || col >= int(a.record.NumCols()) {
+		return nil, nil, false
+	}
+
+	column := a.record.Column(col)
+	n := column.Len()
+
+	var values []int64
+	switch c := column.(type) {
+	case *array.Int64:
+		values = c.Int64Values()
+	case *array.Int32:
+		values = widenInts(c.Int32Values())
+	case *array.Int16:
+		values = widenInts(c.Int16Values())
+	case *array.Int8:
+		values = widenInts(c.Int8Values())
+	case *array.Uint32:
+		values = widenInts(c.Uint32Values())
+	case *array.Uint16:
+		values = widenInts(c.Uint16Values())
+	case *array.Uint8:
+		values = widenInts(c.Uint8Values())
+	default:
+		return nil, nil, false
+	}
+
+	if column.NullN() == 0 {
+		return values[:n], nil, true
+	}
+	valid := make([]bool, n)
+	for i := range valid {
+		valid[i] = column.IsValid(i)
+	}
+	return values[:n], valid, true
+}
+
+// widenInts converts integer values that always fit in int64.
+func widenInts[T int8 | int16 | int32 | uint8 | uint16 | uint32](in []T) []int64 {
+	out := make([]int64, len(in))
+	for i, v := range in {
+		out[i] = int64(v)
+	}
+	return out
+}
+
 // Metadata returns metadata for the data source (optional for Arrow).
 // Arrow tables don't have application-specific metadata, so this returns an empty Metadata.
 func (a *ArrowDataSource) Metadata() datatable.Metadata {
@@ -166,21 +345,31 @@ func mapArrowTypeToDataType(arrowType arrow.DataType) datatable.DataType {
 	case arrow.DATE32, arrow.DATE64:
 		return datatable.TypeDate
 
-	case arrow.TIMESTAMP, arrow.TIME32, arrow.TIME64:
+	case arrow.TIMESTAMP:
 		return datatable.TypeTimestamp
 
+	case arrow.TIME32, arrow.TIME64:
+		return datatable.TypeTime
+
+	case arrow.DURATION, arrow.INTERVAL_MONTHS, arrow.INTERVAL_DAY_TIME, arrow.INTERVAL_MONTH_DAY_NANO:
+		return datatable.TypeDuration
+
 	case arrow.DECIMAL128, arrow.DECIMAL256:
 		return datatable.TypeDecimal
 
 	case arrow.BINARY, arrow.LARGE_BINARY, arrow.FIXED_SIZE_BINARY:
 		return datatable.TypeBinary
 
-	case arrow.STRUCT:
+	case arrow.STRUCT, arrow.MAP:
 		return datatable.TypeStruct
 
 	case arrow.LIST, arrow.LARGE_LIST, arrow.FIXED_SIZE_LIST:
 		return datatable.TypeList
 
+	case arrow.DICTIONARY:
+		// Dictionary columns display as their decoded values
+		return mapArrowTypeToDataType(arrowType.(*arrow.DictionaryType).ValueType)
+
 	default:
 		// For unsupported types, default to string
 		return datatable.TypeString
@@ -188,7 +377,8 @@ func mapArrowTypeToDataType(arrowType arrow.DataType) datatable.DataType {
 }
 
 // extractArrowValue extracts a value from an Arrow column at the given index.
-func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
+// Timestamps are formatted in loc, or in their declared zone when loc is nil.
+func extractArrowValue(col arrow.Array, index int, loc *time.Location) (datatable.Value, error) {
 	// Check for null
 	if col.IsNull(index) {
 		return datatable.Value{
@@ -282,10 +472,14 @@ func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
 	case arrow.UINT64:
 		i := col.(*array.Uint64)
 		val := i.Value(index)
-		// Note: uint64 might overflow int64, but we'll cast for simplicity
+		// Keep values above MaxInt64 unsigned rather than wrapping negative
+		var raw any = int64(val)
+		if val > math.MaxInt64 {
+			raw = val
+		}
 		return datatable.Value{
 			IsNull:    false,
-			Raw:       int64(val),
+			Raw:       raw,
 			Formatted: fmt.Sprintf("%d", val),
 		}, nil
 
@@ -347,18 +541,79 @@ func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
 
 	case arrow.TIMESTAMP:
 		ts := col.(*array.Timestamp)
-		val := ts.Value(index)
-		t := val.ToTime(arrow.Nanosecond)
+		tsType := ts.DataType().(*arrow.TimestampType)
+		zone, err := tsType.GetZone()
+		if err != nil {
+			return datatable.Value{}, err
+		}
+		if loc != nil {
+			zone = loc
+		}
+		t := ts.Value(index).ToTime(tsType.Unit).In(zone)
 		return datatable.Value{
 			IsNull:    false,
 			Raw:       t,
-			Formatted: t.Format("2006-01-02 15:04:05"),
+			Formatted: formatTimestamp(t),
+		}, nil
+
+	case arrow.TIME32:
+		t := col.(*array.Time32)
+		unit := t.DataType().(*arrow.Time32Type).Unit
+		d := time.Duration(t.Value(index)) * unit.Multiplier()
+		return datatable.Value{
+			IsNull:    false,
+			Raw:       d,
+			Formatted: formatTimeOfDay(d, unit),
+		}, nil
+
+	case arrow.TIME64:
+		t := col.(*array.Time64)
+		unit := t.DataType().(*arrow.Time64Type).Unit
+		d := time.Duration(t.Value(index)) * unit.Multiplier()
+		return datatable.Value{
+			IsNull:    false,
+			Raw:       d,
+			Formatted: formatTimeOfDay(d, unit),
+		}, nil
+
+	case arrow.DURATION:
+		dur := col.(*array.Duration)
+		unit := dur.DataType().(*arrow.DurationType).Unit
+		d := time.Duration(dur.Value(index)) * unit.Multiplier()
+		return datatable.Value{
+			IsNull:    false,
+			Raw:       d,
+			Formatted: datatable.FormatDuration(d),
 		}, nil
 
+	case arrow.INTERVAL_MONTHS:
+		months := col.(*array.MonthInterval).Value(index)
+		return intervalValue(int64(months), 0), nil
+
+	case arrow.INTERVAL_DAY_TIME:
+		v := col.(*array.DayTimeInterval).Value(index)
+		d := time.Duration(v.Days)*24*time.Hour + time.Duration(v.Milliseconds)*time.Millisecond
+		return intervalValue(0, d), nil
+
+	case arrow.INTERVAL_MONTH_DAY_NANO:
+		v := col.(*array.MonthDayNanoInterval).Value(index)
+		d := time.Duration(v.Days)*24*time.Hour + time.Duration(v.Nanoseconds)
+		return intervalValue(int64(v.Months), d), nil
+
 	case arrow.DECIMAL128:
 		d := col.(*array.Decimal128)
-		val := d.Value(index)
-		str := val.BigInt().String()
+		scale := d.DataType().(*arrow.Decimal128Type).Scale
+		str := formatDecimal(d.Value(index).BigInt(), scale)
+		return datatable.Value{
+			IsNull:    false,
+			Raw:       str,
+			Formatted: str,
+		}, nil
+
+	case arrow.DECIMAL256:
+		d := col.(*array.Decimal256)
+		scale := d.DataType().(*arrow.Decimal256Type).Scale
+		str := formatDecimal(d.Value(index).BigInt(), scale)
 		return datatable.Value{
 			IsNull:    false,
 			Raw:       str,
@@ -395,6 +650,22 @@ func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
 			Formatted: string(b),
 		}, nil
 
+	case arrow.DICTIONARY:
+		d := col.(*array.Dictionary)
+		return extractArrowValue(d.Dictionary(), d.GetValueIndex(index), loc)
+
+	case arrow.MAP:
+		m := col.(*array.Map)
+		str, err := formatMapEntry(m, index, loc)
+		if err != nil {
+			return datatable.Value{}, fmt.Errorf("failed to marshal map: %w", err)
+		}
+		return datatable.Value{
+			IsNull:    false,
+			Raw:       str,
+			Formatted: str,
+		}, nil
+
 	case arrow.LIST:
 		l := col.(*array.List)
 		// Create a slice of the list for this row
@@ -409,7 +680,7 @@ func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
 			values := l.ListValues()
 			items := make([]string, 0, length)
 			for i := start; i < end && i < start+10; i++ {
-				val, err := extractArrowValue(values, i)
+				val, err := extractArrowValue(values, i, loc)
 				if err == nil {
 					items = append(items, val.Formatted)
 				}
@@ -435,3 +706,107 @@ func extractArrowValue(col arrow.Array, index int) (datatable.Value, error) {
 		}, nil
 	}
 }
+
+// formatTimestamp formats t in its own location, naming the zone unless it
+// is UTC.
+func formatTimestamp(t time.Time) string {
+	if t.Location() == time.UTC {
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return t.Format("2006-01-02 15:04:05 MST")
+}
+
+// daysPerMonth is the month length used to order intervals with months.
+const daysPerMonth = 30
+
+// intervalValue returns the value of an interval of months plus d. The
+// months are shown as "Nmo" before the rest, as in "1mo2d"; Raw holds the
+// whole interval as a time.Duration, counting a month as 30 days, so
+// intervals sort by length.
+func intervalValue(months int64, d time.Duration) datatable.Value {
+	formatted := datatable.FormatDuration(d)
+	if months != 0 {
+		formatted = strconv.FormatInt(months, 10) + "mo"
+		if d != 0 {
+			formatted += datatable.FormatDuration(d)
+		}
+	}
+	return datatable.Value{
+		IsNull:    false,
+		Raw:       time.Duration(months)*daysPerMonth*24*time.Hour + d,
+		Formatted: formatted,
+	}
+}
+
+// formatTimeOfDay formats a duration since midnight as a clock time, with
+// as many fractional digits as the unit carries.
+func formatTimeOfDay(d time.Duration, unit arrow.TimeUnit) string {
+	layout := "15:04:05"
+	switch unit {
+	case arrow.Millisecond:
+		layout = "15:04:05.000"
+	case arrow.Microsecond:
+		layout = "15:04:05.000000"
+	case arrow.Nanosecond:
+		layout = "15:04:05.000000000"
+	}
+	return time.Unix(0, 0).UTC().Add(d).Format(layout)
+}
+
+// formatDecimal places the decimal point in an unscaled integer according to
+// scale, without going through floating point. A negative scale appends zeros.
+func formatDecimal(unscaled *big.Int, scale int32) string {
+	digits := new(big.Int).Abs(unscaled).String()
+	sign := ""
+	if unscaled.Sign() < 0 {
+		sign = "-"
+	}
+
+	if scale <= 0 {
+		if unscaled.Sign() == 0 {
+			return "0"
+		}
+		return sign + digits + strings.Repeat("0", int(-scale))
+	}
+
+	if len(digits) <= int(scale) {
+		digits = strings.Repeat("0", int(scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// formatMapEntry renders the key/value pairs of one map row as a JSON object,
+// keeping the order in which the pairs are stored.
+func formatMapEntry(m *array.Map, index int, loc *time.Location) (string, error) {
+	keys := m.Keys()
+	items := m.Items()
+	start, end := m.ValueOffsets(index)
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i := int(start); i < int(end); i++ {
+		key, err := extractArrowValue(keys, i, loc)
+		if err != nil {
+			return "", err
+		}
+		keyJSON, err := json.Marshal(key.Formatted)
+		if err != nil {
+			return "", err
+		}
+		itemJSON, err := json.Marshal(items.GetOneForMarshal(i))
+		if err != nil {
+			return "", err
+		}
+
+		if i > int(start) {
+			sb.WriteByte(',')
+		}
+		sb.Write(keyJSON)
+		sb.WriteByte(':')
+		sb.Write(itemJSON)
+	}
+	sb.WriteByte('}')
+
+	return sb.String(), nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// createWideArrowTable builds a table with alternating string and float
+// columns, similar to what the widget renders.
+func createWideArrowTable(rows, cols int) arrow.Table {
+	pool := memory.NewGoAllocator()
+
+	fields := make([]arrow.Field, cols)
+	columns := make([]arrow.Column, cols)
+	for c := 0; c < cols; c++ {
+		var arr arrow.Array
+		if c%2 == 0 {
+			fields[c] = arrow.Field{Name: fmt.Sprintf("s%d", c), Type: arrow.BinaryTypes.String}
+			builder := array.NewStringBuilder(pool)
+			for r := 0; r < rows; r++ {
+				builder.Append(fmt.Sprintf("row %d col %d", r, c))
+			}
+			arr = builder.NewArray()
+			builder.Release()
+		} else {
+			fields[c] = arrow.Field{Name: fmt.Sprintf("f%d", c), Type: arrow.PrimitiveTypes.Float64}
+			builder := array.NewFloat64Builder(pool)
+			for r := 0; r < rows; r++ {
+				builder.Append(float64(r*c) / 3)
+			}
+			arr = builder.NewArray()
+			builder.Release()
+		}
+		chunked := arrow.NewChunked(fields[c].Type, []arrow.Array{arr})
+		arr.Release()
+		columns[c] = *arrow.NewColumn(fields[c], chunked)
+		chunked.Release()
+	}
+
+	return array.NewTable(arrow.NewSchema(fields, nil), columns, int64(rows))
+}
+
+// Benchmark repeated access to a visible window of rows, as during rendering
+func benchmarkRowAccess(b *testing.B, cacheSize int) {
+	table := createWideArrowTable(1000, 20)
+	defer table.Release()
+
+	source, err := NewFromArrowTableWithCache(table, cacheSize)
+	if err != nil {
+		b.Fatalf("NewFromArrowTableWithCache failed: %v", err)
+	}
+	defer source.Release()
+
+	const visibleRows = 50
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		source.Row(i % visibleRows)
+	}
+}
+
+func BenchmarkRow_Uncached(b *testing.B) {
+	benchmarkRowAccess(b, 0)
+}
+
+func BenchmarkRow_Cached(b *testing.B) {
+	benchmarkRowAccess(b, 64)
+}
@@ -15,13 +15,23 @@
 package arrow
 
 import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/decimal128"
+	"github.com/apache/arrow-go/v18/arrow/decimal256"
 	"github.com/apache/arrow-go/v18/arrow/memory"
+	memadapter "github.com/magpierre/fyne-datatable/adapters/memory"
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+	sortengine "github.com/magpierre/fyne-datatable/internal/sort"
 )
 
 // Helper function to create a test Arrow table with various types
@@ -175,6 +185,206 @@ func TestNewFromArrowTable_NilTable(t *testing.T) {
 	}
 }
 
+// Helper function to create a 4-row Arrow table whose columns are split
+// into two chunks of two rows each
+func createChunkedArrowTable() arrow.Table {
+	pool := memory.NewGoAllocator()
+
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "name", Type: arrow.BinaryTypes.String},
+			{Name: "age", Type: arrow.PrimitiveTypes.Int32},
+		},
+		nil,
+	)
+
+	nameBuilder := array.NewStringBuilder(pool)
+	defer nameBuilder.Release()
+	nameBuilder.AppendValues([]string{"Alice", "Bob"}, nil)
+	names1 := nameBuilder.NewArray()
+	defer names1.Release()
+	nameBuilder.AppendValues([]string{"Charlie", "Diana"}, nil)
+	names2 := nameBuilder.NewArray()
+	defer names2.Release()
+
+	ageBuilder := array.NewInt32Builder(pool)
+	defer ageBuilder.Release()
+	ageBuilder.AppendValues([]int32{30, 25}, nil)
+	ages1 := ageBuilder.NewArray()
+	defer ages1.Release()
+	ageBuilder.AppendValues([]int32{35, 28}, nil)
+	ages2 := ageBuilder.NewArray()
+	defer ages2.Release()
+
+	nameChunks := arrow.NewChunked(schema.Field(0).Type, []arrow.Array{names1, names2})
+	defer nameChunks.Release()
+	ageChunks := arrow.NewChunked(schema.Field(1).Type, []arrow.Array{ages1, ages2})
+	defer ageChunks.Release()
+
+	columns := []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), nameChunks),
+		*arrow.NewColumn(schema.Field(1), ageChunks),
+	}
+	return array.NewTable(schema, columns, 4)
+}
+
+func TestNewFromArrowTable_Chunked(t *testing.T) {
+	table := createChunkedArrowTable()
+	defer table.Release()
+
+	source, err := NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable returned error: %v", err)
+	}
+	defer source.Release()
+
+	if source.RowCount() != 4 {
+		t.Errorf("Expected 4 rows across both chunks, got %d", source.RowCount())
+	}
+
+	cell, err := source.Cell(3, 0)
+	if err != nil {
+		t.Fatalf("Cell(3, 0) returned error: %v", err)
+	}
+	if cell.Formatted != "Diana" {
+		t.Errorf("Expected last row name 'Diana', got %q", cell.Formatted)
+	}
+
+	row, err := source.Row(2)
+	if err != nil {
+		t.Fatalf("Row(2) returned error: %v", err)
+	}
+	if row[0].Formatted != "Charlie" || row[1].Formatted != "35" {
+		t.Errorf("Expected row 2 to be Charlie, 35, got %s, %s", row[0].Formatted, row[1].Formatted)
+	}
+}
+
+// Helper function to create an Arrow record with a null value
+func createTestArrowRecord(pool memory.Allocator) arrow.Record {
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "name", Type: arrow.BinaryTypes.String},
+			{Name: "age", Type: arrow.PrimitiveTypes.Int32},
+			{Name: "salary", Type: arrow.PrimitiveTypes.Float64, Nullable: true},
+		},
+		nil,
+	)
+
+	nameBuilder := array.NewStringBuilder(pool)
+	defer nameBuilder.Release()
+	nameBuilder.AppendValues([]string{"Alice", "Bob", "Charlie"}, nil)
+	nameArray := nameBuilder.NewArray()
+	defer nameArray.Release()
+
+	ageBuilder := array.NewInt32Builder(pool)
+	defer ageBuilder.Release()
+	ageBuilder.AppendValues([]int32{30, 25, 35}, nil)
+	ageArray := ageBuilder.NewArray()
+	defer ageArray.Release()
+
+	salaryBuilder := array.NewFloat64Builder(pool)
+	defer salaryBuilder.Release()
+	salaryBuilder.AppendValues([]float64{75000.50, 0, 85000.75}, []bool{true, false, true})
+	salaryArray := salaryBuilder.NewArray()
+	defer salaryArray.Release()
+
+	return array.NewRecord(schema, []arrow.Array{nameArray, ageArray, salaryArray}, 3)
+}
+
+func TestNewFromRecord(t *testing.T) {
+	record := createTestArrowRecord(memory.NewGoAllocator())
+	defer record.Release()
+
+	source, err := NewFromRecord(record)
+	if err != nil {
+		t.Fatalf("NewFromRecord() error = %v", err)
+	}
+	defer source.Release()
+
+	if source.table != nil {
+		t.Error("Expected no table for a record source")
+	}
+
+	table := array.NewTableFromRecords(source.schema, []arrow.Record{source.record})
+	defer table.Release()
+	fromTable, err := NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable() error = %v", err)
+	}
+	defer fromTable.Release()
+
+	if source.RowCount() != 3 || source.RowCount() != fromTable.RowCount() {
+		t.Errorf("RowCount() = %d, table path = %d, want 3", source.RowCount(), fromTable.RowCount())
+	}
+	if source.ColumnCount() != 3 || source.ColumnCount() != fromTable.ColumnCount() {
+		t.Errorf("ColumnCount() = %d, table path = %d, want 3", source.ColumnCount(), fromTable.ColumnCount())
+	}
+
+	for col := 0; col < source.ColumnCount(); col++ {
+		name, _ := source.ColumnName(col)
+		wantName, _ := fromTable.ColumnName(col)
+		colType, _ := source.ColumnType(col)
+		wantType, _ := fromTable.ColumnType(col)
+		if name != wantName || colType != wantType {
+			t.Errorf("column %d = %s %v, table path = %s %v", col, name, colType, wantName, wantType)
+		}
+	}
+
+	for row := 0; row < source.RowCount(); row++ {
+		got, err := source.Row(row)
+		if err != nil {
+			t.Fatalf("Row(%d) error = %v", row, err)
+		}
+		want, _ := fromTable.Row(row)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Row(%d) = %v, table path = %v", row, got, want)
+		}
+	}
+
+	if cell, _ := source.Cell(1, 2); !cell.IsNull {
+		t.Errorf("Cell(1, 2) = %v, want null", cell)
+	}
+	if _, err := source.Cell(3, 0); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("Cell(3, 0) error = %v, want ErrInvalidRow", err)
+	}
+	if err := datatable.ValidateSource(source); err != nil {
+		t.Errorf("ValidateSource() error = %v", err)
+	}
+}
+
+func TestNewFromRecord_Release(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	record := createTestArrowRecord(pool)
+	source, err := NewFromRecord(record)
+	if err != nil {
+		t.Fatalf("NewFromRecord() error = %v", err)
+	}
+
+	// The source keeps the record alive after the caller releases it
+	record.Release()
+	if cell, _ := source.Cell(2, 0); cell.Raw != "Charlie" {
+		t.Errorf("Cell(2, 0) = %v, want Charlie", cell.Raw)
+	}
+	source.Release()
+}
+
+func TestNewFromRecord_Invalid(t *testing.T) {
+	if _, err := NewFromRecord(nil); err == nil {
+		t.Error("Expected error for nil record")
+	}
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "x", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer builder.Release()
+	empty := builder.NewRecord()
+	defer empty.Release()
+	if _, err := NewFromRecord(empty); err == nil {
+		t.Error("Expected error for record without rows")
+	}
+}
+
 func TestColumnCount(t *testing.T) {
 	table := createTestArrowTable()
 	defer table.Release()
@@ -634,3 +844,792 @@ func TestFloatTypes(t *testing.T) {
 		t.Errorf("Float64 formatted value = %q, expected \"2.50\"", cell.Formatted)
 	}
 }
+
+func TestRow_Cached(t *testing.T) {
+	table := createTestArrowTable()
+	defer table.Release()
+
+	plain, _ := NewFromArrowTable(table)
+	defer plain.Release()
+
+	cached, err := NewFromArrowTableWithCache(table, 2)
+	if err != nil {
+		t.Fatalf("NewFromArrowTableWithCache returned error: %v", err)
+	}
+	defer cached.Release()
+
+	// Read each row twice so the second pass is served from the cache,
+	// including row 0 which is evicted and re-extracted
+	for pass := 0; pass < 2; pass++ {
+		for row := 0; row < plain.RowCount(); row++ {
+			want, _ := plain.Row(row)
+			got, err := cached.Row(row)
+			if err != nil {
+				t.Fatalf("Row(%d) returned error: %v", row, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("pass %d: Row(%d) = %v, expected %v", pass, row, got, want)
+			}
+		}
+	}
+
+	if n := cached.cache.len(); n != 2 {
+		t.Errorf("Expected cache to hold 2 rows, got %d", n)
+	}
+
+	// Modifying a returned row must not leak into the cache
+	row, _ := cached.Row(2)
+	row[0].Formatted = "changed"
+	again, _ := cached.Row(2)
+	if again[0].Formatted != "Charlie" {
+		t.Errorf("Cached row was modified: got %q, expected %q", again[0].Formatted, "Charlie")
+	}
+}
+
+func TestNewFromArrowTableWithCache_InvalidSize(t *testing.T) {
+	table := createTestArrowTable()
+	defer table.Release()
+
+	if _, err := NewFromArrowTableWithCache(table, -1); err == nil {
+		t.Error("Expected error for negative cache size")
+	}
+
+	source, err := NewFromArrowTableWithCache(table, 0)
+	if err != nil {
+		t.Fatalf("NewFromArrowTableWithCache(0) returned error: %v", err)
+	}
+	defer source.Release()
+	if source.cache != nil {
+		t.Error("Expected cache to be disabled for size 0")
+	}
+}
+
+// Helper function to create a table with a microsecond timestamp in New York
+func createZonedTimestampTable(instant time.Time) arrow.Table {
+	pool := memory.NewGoAllocator()
+
+	tsType := &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "America/New_York"}
+	schema := arrow.NewSchema([]arrow.Field{{Name: "ts", Type: tsType}}, nil)
+
+	tsBuilder := array.NewTimestampBuilder(pool, tsType)
+	tsBuilder.Append(arrow.Timestamp(instant.UnixMicro()))
+	tsArray := tsBuilder.NewArray()
+	defer tsArray.Release()
+
+	columns := []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(tsType, []arrow.Array{tsArray})),
+	}
+
+	return array.NewTable(schema, columns, 1)
+}
+
+func TestTimestampUnitAndZone(t *testing.T) {
+	instant := time.Date(2023, 7, 4, 16, 30, 15, 250000000, time.UTC)
+	table := createZonedTimestampTable(instant)
+	defer table.Release()
+
+	source, err := NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable returned error: %v", err)
+	}
+	defer source.Release()
+
+	cell, err := source.Cell(0, 0)
+	if err != nil {
+		t.Fatalf("Cell(0, 0) returned error: %v", err)
+	}
+
+	got, ok := cell.Raw.(time.Time)
+	if !ok {
+		t.Fatalf("Expected time.Time raw value, got %T", cell.Raw)
+	}
+	if !got.Equal(instant) {
+		t.Errorf("Timestamp instant = %v, expected %v", got, instant)
+	}
+	if got.Location().String() != "America/New_York" {
+		t.Errorf("Timestamp location = %v, expected America/New_York", got.Location())
+	}
+	if cell.Formatted != "2023-07-04 12:30:15 EDT" {
+		t.Errorf("Timestamp formatted value = %q, expected \"2023-07-04 12:30:15 EDT\"", cell.Formatted)
+	}
+}
+
+func TestTimestampLocationOverride(t *testing.T) {
+	instant := time.Date(2023, 7, 4, 16, 30, 15, 0, time.UTC)
+	table := createZonedTimestampTable(instant)
+	defer table.Release()
+
+	config := DefaultConfig()
+	config.Location = time.FixedZone("IST", 5*3600+1800)
+	source, err := NewFromArrowTableWithConfig(table, config)
+	if err != nil {
+		t.Fatalf("NewFromArrowTableWithConfig returned error: %v", err)
+	}
+	defer source.Release()
+
+	row, err := source.Row(0)
+	if err != nil {
+		t.Fatalf("Row(0) returned error: %v", err)
+	}
+	if !row[0].Raw.(time.Time).Equal(instant) {
+		t.Errorf("Timestamp instant = %v, expected %v", row[0].Raw, instant)
+	}
+	if row[0].Formatted != "2023-07-04 22:00:15 IST" {
+		t.Errorf("Timestamp formatted value = %q, expected \"2023-07-04 22:00:15 IST\"", row[0].Formatted)
+	}
+
+	// UTC override drops the zone name
+	config.Location = time.UTC
+	utcSource, _ := NewFromArrowTableWithConfig(table, config)
+	defer utcSource.Release()
+	cell, _ := utcSource.Cell(0, 0)
+	if cell.Formatted != "2023-07-04 16:30:15" {
+		t.Errorf("Timestamp formatted value = %q, expected \"2023-07-04 16:30:15\"", cell.Formatted)
+	}
+}
+
+func TestNewFromArrowTable_InvalidTimeZone(t *testing.T) {
+	pool := memory.NewGoAllocator()
+	tsType := &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Not/AZone"}
+	schema := arrow.NewSchema([]arrow.Field{{Name: "ts", Type: tsType}}, nil)
+
+	tsBuilder := array.NewTimestampBuilder(pool, tsType)
+	tsBuilder.Append(0)
+	tsArray := tsBuilder.NewArray()
+	defer tsArray.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(tsType, []arrow.Array{tsArray})),
+	}, 1)
+	defer table.Release()
+
+	if _, err := NewFromArrowTable(table); err == nil {
+		t.Error("Expected error for invalid timezone")
+	}
+}
+
+func TestTimeOfDayTypes(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	time64Type := arrow.FixedWidthTypes.Time64us.(*arrow.Time64Type)
+	time32Type := arrow.FixedWidthTypes.Time32ms.(*arrow.Time32Type)
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "time64", Type: time64Type, Nullable: true},
+			{Name: "time32", Type: time32Type},
+		},
+		nil,
+	)
+
+	clock := 13*time.Hour + 45*time.Minute + 30*time.Second
+
+	time64Builder := array.NewTime64Builder(pool, time64Type)
+	time64Builder.Append(arrow.Time64(clock / time.Microsecond))
+	time64Builder.Append(arrow.Time64((clock + 1500*time.Microsecond) / time.Microsecond))
+	time64Builder.AppendNull()
+	time64Array := time64Builder.NewArray()
+	defer time64Array.Release()
+
+	time32Builder := array.NewTime32Builder(pool, time32Type)
+	time32Builder.AppendValues([]arrow.Time32{
+		arrow.Time32(clock / time.Millisecond),
+		arrow.Time32((clock + 250*time.Millisecond) / time.Millisecond),
+		0,
+	}, nil)
+	time32Array := time32Builder.NewArray()
+	defer time32Array.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(time64Type, []arrow.Array{time64Array})),
+		*arrow.NewColumn(schema.Field(1), arrow.NewChunked(time32Type, []arrow.Array{time32Array})),
+	}, 3)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	colType, _ := source.ColumnType(0)
+	if colType != datatable.TypeTime {
+		t.Errorf("Expected TypeTime for time64, got %v", colType)
+	}
+
+	cell, err := source.Cell(0, 0)
+	if err != nil {
+		t.Fatalf("Cell(0, 0) returned error: %v", err)
+	}
+	if cell.Raw != clock {
+		t.Errorf("Time64 raw value = %v, expected %v", cell.Raw, clock)
+	}
+	if cell.Formatted != "13:45:30.000000" {
+		t.Errorf("Time64 formatted value = %q, expected \"13:45:30.000000\"", cell.Formatted)
+	}
+
+	tests := []struct {
+		row, col int
+		expected string
+	}{
+		{1, 0, "13:45:30.001500"},
+		{0, 1, "13:45:30.000"},
+		{1, 1, "13:45:30.250"},
+		{2, 1, "00:00:00.000"},
+	}
+	for _, tt := range tests {
+		cell, _ := source.Cell(tt.row, tt.col)
+		if cell.Formatted != tt.expected {
+			t.Errorf("Cell(%d, %d).Formatted = %q, expected %q", tt.row, tt.col, cell.Formatted, tt.expected)
+		}
+	}
+
+	cell, _ = source.Cell(2, 0)
+	if !cell.IsNull {
+		t.Error("Expected null time64 value")
+	}
+}
+
+func TestFormatTimeOfDay(t *testing.T) {
+	clock := 13*time.Hour + 45*time.Minute + 30*time.Second
+	if got := formatTimeOfDay(clock, arrow.Second); got != "13:45:30" {
+		t.Errorf("formatTimeOfDay() = %q, expected \"13:45:30\"", got)
+	}
+	if got := formatTimeOfDay(clock+5, arrow.Nanosecond); got != "13:45:30.000000005" {
+		t.Errorf("formatTimeOfDay() = %q, expected \"13:45:30.000000005\"", got)
+	}
+}
+
+func TestDecimalTypes(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	dec128Type := &arrow.Decimal128Type{Precision: 10, Scale: 2}
+	dec256Type := &arrow.Decimal256Type{Precision: 40, Scale: 4}
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "price", Type: dec128Type},
+			{Name: "rate", Type: dec256Type},
+		},
+		nil,
+	)
+
+	dec128Builder := array.NewDecimal128Builder(pool, dec128Type)
+	dec128Builder.AppendValues([]decimal128.Num{
+		decimal128.FromI64(12345),
+		decimal128.FromI64(-5),
+		decimal128.FromI64(100),
+	}, nil)
+	dec128Array := dec128Builder.NewArray()
+	defer dec128Array.Release()
+
+	// Wider than float64 can represent exactly
+	big256, _ := decimal256.FromString("123456789012345678901234.5678", 40, 4)
+	dec256Builder := array.NewDecimal256Builder(pool, dec256Type)
+	dec256Builder.AppendValues([]decimal256.Num{
+		decimal256.FromI64(12345),
+		decimal256.FromI64(-7),
+		big256,
+	}, nil)
+	dec256Array := dec256Builder.NewArray()
+	defer dec256Array.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(dec128Type, []arrow.Array{dec128Array})),
+		*arrow.NewColumn(schema.Field(1), arrow.NewChunked(dec256Type, []arrow.Array{dec256Array})),
+	}, 3)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	for col := 0; col < 2; col++ {
+		colType, _ := source.ColumnType(col)
+		if colType != datatable.TypeDecimal {
+			t.Errorf("Expected TypeDecimal for column %d, got %v", col, colType)
+		}
+	}
+
+	tests := []struct {
+		row, col int
+		expected string
+	}{
+		{0, 0, "123.45"},
+		{1, 0, "-0.05"},
+		{2, 0, "1.00"},
+		{0, 1, "1.2345"},
+		{1, 1, "-0.0007"},
+		{2, 1, "123456789012345678901234.5678"},
+	}
+	for _, tt := range tests {
+		cell, err := source.Cell(tt.row, tt.col)
+		if err != nil {
+			t.Fatalf("Cell(%d, %d) returned error: %v", tt.row, tt.col, err)
+		}
+		if cell.Formatted != tt.expected {
+			t.Errorf("Cell(%d, %d).Formatted = %q, expected %q", tt.row, tt.col, cell.Formatted, tt.expected)
+		}
+	}
+}
+
+func TestFormatDecimal(t *testing.T) {
+	tests := []struct {
+		unscaled int64
+		scale    int32
+		expected string
+	}{
+		{12345, 2, "123.45"},
+		{12345, 4, "1.2345"},
+		{5, 4, "0.0005"},
+		{-12345, 4, "-1.2345"},
+		{0, 2, "0.00"},
+		{12, 0, "12"},
+		{12, -2, "1200"},
+	}
+	for _, tt := range tests {
+		got := formatDecimal(big.NewInt(tt.unscaled), tt.scale)
+		if got != tt.expected {
+			t.Errorf("formatDecimal(%d, %d) = %q, expected %q", tt.unscaled, tt.scale, got, tt.expected)
+		}
+	}
+}
+
+func TestUint64Overflow(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "id", Type: arrow.PrimitiveTypes.Uint64}}, nil)
+
+	builder := array.NewUint64Builder(pool)
+	builder.AppendValues([]uint64{math.MaxUint64, math.MaxInt64, 42}, nil)
+	uint64Array := builder.NewArray()
+	defer uint64Array.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(schema.Field(0).Type, []arrow.Array{uint64Array})),
+	}, 3)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	tests := []struct {
+		row       int
+		raw       any
+		formatted string
+	}{
+		{0, uint64(math.MaxUint64), "18446744073709551615"},
+		{1, int64(math.MaxInt64), "9223372036854775807"},
+		{2, int64(42), "42"},
+	}
+	for _, tt := range tests {
+		cell, err := source.Cell(tt.row, 0)
+		if err != nil {
+			t.Fatalf("Cell(%d, 0) returned error: %v", tt.row, err)
+		}
+		if cell.Raw != tt.raw {
+			t.Errorf("Cell(%d, 0).Raw = %v (%T), expected %v (%T)", tt.row, cell.Raw, cell.Raw, tt.raw, tt.raw)
+		}
+		if cell.Formatted != tt.formatted {
+			t.Errorf("Cell(%d, 0).Formatted = %q, expected %q", tt.row, cell.Formatted, tt.formatted)
+		}
+	}
+}
+
+func TestMapType(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	mapType := arrow.MapOf(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Int64)
+	schema := arrow.NewSchema([]arrow.Field{{Name: "counts", Type: mapType, Nullable: true}}, nil)
+
+	builder := array.NewMapBuilderWithType(pool, mapType)
+	defer builder.Release()
+	keys := builder.KeyBuilder().(*array.StringBuilder)
+	items := builder.ItemBuilder().(*array.Int64Builder)
+
+	builder.Append(true)
+	keys.AppendValues([]string{"apples", "pears"}, nil)
+	items.AppendValues([]int64{3, 5}, nil)
+
+	builder.Append(true)
+	keys.Append("plums")
+	items.AppendNull()
+
+	builder.Append(true) // empty map
+
+	builder.AppendNull()
+
+	mapArray := builder.NewArray()
+	defer mapArray.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(mapType, []arrow.Array{mapArray})),
+	}, 4)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	colType, _ := source.ColumnType(0)
+	if colType != datatable.TypeStruct {
+		t.Errorf("Expected TypeStruct for map, got %v", colType)
+	}
+
+	expected := []string{
+		`{"apples":3,"pears":5}`,
+		`{"plums":null}`,
+		`{}`,
+	}
+	for row, want := range expected {
+		cell, err := source.Cell(row, 0)
+		if err != nil {
+			t.Fatalf("Cell(%d, 0) returned error: %v", row, err)
+		}
+		if cell.Formatted != want {
+			t.Errorf("Cell(%d, 0).Formatted = %q, expected %q", row, cell.Formatted, want)
+		}
+	}
+
+	cell, _ := source.Cell(3, 0)
+	if !cell.IsNull {
+		t.Error("Expected null map value")
+	}
+}
+
+func TestDictionaryType(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	dictType := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+	schema := arrow.NewSchema([]arrow.Field{{Name: "status", Type: dictType, Nullable: true}}, nil)
+
+	builder := array.NewDictionaryBuilder(pool, dictType).(*array.BinaryDictionaryBuilder)
+	defer builder.Release()
+	for _, status := range []string{"open", "closed", "open"} {
+		if err := builder.AppendString(status); err != nil {
+			t.Fatalf("AppendString(%q) returned error: %v", status, err)
+		}
+	}
+	builder.AppendNull()
+
+	dictArray := builder.NewArray()
+	defer dictArray.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(dictType, []arrow.Array{dictArray})),
+	}, 4)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	colType, _ := source.ColumnType(0)
+	if colType != datatable.TypeString {
+		t.Errorf("Expected TypeString for dictionary of strings, got %v", colType)
+	}
+
+	for row, want := range []string{"open", "closed", "open"} {
+		cell, err := source.Cell(row, 0)
+		if err != nil {
+			t.Fatalf("Cell(%d, 0) returned error: %v", row, err)
+		}
+		if cell.Raw != want || cell.Formatted != want {
+			t.Errorf("Cell(%d, 0) = %v/%q, expected %q", row, cell.Raw, cell.Formatted, want)
+		}
+	}
+
+	cell, _ := source.Cell(3, 0)
+	if !cell.IsNull {
+		t.Error("Expected null dictionary value")
+	}
+}
+
+func TestInt64Values(t *testing.T) {
+	table := createTestArrowTable()
+	defer table.Release()
+	source, err := NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable() error = %v", err)
+	}
+	defer source.Release()
+
+	// Int32 column is widened, no nulls
+	values, valid, ok := source.Int64Values(1)
+	if !ok {
+		t.Fatal("Int64Values(age) ok = false")
+	}
+	if valid != nil {
+		t.Errorf("Int64Values(age) valid = %v, want nil", valid)
+	}
+	want := []int64{30, 25, 35}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("Int64Values(age) = %v, want %v", values, want)
+			break
+		}
+	}
+
+	// Non-integer and out-of-range columns have no fast path
+	for _, col := range []int{0, 2, 3, -1, 4} {
+		if _, _, ok := source.Int64Values(col); ok {
+			t.Errorf("Int64Values(%d) ok = true, want false", col)
+		}
+	}
+
+	nullable := createNullableArrowTable()
+	defer nullable.Release()
+	nullSource, err := NewFromArrowTable(nullable)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable() error = %v", err)
+	}
+	defer nullSource.Release()
+
+	_, valid, ok = nullSource.Int64Values(1)
+	if !ok {
+		t.Fatal("Int64Values(nullable age) ok = false")
+	}
+	if len(valid) != 2 || valid[0] || !valid[1] {
+		t.Errorf("Int64Values(nullable age) valid = %v, want [false true]", valid)
+	}
+}
+
+func TestToArrowTable_RoundTrip(t *testing.T) {
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	instant := time.Date(2024, 3, 20, 14, 5, 6, 789000000, time.UTC)
+
+	columnNames := []string{"name", "age", "score", "active", "joined", "seen"}
+	columnTypes := []datatable.DataType{
+		datatable.TypeString, datatable.TypeInt, datatable.TypeFloat,
+		datatable.TypeBool, datatable.TypeDate, datatable.TypeTimestamp,
+	}
+	data := [][]datatable.Value{
+		{
+			datatable.NewValue("Alice", datatable.TypeString),
+			datatable.NewValue(int64(30), datatable.TypeInt),
+			datatable.NewValue(1.5, datatable.TypeFloat),
+			datatable.NewValue(true, datatable.TypeBool),
+			datatable.NewValue(day, datatable.TypeDate),
+			datatable.NewValue(instant, datatable.TypeTimestamp),
+		},
+		{
+			datatable.NewNullValue(datatable.TypeString),
+			datatable.NewNullValue(datatable.TypeInt),
+			datatable.NewNullValue(datatable.TypeFloat),
+			datatable.NewNullValue(datatable.TypeBool),
+			datatable.NewNullValue(datatable.TypeDate),
+			datatable.NewNullValue(datatable.TypeTimestamp),
+		},
+	}
+
+	source, err := memadapter.NewDataSourceFromValues(data, columnNames, columnTypes)
+	if err != nil {
+		t.Fatalf("NewDataSourceFromValues() error = %v", err)
+	}
+
+	table, err := datatable.ToArrowTable(source, memory.NewGoAllocator())
+	if err != nil {
+		t.Fatalf("ToArrowTable() error = %v", err)
+	}
+	defer table.Release()
+
+	roundTrip, err := NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable() error = %v", err)
+	}
+	defer roundTrip.Release()
+
+	for col, name := range columnNames {
+		gotName, _ := roundTrip.ColumnName(col)
+		gotType, _ := roundTrip.ColumnType(col)
+		if gotName != name || gotType != columnTypes[col] {
+			t.Errorf("column %d = %q %v, want %q %v", col, gotName, gotType, name, columnTypes[col])
+		}
+
+		for row := range data {
+			want := data[row][col]
+			got, err := roundTrip.Cell(row, col)
+			if err != nil {
+				t.Fatalf("Cell(%d, %d) error = %v", row, col, err)
+			}
+			if got.IsNull != want.IsNull {
+				t.Errorf("Cell(%d, %d).IsNull = %v, want %v", row, col, got.IsNull, want.IsNull)
+				continue
+			}
+			if want.IsNull {
+				continue
+			}
+
+			if wantTime, ok := want.Raw.(time.Time); ok {
+				if gotTime, ok := got.Raw.(time.Time); !ok || !gotTime.Equal(wantTime) {
+					t.Errorf("Cell(%d, %d).Raw = %v, want %v", row, col, got.Raw, wantTime)
+				}
+			} else if got.Raw != want.Raw {
+				t.Errorf("Cell(%d, %d).Raw = %v, want %v", row, col, got.Raw, want.Raw)
+			}
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	table := createNullableArrowTable()
+	defer table.Release()
+
+	datatabletest.RunConformance(t, func() datatable.DataSource {
+		source, err := NewFromArrowTable(table)
+		if err != nil {
+			t.Fatalf("NewFromArrowTable() error = %v", err)
+		}
+		return source
+	})
+}
+
+func TestDurationTypes(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	durationType := arrow.FixedWidthTypes.Duration_ms.(*arrow.DurationType)
+	intervalType := arrow.FixedWidthTypes.MonthDayNanoInterval
+	schema := arrow.NewSchema(
+		[]arrow.Field{
+			{Name: "duration", Type: durationType, Nullable: true},
+			{Name: "interval", Type: intervalType},
+		},
+		nil,
+	)
+
+	durationBuilder := array.NewDurationBuilder(pool, durationType)
+	durationBuilder.AppendValues([]arrow.Duration{
+		arrow.Duration((90 * time.Minute) / time.Millisecond),
+		arrow.Duration((45 * time.Second) / time.Millisecond),
+		arrow.Duration((26 * time.Hour) / time.Millisecond),
+		0,
+	}, []bool{true, true, true, false})
+	durationArray := durationBuilder.NewArray()
+	defer durationArray.Release()
+
+	intervalBuilder := array.NewMonthDayNanoIntervalBuilder(pool)
+	intervalBuilder.AppendValues([]arrow.MonthDayNanoInterval{
+		{Months: 1, Days: 2},
+		{Days: 3, Nanoseconds: int64(90 * time.Minute)},
+		{Months: 2},
+		{},
+	}, nil)
+	intervalArray := intervalBuilder.NewArray()
+	defer intervalArray.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(durationType, []arrow.Array{durationArray})),
+		*arrow.NewColumn(schema.Field(1), arrow.NewChunked(intervalType, []arrow.Array{intervalArray})),
+	}, 4)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	for col := 0; col < 2; col++ {
+		if colType, _ := source.ColumnType(col); colType != datatable.TypeDuration {
+			t.Errorf("Expected TypeDuration for column %d, got %v", col, colType)
+		}
+	}
+
+	cell, err := source.Cell(0, 0)
+	if err != nil {
+		t.Fatalf("Cell(0, 0) returned error: %v", err)
+	}
+	if cell.Raw != 90*time.Minute {
+		t.Errorf("Duration raw value = %v, expected %v", cell.Raw, 90*time.Minute)
+	}
+
+	tests := []struct {
+		row, col int
+		expected string
+	}{
+		{0, 0, "1h30m"},
+		{1, 0, "45s"},
+		{2, 0, "1d2h"},
+		{0, 1, "1mo2d"},
+		{1, 1, "3d1h30m"},
+		{2, 1, "2mo"},
+		{3, 1, "0s"},
+	}
+	for _, tt := range tests {
+		cell, _ := source.Cell(tt.row, tt.col)
+		if cell.Formatted != tt.expected {
+			t.Errorf("Cell(%d, %d).Formatted = %q, expected %q", tt.row, tt.col, cell.Formatted, tt.expected)
+		}
+	}
+
+	cell, _ = source.Cell(3, 0)
+	if !cell.IsNull {
+		t.Error("Expected null duration value")
+	}
+
+	// Durations sort by length, not by their formatted text
+	engine := sortengine.NewEngine()
+	sortTests := []struct {
+		col      int
+		expected []string
+	}{
+		{0, []string{"45s", "1h30m", "1d2h", ""}},
+		{1, []string{"0s", "3d1h30m", "1mo2d", "2mo"}},
+	}
+	for _, tt := range sortTests {
+		sorted, err := engine.Sort(source, []int{0, 1, 2, 3}, sortengine.SortSpec{
+			Column:    tt.col,
+			Direction: datatable.SortAscending,
+			DataType:  datatable.TypeDuration,
+		})
+		if err != nil {
+			t.Fatalf("Sort(%d) returned error: %v", tt.col, err)
+		}
+		for i, row := range sorted {
+			cell, _ := source.Cell(row, tt.col)
+			if cell.Formatted != tt.expected[i] {
+				t.Errorf("column %d sorted row %d = %q, expected %q", tt.col, i, cell.Formatted, tt.expected[i])
+			}
+		}
+	}
+}
+
+func TestFilterNumericColumn(t *testing.T) {
+	pool := memory.NewGoAllocator()
+
+	schema := arrow.NewSchema([]arrow.Field{{Name: "Age", Type: arrow.PrimitiveTypes.Int64}}, nil)
+	builder := array.NewInt64Builder(pool)
+	builder.AppendValues([]int64{9, 30}, nil)
+	ages := builder.NewArray()
+	defer ages.Release()
+
+	table := array.NewTable(schema, []arrow.Column{
+		*arrow.NewColumn(schema.Field(0), arrow.NewChunked(arrow.PrimitiveTypes.Int64, []arrow.Array{ages})),
+	}, 2)
+	defer table.Release()
+
+	source, _ := NewFromArrowTable(table)
+	defer source.Release()
+
+	// "9" > "28" as text; the column type must make this a numeric compare
+	ageFilter := &filter.SimpleFilter{Column: "Age", Operator: filter.OpGreaterThan, Value: "28"}
+
+	prepared, err := filter.Prepare(source, ageFilter)
+	if err != nil {
+		t.Fatalf("Prepare returned error: %v", err)
+	}
+	indices, err := prepared.Apply(source)
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if !reflect.DeepEqual(indices, []int{1}) {
+		t.Errorf("Prepared filter kept rows %v, expected [1]", indices)
+	}
+
+	composite := &filter.CompositeFilter{Filters: []datatable.Filter{ageFilter}, Logic: filter.LogicAND}
+	for _, f := range []datatable.Filter{ageFilter, composite} {
+		model, err := datatable.NewTableModel(source)
+		if err != nil {
+			t.Fatalf("NewTableModel returned error: %v", err)
+		}
+		if err := model.SetFilter(f); err != nil {
+			t.Fatalf("SetFilter(%s) returned error: %v", f.Description(), err)
+		}
+		if model.VisibleRowCount() != 1 {
+			t.Fatalf("SetFilter(%s) kept %d rows, expected 1", f.Description(), model.VisibleRowCount())
+		}
+		cell, _ := model.VisibleCell(0, 0)
+		if cell.Formatted != "30" {
+			t.Errorf("SetFilter(%s) kept %q, expected \"30\"", f.Description(), cell.Formatted)
+		}
+	}
+}
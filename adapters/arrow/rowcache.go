@@ -0,0 +1,94 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrow
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// rowCache is a fixed-size LRU cache of decoded rows keyed by row index.
+// Entries are never invalidated because the underlying Arrow table is
+// immutable for the lifetime of the DataSource.
+type rowCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // front is most recently used
+	entries  map[int]*list.Element
+}
+
+// rowCacheEntry is the payload stored in each list element.
+type rowCacheEntry struct {
+	row    int
+	values []datatable.Value
+}
+
+// newRowCache creates a cache holding at most capacity rows.
+func newRowCache(capacity int) *rowCache {
+	return &rowCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[int]*list.Element, capacity),
+	}
+}
+
+// get returns a copy of the cached values for row, if present.
+func (c *rowCache) get(row int) ([]datatable.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[row]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return copyValues(elem.Value.(*rowCacheEntry).values), true
+}
+
+// put stores a copy of values for row, evicting the least recently used
+// row when the cache is full.
+func (c *rowCache) put(row int, values []datatable.Value) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[row]; ok {
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*rowCacheEntry).row)
+	}
+
+	c.entries[row] = c.order.PushFront(&rowCacheEntry{row: row, values: copyValues(values)})
+}
+
+// len returns the number of cached rows.
+func (c *rowCache) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// copyValues returns a shallow copy so callers cannot modify cached rows.
+func copyValues(values []datatable.Value) []datatable.Value {
+	result := make([]datatable.Value, len(values))
+	copy(result, values)
+	return result
+}
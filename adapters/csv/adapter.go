@@ -16,13 +16,18 @@
 package csv
 
 import (
+	"bufio"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
-	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/transform"
 )
 
 // Config configures CSV file loading.
@@ -39,18 +44,110 @@ type Config struct {
 	// Comment character to ignore lines (0 = no comments)
 	Comment rune
 
-	// LazyQuotes allows lazy quote parsing
+	// SkipRows discards this many leading lines before parsing, e.g. a
+	// metadata preamble above the header. Headers and type inference apply
+	// to what follows.
+	SkipRows int
+
+	// LazyQuotes allows lazy quote parsing: a quote may appear in an
+	// unquoted field and a non-doubled quote may appear in a quoted field
 	LazyQuotes bool
+
+	// Quote is the field quoting character (0 or '"' = standard double quote).
+	// encoding/csv only supports '"', so other characters are handled by
+	// rewriting the input before parsing. Quotes inside a quoted field are
+	// escaped by doubling them.
+	Quote rune
+
+	// Encoding is the character encoding of the input, by WHATWG label
+	// such as "windows-1252", "latin1" or "shift_jis". The input is decoded
+	// to UTF-8 before parsing. Empty means UTF-8.
+	Encoding string
+
+	// BoolValues lists the tokens read as booleans. A column whose
+	// non-empty cells all match is typed TypeBool and its cells hold bool
+	// Raw values. When both lists are empty DefaultBoolValues is used.
+	BoolValues BoolValues
+
+	// DateLayouts lists the time.Parse layouts tried for date detection.
+	// A column whose non-empty cells all parse with one layout is typed
+	// TypeTimestamp if the layout has a clock part and TypeDate otherwise,
+	// and its cells hold time.Time Raw values. When empty
+	// DefaultDateLayouts is used.
+	DateLayouts []string
+
+	// Progress, if set, is called with the number of data rows read so
+	// far every ProgressInterval rows and once more when reading ends.
+	// Returning false aborts the load with ErrLoadCancelled.
+	Progress func(rowsRead int) bool
+
+	// ProgressInterval is the number of rows between Progress calls
+	// (0 = DefaultProgressInterval).
+	ProgressInterval int
+}
+
+// DefaultProgressInterval is the number of rows between Progress calls when
+// Config.ProgressInterval is not set.
+const DefaultProgressInterval = 10000
+
+// ErrLoadCancelled is returned when a Progress callback aborts a load.
+var ErrLoadCancelled = errors.New("CSV load cancelled")
+
+// BoolValues lists the cell tokens recognized as true and false.
+// Matching ignores case and surrounding whitespace.
+type BoolValues struct {
+	True  []string
+	False []string
+}
+
+// DefaultBoolValues returns the boolean tokens recognized by default:
+// true/false, yes/no, y/n and 1/0.
+func DefaultBoolValues() BoolValues {
+	return BoolValues{
+		True:  []string{"true", "yes", "y", "1"},
+		False: []string{"false", "no", "n", "0"},
+	}
+}
+
+// parse reports the boolean s stands for, and whether it is a known token.
+func (b BoolValues) parse(s string) (value bool, ok bool) {
+	s = trimSpace(s)
+	for _, token := range b.True {
+		if strings.EqualFold(s, token) {
+			return true, true
+		}
+	}
+	for _, token := range b.False {
+		if strings.EqualFold(s, token) {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// DefaultDateLayouts returns the date layouts recognized by default:
+// RFC 3339 timestamps, "2006-01-02 15:04:05", "2006-01-02T15:04:05" and
+// ISO dates.
+func DefaultDateLayouts() []string {
+	return []string{
+		time.RFC3339,
+		time.DateTime,
+		"2006-01-02T15:04:05",
+		time.DateOnly,
+	}
 }
 
 // DefaultConfig returns the default CSV configuration.
 func DefaultConfig() Config {
 	return Config{
-		Delimiter:  ',',
-		HasHeaders: true,
-		TrimSpace:  true,
-		Comment:    0,
-		LazyQuotes: false,
+		Delimiter:   ',',
+		HasHeaders:  true,
+		TrimSpace:   true,
+		Comment:     0,
+		LazyQuotes:  false,
+		Quote:       '"',
+		BoolValues:  DefaultBoolValues(),
+		DateLayouts: DefaultDateLayouts(),
 	}
 }
 
@@ -64,10 +161,12 @@ type CSVDataSource struct {
 }
 
 // NewFromFile loads a CSV file and creates a DataSource.
+// Gzip-compressed files (such as .csv.gz) are decompressed transparently,
+// and for zip archives the first entry with a .csv extension is loaded.
 func NewFromFile(filename string, config Config) (*CSVDataSource, error) {
-	file, err := os.Open(filename)
+	file, err := openFile(filename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+		return nil, err
 	}
 	defer file.Close()
 
@@ -76,6 +175,43 @@ func NewFromFile(filename string, config Config) (*CSVDataSource, error) {
 
 // NewFromReader loads CSV data from an io.Reader.
 func NewFromReader(reader io.Reader, config Config) (*CSVDataSource, error) {
+	// Decode legacy character sets to UTF-8
+	if config.Encoding != "" {
+		enc, err := htmlindex.Get(config.Encoding)
+		if err != nil {
+			return nil, fmt.Errorf("unsupported encoding %q: %w", config.Encoding, err)
+		}
+		reader = transform.NewReader(reader, enc.NewDecoder())
+	}
+
+	// Drop preamble lines
+	if config.SkipRows < 0 {
+		return nil, fmt.Errorf("SkipRows cannot be negative: %d", config.SkipRows)
+	}
+	if config.SkipRows > 0 {
+		buffered := bufio.NewReader(reader)
+		if err := skipLines(buffered, config.SkipRows); err != nil {
+			return nil, fmt.Errorf("failed to skip rows: %w", err)
+		}
+		reader = buffered
+	}
+
+	// Rewrite custom quoting into the double quotes encoding/csv understands
+	if config.Quote != 0 && config.Quote != '"' {
+		if err := validateQuote(config); err != nil {
+			return nil, err
+		}
+		raw, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		translated, err := translateQuotes(string(raw), config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV: %w", err)
+		}
+		reader = strings.NewReader(translated)
+	}
+
 	// Create CSV reader
 	csvReader := csv.NewReader(reader)
 	csvReader.Comma = config.Delimiter
@@ -84,7 +220,7 @@ func NewFromReader(reader io.Reader, config Config) (*CSVDataSource, error) {
 	csvReader.LazyQuotes = config.LazyQuotes
 
 	// Read all records
-	records, err := csvReader.ReadAll()
+	records, err := readRecords(csvReader, config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read CSV: %w", err)
 	}
@@ -140,12 +276,36 @@ func NewFromReader(reader io.Reader, config Config) (*CSVDataSource, error) {
 	}
 
 	// Infer column types from data
-	columnTypes := inferColumnTypes(dataRows, len(columnNames))
+	boolValues := config.BoolValues
+	if len(boolValues.True) == 0 && len(boolValues.False) == 0 {
+		boolValues = DefaultBoolValues()
+	}
+	columnTypes := inferColumnTypes(dataRows, len(columnNames), boolValues)
+
+	// Detect date columns among the remaining string columns
+	dateLayouts := config.DateLayouts
+	if len(dateLayouts) == 0 {
+		dateLayouts = DefaultDateLayouts()
+	}
+	for col, colType := range columnTypes {
+		if colType != datatable.TypeString {
+			continue
+		}
+		if layout, ok := detectDateLayout(dataRows, col, dateLayouts); ok {
+			columnTypes[col] = dateType(layout)
+			parseDates(dataRows, col, layout, columnTypes[col])
+		}
+	}
 
 	// Update Value types based on inferred types
 	for i := range dataRows {
 		for j := range dataRows[i] {
 			dataRows[i][j].Type = columnTypes[j]
+			if columnTypes[j] == datatable.TypeBool {
+				if b, ok := boolValues.parse(dataRows[i][j].Formatted); ok {
+					dataRows[i][j].Raw = b
+				}
+			}
 		}
 	}
 
@@ -157,8 +317,115 @@ func NewFromReader(reader io.Reader, config Config) (*CSVDataSource, error) {
 	}, nil
 }
 
+// detectDateLayout returns the first layout that parses every non-empty cell
+// of column col. Columns with no non-empty cells are not dates.
+func detectDateLayout(data [][]datatable.Value, col int, layouts []string) (string, bool) {
+	for _, layout := range layouts {
+		matched := false
+		for row := range data {
+			value := data[row][col].Formatted
+			if value == "" {
+				continue
+			}
+			if _, err := time.Parse(layout, value); err != nil {
+				matched = false
+				break
+			}
+			matched = true
+		}
+		if matched {
+			return layout, true
+		}
+	}
+	return "", false
+}
+
+// dateType returns TypeTimestamp for layouts with a clock part and TypeDate
+// for layouts without one.
+func dateType(layout string) datatable.DataType {
+	morning := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	afternoon := time.Date(2000, 1, 1, 13, 14, 15, 0, time.UTC)
+	if morning.Format(layout) != afternoon.Format(layout) {
+		return datatable.TypeTimestamp
+	}
+	return datatable.TypeDate
+}
+
+// parseDates stores the parsed time.Time of each cell in column col as its
+// Raw value, keeping the original text for display. Empty cells become nulls.
+func parseDates(data [][]datatable.Value, col int, layout string, dataType datatable.DataType) {
+	for row := range data {
+		cell := &data[row][col]
+		if cell.Formatted == "" {
+			*cell = datatable.NewNullValue(dataType)
+			continue
+		}
+		if t, err := time.Parse(layout, cell.Formatted); err == nil {
+			cell.Raw = t
+		}
+	}
+}
+
+// readRecords reads every record from reader, reporting progress to
+// config.Progress if it is set.
+func readRecords(reader *csv.Reader, config Config) ([][]string, error) {
+	if config.Progress == nil {
+		return reader.ReadAll()
+	}
+
+	interval := config.ProgressInterval
+	if interval <= 0 {
+		interval = DefaultProgressInterval
+	}
+	headerRows := 0
+	if config.HasHeaders {
+		headerRows = 1
+	}
+
+	var records [][]string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+
+		if rows := len(records) - headerRows; rows > 0 && rows%interval == 0 {
+			if !config.Progress(rows) {
+				return nil, ErrLoadCancelled
+			}
+		}
+	}
+
+	// Report the final count unless it was just reported
+	if rows := len(records) - headerRows; rows > 0 && rows%interval != 0 {
+		if !config.Progress(rows) {
+			return nil, ErrLoadCancelled
+		}
+	}
+
+	return records, nil
+}
+
+// skipLines discards n lines from reader. Running out of input is not an
+// error; the caller then sees an empty file.
+func skipLines(reader *bufio.Reader, n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := reader.ReadString('\n'); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 // inferColumnTypes attempts to infer data types from the data.
-func inferColumnTypes(data [][]datatable.Value, numCols int) []datatable.DataType {
+func inferColumnTypes(data [][]datatable.Value, numCols int, boolValues BoolValues) []datatable.DataType {
 	types := make([]datatable.DataType, numCols)
 
 	// Initialize all as string
@@ -205,7 +472,7 @@ func inferColumnTypes(data [][]datatable.Value, numCols int) []datatable.DataTyp
 
 			// Try bool
 			if allBools {
-				if !isBool(value) {
+				if _, ok := boolValues.parse(value); !ok {
 					allBools = false
 				}
 			}
@@ -266,13 +533,6 @@ func isFloat(s string) bool {
 	return true
 }
 
-func isBool(s string) bool {
-	s = trimSpace(s)
-	lower := toLower(s)
-	return lower == "true" || lower == "false" || lower == "1" || lower == "0" ||
-		lower == "yes" || lower == "no" || lower == "y" || lower == "n"
-}
-
 func trimSpace(s string) string {
 	// Simple trim implementation
 	start := 0
@@ -293,18 +553,6 @@ func isSpace(c byte) bool {
 	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
 }
 
-func toLower(s string) string {
-	result := make([]byte, len(s))
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-		if c >= 'A' && c <= 'Z' {
-			c = c + ('a' - 'A')
-		}
-		result[i] = c
-	}
-	return string(result)
-}
-
 // DataSource interface implementation
 
 func (ds *CSVDataSource) RowCount() int {
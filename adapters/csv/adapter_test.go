@@ -15,10 +15,14 @@
 package csv
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
 func TestNewFromReader_WithHeaders(t *testing.T) {
@@ -130,6 +134,111 @@ Charlie,35,60000.75,true`
 	}
 }
 
+func TestNewFromReader_BoolValues(t *testing.T) {
+	csvData := "Name,Member,Flag\nAlice,Y,on\nBob,n,off\nCharlie,y,on\n"
+
+	source, err := NewFromReader(strings.NewReader(csvData), DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+
+	if colType, _ := source.ColumnType(1); colType != datatable.TypeBool {
+		t.Errorf("Member type = %v, want Bool", colType)
+	}
+	for row, want := range []bool{true, false, true} {
+		cell, _ := source.Cell(row, 1)
+		if cell.Raw != want {
+			t.Errorf("Cell(%d,1).Raw = %#v, want %v", row, cell.Raw, want)
+		}
+	}
+	if cell, _ := source.Cell(1, 1); cell.Formatted != "n" {
+		t.Errorf("Cell(1,1).Formatted = %q, want original text", cell.Formatted)
+	}
+
+	// Unrecognized tokens stay strings
+	if colType, _ := source.ColumnType(2); colType != datatable.TypeString {
+		t.Errorf("Flag type = %v, want String", colType)
+	}
+
+	// Custom tokens
+	config := DefaultConfig()
+	config.BoolValues = BoolValues{True: []string{"on"}, False: []string{"off"}}
+	source, err = NewFromReader(strings.NewReader(csvData), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+	if colType, _ := source.ColumnType(1); colType != datatable.TypeString {
+		t.Errorf("Member type with custom tokens = %v, want String", colType)
+	}
+	if colType, _ := source.ColumnType(2); colType != datatable.TypeBool {
+		t.Errorf("Flag type with custom tokens = %v, want Bool", colType)
+	}
+	if cell, _ := source.Cell(1, 2); cell.Raw != false {
+		t.Errorf("Cell(1,2).Raw = %#v, want false", cell.Raw)
+	}
+}
+
+func TestNewFromReader_Dates(t *testing.T) {
+	csvData := "Name,Hired,LastLogin,Note\n" +
+		"Alice,2024-01-15,2024-03-01T09:30:00Z,2024-01-15\n" +
+		"Bob,,2024-03-02T17:05:00+01:00,soon\n" +
+		"Charlie,2023-11-30,2024-03-03T08:00:00Z,\n"
+
+	source, err := NewFromReader(strings.NewReader(csvData), DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+
+	tests := []struct {
+		col          int
+		expectedType datatable.DataType
+	}{
+		{1, datatable.TypeDate},      // Hired
+		{2, datatable.TypeTimestamp}, // LastLogin
+		{3, datatable.TypeString},    // Note: not every value is a date
+	}
+	for _, tt := range tests {
+		if colType, _ := source.ColumnType(tt.col); colType != tt.expectedType {
+			t.Errorf("ColumnType(%d) = %v, want %v", tt.col, colType, tt.expectedType)
+		}
+	}
+
+	cell, _ := source.Cell(0, 1)
+	raw, ok := cell.Raw.(time.Time)
+	if !ok {
+		t.Fatalf("Cell(0,1).Raw = %#v, want time.Time", cell.Raw)
+	}
+	if !raw.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Cell(0,1).Raw = %v, want 2024-01-15", raw)
+	}
+	if cell.Formatted != "2024-01-15" || cell.Type != datatable.TypeDate {
+		t.Errorf("Cell(0,1) = %+v, want date with original text", cell)
+	}
+
+	// Empty cells in a date column are nulls
+	if cell, _ := source.Cell(1, 1); !cell.IsNull || cell.Type != datatable.TypeDate {
+		t.Errorf("Cell(1,1) = %+v, want null date", cell)
+	}
+
+	if cell, _ := source.Cell(1, 2); cell.Raw.(time.Time).Hour() != 17 {
+		t.Errorf("Cell(1,2).Raw = %v, want 17:05 local time", cell.Raw)
+	}
+
+	// Custom layouts
+	config := DefaultConfig()
+	config.DateLayouts = []string{"02/01/2006"}
+	source, err = NewFromReader(strings.NewReader("Day\n15/01/2024\n31/12/2023\n"), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+	if colType, _ := source.ColumnType(0); colType != datatable.TypeDate {
+		t.Errorf("ColumnType(0) with custom layout = %v, want Date", colType)
+	}
+	if cell, _ := source.Cell(1, 0); cell.Raw.(time.Time).Month() != time.December {
+		t.Errorf("Cell(1,0).Raw = %v, want December", cell.Raw)
+	}
+}
+
 func TestNewFromReader_CustomDelimiter(t *testing.T) {
 	tsvData := "Name\tAge\tRole\nAlice\t30\tEngineer\nBob\t25\tDesigner"
 
@@ -193,6 +302,150 @@ Bob,25,Designer`
 	}
 }
 
+func TestNewFromReader_SingleQuote(t *testing.T) {
+	csvData := `Name,Note,Count
+'Smith, John','it''s "fine"',3
+Plain,'multi
+line',4
+'',x,5`
+
+	config := DefaultConfig()
+	config.Quote = '\''
+	source, err := NewFromReader(strings.NewReader(csvData), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+
+	if source.RowCount() != 3 {
+		t.Fatalf("Expected 3 rows, got %d", source.RowCount())
+	}
+
+	expected := [][]string{
+		{"Smith, John", `it's "fine"`, "3"},
+		{"Plain", "multi\nline", "4"},
+		{"", "x", "5"},
+	}
+	for row, values := range expected {
+		for col, want := range values {
+			cell, _ := source.Cell(row, col)
+			if cell.Formatted != want {
+				t.Errorf("Cell(%d,%d) = %q, want %q", row, col, cell.Formatted, want)
+			}
+		}
+	}
+
+	// Type inference still applies
+	if colType, _ := source.ColumnType(2); colType != datatable.TypeInt {
+		t.Errorf("Column 2 type = %v, want Int", colType)
+	}
+}
+
+func TestNewFromReader_LazyQuotes(t *testing.T) {
+	csvData := `Name,Quote
+Alice,She said "hi"
+Bob,"a "quoted" word"`
+
+	// Strict parsing rejects the bare quotes
+	if _, err := NewFromReader(strings.NewReader(csvData), DefaultConfig()); err == nil {
+		t.Error("Expected error for bare quotes without LazyQuotes")
+	}
+
+	config := DefaultConfig()
+	config.LazyQuotes = true
+	source, err := NewFromReader(strings.NewReader(csvData), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+
+	cell, _ := source.Cell(0, 1)
+	if cell.Formatted != `She said "hi"` {
+		t.Errorf("Cell(0,1) = %q, want %q", cell.Formatted, `She said "hi"`)
+	}
+	cell, _ = source.Cell(1, 1)
+	if cell.Formatted != `a "quoted" word` {
+		t.Errorf("Cell(1,1) = %q, want %q", cell.Formatted, `a "quoted" word`)
+	}
+}
+
+func TestNewFromReader_InvalidQuote(t *testing.T) {
+	tests := []struct {
+		name   string
+		config func() Config
+		data   string
+	}{
+		{"quote equals delimiter", func() Config { c := DefaultConfig(); c.Quote = ','; return c }, "a,b\n1,2"},
+		{"newline quote", func() Config { c := DefaultConfig(); c.Quote = '\n'; return c }, "a,b\n1,2"},
+		{"unterminated quote", func() Config { c := DefaultConfig(); c.Quote = '\''; return c }, "a,b\n'1,2"},
+		{"bare quote", func() Config { c := DefaultConfig(); c.Quote = '\''; return c }, "a,b\nit's,2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewFromReader(strings.NewReader(tt.data), tt.config()); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestNewFromReader_SkipRowsAndComments(t *testing.T) {
+	csvData := `Exported by ReportTool v2
+Generated: 2025-01-31
+Region,Sales,Active
+# North America
+East,100,true
+West,250,false
+# Europe
+North,75,true`
+
+	config := DefaultConfig()
+	config.SkipRows = 2
+	config.Comment = '#'
+	source, err := NewFromReader(strings.NewReader(csvData), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+
+	expectedNames := []string{"Region", "Sales", "Active"}
+	for i, expected := range expectedNames {
+		name, _ := source.ColumnName(i)
+		if name != expected {
+			t.Errorf("ColumnName(%d) = %s, want %s", i, name, expected)
+		}
+	}
+
+	if source.RowCount() != 3 {
+		t.Errorf("Expected 3 rows, got %d", source.RowCount())
+	}
+
+	cell, _ := source.Cell(2, 0)
+	if cell.Formatted != "North" {
+		t.Errorf("Cell(2,0) = %s, want North", cell.Formatted)
+	}
+
+	// Inference runs on the data after the preamble
+	if colType, _ := source.ColumnType(1); colType != datatable.TypeInt {
+		t.Errorf("Sales type = %v, want Int", colType)
+	}
+	if colType, _ := source.ColumnType(2); colType != datatable.TypeBool {
+		t.Errorf("Active type = %v, want Bool", colType)
+	}
+}
+
+func TestNewFromReader_SkipRowsErrors(t *testing.T) {
+	config := DefaultConfig()
+	config.SkipRows = -1
+	if _, err := NewFromReader(strings.NewReader("a,b\n1,2"), config); err == nil {
+		t.Error("Expected error for negative SkipRows")
+	}
+
+	// Skipping past the end leaves an empty file
+	config.SkipRows = 5
+	if _, err := NewFromReader(strings.NewReader("a,b\n1,2"), config); err == nil {
+		t.Error("Expected error when all rows are skipped")
+	}
+}
+
 func TestCSVDataSource_Row(t *testing.T) {
 	csvData := `Name,Age,Role
 Alice,30,Engineer`
@@ -273,3 +526,108 @@ Alice,30`
 		t.Errorf("Expected ErrInvalidRow for Row(), got %v", err)
 	}
 }
+
+func TestConformance(t *testing.T) {
+	datatabletest.RunConformance(t, func() datatable.DataSource {
+		source, err := NewFromReader(strings.NewReader("Name,Age\nAlice,30\nBob,25\n"), DefaultConfig())
+		if err != nil {
+			t.Fatalf("NewFromReader() error = %v", err)
+		}
+		return source
+	})
+}
+
+func TestNewFromFile_Encoding(t *testing.T) {
+	// "café" and "€5" in Latin-1 / Windows-1252
+	latin1 := []byte("Item,Price\ncaf\xe9,\x805\n")
+	filename := writeFixture(t, "latin1.csv", latin1)
+
+	for _, encoding := range []string{"latin1", "ISO-8859-1", "windows-1252"} {
+		t.Run(encoding, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Encoding = encoding
+
+			source, err := NewFromFile(filename, config)
+			if err != nil {
+				t.Fatalf("NewFromFile() error = %v", err)
+			}
+			if v, _ := source.Cell(0, 0); v.Formatted != "café" {
+				t.Errorf("Cell(0, 0) = %q, want %q", v.Formatted, "café")
+			}
+			if v, _ := source.Cell(0, 1); v.Formatted != "€5" {
+				t.Errorf("Cell(0, 1) = %q, want %q", v.Formatted, "€5")
+			}
+		})
+	}
+}
+
+func TestNewFromReader_UnknownEncoding(t *testing.T) {
+	config := DefaultConfig()
+	config.Encoding = "no-such-charset"
+
+	if _, err := NewFromReader(strings.NewReader("A\n1\n"), config); err == nil {
+		t.Error("NewFromReader() error = nil, want error for unknown encoding")
+	}
+}
+
+// numberedCSV returns a CSV document with a header and rows data rows.
+func numberedCSV(rows int) string {
+	var b strings.Builder
+	b.WriteString("ID,Name\n")
+	for i := 0; i < rows; i++ {
+		fmt.Fprintf(&b, "%d,row%d\n", i, i)
+	}
+	return b.String()
+}
+
+func TestNewFromReader_Progress(t *testing.T) {
+	var calls []int
+	config := DefaultConfig()
+	config.ProgressInterval = 1000
+	config.Progress = func(rowsRead int) bool {
+		calls = append(calls, rowsRead)
+		return true
+	}
+
+	source, err := NewFromReader(strings.NewReader(numberedCSV(2500)), config)
+	if err != nil {
+		t.Fatalf("NewFromReader failed: %v", err)
+	}
+	if source.RowCount() != 2500 {
+		t.Errorf("RowCount() = %d, want 2500", source.RowCount())
+	}
+
+	want := []int{1000, 2000, 2500}
+	if len(calls) != len(want) {
+		t.Fatalf("Progress calls = %v, want %v", calls, want)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Progress calls = %v, want %v", calls, want)
+			break
+		}
+	}
+}
+
+func TestNewFromFile_ProgressCancel(t *testing.T) {
+	filename := writeFixture(t, "big.csv", []byte(numberedCSV(5000)))
+
+	calls := 0
+	config := DefaultConfig()
+	config.ProgressInterval = 1000
+	config.Progress = func(rowsRead int) bool {
+		calls++
+		return rowsRead < 2000
+	}
+
+	source, err := NewFromFile(filename, config)
+	if !errors.Is(err, ErrLoadCancelled) {
+		t.Fatalf("NewFromFile() error = %v, want ErrLoadCancelled", err)
+	}
+	if source != nil {
+		t.Error("NewFromFile() returned a source after cancellation")
+	}
+	if calls != 2 {
+		t.Errorf("Progress called %d times, want 2", calls)
+	}
+}
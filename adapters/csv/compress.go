@@ -0,0 +1,110 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// fileReader is an io.ReadCloser that closes every layer opened beneath it.
+type fileReader struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// Close closes the layers from the outermost inwards and returns the first
+// error.
+func (r *fileReader) Close() error {
+	var first error
+	for _, c := range r.closers {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// openFile opens filename for reading. Gzip files are decompressed and zip
+// archives yield their first CSV entry; anything else is read as is. The
+// format is detected from the leading bytes, so the extension does not
+// matter.
+func openFile(filename string) (io.ReadCloser, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	buffered := bufio.NewReader(file)
+	magic, _ := buffered.Peek(len(zipMagic)) // short files are plain CSV
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to read gzip file: %w", err)
+		}
+		return &fileReader{Reader: gz, closers: []io.Closer{gz, file}}, nil
+
+	case bytes.HasPrefix(magic, zipMagic):
+		entry, err := openZipEntry(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		return &fileReader{Reader: entry, closers: []io.Closer{entry, file}}, nil
+	}
+
+	return &fileReader{Reader: buffered, closers: []io.Closer{file}}, nil
+}
+
+// openZipEntry opens the first entry of the zip archive in file whose name
+// has a .csv extension.
+func openZipEntry(file *os.File) (io.ReadCloser, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip file: %w", err)
+	}
+	archive, err := zip.NewReader(file, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zip file: %w", err)
+	}
+
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || !strings.EqualFold(path.Ext(entry.Name), ".csv") {
+			continue
+		}
+		rc, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s in zip file: %w", entry.Name, err)
+		}
+		return rc, nil
+	}
+
+	return nil, fmt.Errorf("zip file contains no .csv entry")
+}
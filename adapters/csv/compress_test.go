@@ -0,0 +1,161 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const compressFixture = `Name,Age,Score
+Alice,30,91.5
+Bob,25,78
+Charlie,35,88.25
+`
+
+// writeFixture writes data to name in a temporary directory.
+func writeFixture(t *testing.T, name string, data []byte) string {
+	t.Helper()
+	filename := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	return filename
+}
+
+func gzipBytes(t *testing.T, data string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write([]byte(data)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// zipBytes builds a zip archive from alternating entry names and contents.
+func zipBytes(t *testing.T, entries ...string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := 0; i < len(entries); i += 2 {
+		w, err := zw.Create(entries[i])
+		if err != nil {
+			t.Fatalf("zip create: %v", err)
+		}
+		if _, err := w.Write([]byte(entries[i+1])); err != nil {
+			t.Fatalf("zip write: %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// assertSameSource checks that two sources have identical schemas and cells.
+func assertSameSource(t *testing.T, want, got *CSVDataSource) {
+	t.Helper()
+	if got.RowCount() != want.RowCount() || got.ColumnCount() != want.ColumnCount() {
+		t.Fatalf("size = %dx%d, want %dx%d",
+			got.RowCount(), got.ColumnCount(), want.RowCount(), want.ColumnCount())
+	}
+	for col := 0; col < want.ColumnCount(); col++ {
+		wantName, _ := want.ColumnName(col)
+		gotName, _ := got.ColumnName(col)
+		wantType, _ := want.ColumnType(col)
+		gotType, _ := got.ColumnType(col)
+		if gotName != wantName || gotType != wantType {
+			t.Errorf("column %d = %s %v, want %s %v", col, gotName, gotType, wantName, wantType)
+		}
+	}
+	for row := 0; row < want.RowCount(); row++ {
+		for col := 0; col < want.ColumnCount(); col++ {
+			w, _ := want.Cell(row, col)
+			g, _ := got.Cell(row, col)
+			if g.Formatted != w.Formatted || g.Raw != w.Raw {
+				t.Errorf("Cell(%d, %d) = %v, want %v", row, col, g.Raw, w.Raw)
+			}
+		}
+	}
+}
+
+func TestNewFromFile_Compressed(t *testing.T) {
+	plain, err := NewFromFile(writeFixture(t, "data.csv", []byte(compressFixture)), DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewFromFile(plain) error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		file string
+		data []byte
+	}{
+		{"gzip", "data.csv.gz", gzipBytes(t, compressFixture)},
+		{"gzip without extension", "data.csv", gzipBytes(t, compressFixture)},
+		{"zip", "data.zip", zipBytes(t, "data.csv", compressFixture)},
+		{"zip with other entries", "data.zip", zipBytes(t,
+			"README.txt", "not csv",
+			"dir/DATA.CSV", compressFixture,
+			"other.csv", "x\n1\n",
+		)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromFile(writeFixture(t, tt.file, tt.data), DefaultConfig())
+			if err != nil {
+				t.Fatalf("NewFromFile() error = %v", err)
+			}
+			assertSameSource(t, plain, got)
+		})
+	}
+}
+
+func TestNewFromFile_CompressedErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data []byte
+	}{
+		{"truncated gzip", "data.csv.gz", gzipBytes(t, compressFixture)[:12]},
+		{"zip without csv", "data.zip", zipBytes(t, "notes.txt", "a,b\n1,2\n")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewFromFile(writeFixture(t, tt.file, tt.data), DefaultConfig()); err == nil {
+				t.Error("NewFromFile() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNewFromFile_ShortPlainFile(t *testing.T) {
+	source, err := NewFromFile(writeFixture(t, "a.csv", []byte("A\n")), DefaultConfig())
+	if err != nil {
+		t.Fatalf("NewFromFile() error = %v", err)
+	}
+	if name, _ := source.ColumnName(0); name != "A" {
+		t.Errorf("ColumnName(0) = %q, want A", name)
+	}
+}
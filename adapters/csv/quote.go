@@ -0,0 +1,182 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"fmt"
+	"strings"
+)
+
+// validateQuote checks that a custom quote character can be used with the
+// rest of the configuration.
+func validateQuote(config Config) error {
+	quote := config.Quote
+	switch {
+	case quote == '\n' || quote == '\r':
+		return fmt.Errorf("invalid quote character %q", quote)
+	case quote == config.Delimiter:
+		return fmt.Errorf("quote character %q cannot equal the delimiter", quote)
+	case config.Comment != 0 && quote == config.Comment:
+		return fmt.Errorf("quote character %q cannot equal the comment character", quote)
+	}
+	return nil
+}
+
+// translateQuotes rewrites CSV text quoted with config.Quote into standard
+// double-quoted CSV, because encoding/csv only understands '"'. Every field
+// is emitted double-quoted so the standard reader sees its exact contents.
+// Inside a quoted field the quote character is escaped by doubling it.
+// Comment lines and blank lines are passed through for the standard reader
+// to skip.
+func translateQuotes(input string, config Config) (string, error) {
+	const (
+		fieldStart = iota
+		unquoted
+		quoted
+		quoteInQuoted
+	)
+
+	quote := config.Quote
+	delim := config.Delimiter
+
+	var out strings.Builder
+	var field strings.Builder
+	state := fieldStart
+	recordStart := true
+	inComment := false
+	line := 1
+
+	flushField := func() {
+		out.WriteByte('"')
+		out.WriteString(strings.ReplaceAll(field.String(), `"`, `""`))
+		out.WriteByte('"')
+		field.Reset()
+	}
+
+	runes := []rune(input)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\n' {
+			line++
+		}
+
+		if inComment {
+			out.WriteRune(r)
+			if r == '\n' {
+				inComment = false
+				recordStart = true
+			}
+			continue
+		}
+
+		switch state {
+		case fieldStart:
+			switch {
+			case recordStart && config.Comment != 0 && r == config.Comment:
+				inComment = true
+				out.WriteRune(r)
+			case r == '\r' && i+1 < len(runes) && runes[i+1] == '\n':
+				// Handled with the '\n'
+			case r == '\n':
+				if !recordStart {
+					flushField() // Trailing empty field after a delimiter
+				}
+				out.WriteByte('\n')
+				recordStart = true
+			case r == quote:
+				state = quoted
+				recordStart = false
+			case r == delim:
+				flushField()
+				out.WriteRune(delim)
+				recordStart = false
+			case config.TrimSpace && (r == ' ' || r == '\t'):
+				// Leading space is trimmed, as encoding/csv would
+			default:
+				field.WriteRune(r)
+				state = unquoted
+				recordStart = false
+			}
+
+		case unquoted:
+			switch {
+			case r == delim:
+				flushField()
+				out.WriteRune(delim)
+				state = fieldStart
+			case r == '\r' && i+1 < len(runes) && runes[i+1] == '\n':
+				// Handled with the '\n'
+			case r == '\n':
+				flushField()
+				out.WriteByte('\n')
+				state = fieldStart
+				recordStart = true
+			case r == quote && !config.LazyQuotes:
+				return "", fmt.Errorf("line %d: bare %q in non-quoted field", line, quote)
+			default:
+				field.WriteRune(r)
+			}
+
+		case quoted:
+			if r == quote {
+				state = quoteInQuoted
+			} else {
+				field.WriteRune(r)
+			}
+
+		case quoteInQuoted:
+			switch {
+			case r == quote:
+				// Doubled quote is a literal quote character
+				field.WriteRune(quote)
+				state = quoted
+			case r == delim:
+				flushField()
+				out.WriteRune(delim)
+				state = fieldStart
+			case r == '\r' && i+1 < len(runes) && runes[i+1] == '\n':
+				// Handled with the '\n'
+			case r == '\n':
+				flushField()
+				out.WriteByte('\n')
+				state = fieldStart
+				recordStart = true
+			case config.LazyQuotes:
+				// Stray quote inside a quoted field is kept as data
+				field.WriteRune(quote)
+				field.WriteRune(r)
+				state = quoted
+			default:
+				return "", fmt.Errorf("line %d: extraneous %q in quoted field", line, quote)
+			}
+		}
+	}
+
+	switch state {
+	case quoted:
+		if !config.LazyQuotes {
+			return "", fmt.Errorf("line %d: unterminated quoted field", line)
+		}
+		flushField()
+	case unquoted, quoteInQuoted:
+		flushField()
+	case fieldStart:
+		if !recordStart {
+			flushField() // Trailing empty field after a delimiter
+		}
+	}
+
+	return out.String(), nil
+}
@@ -67,28 +67,28 @@ func (a *Adapter) ColumnCount() int {
 }
 
 // ColumnName returns the name of the column at the given index.
-// Returns an error if the column index is out of range.
+// Returns ErrInvalidColumn if the column index is out of range.
 func (a *Adapter) ColumnName(col int) (string, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	names := a.df.ColumnNames()
 	if col < 0 || col >= len(names) {
-		return "", fmt.Errorf("column index %d out of range [0, %d)", col, len(names))
+		return "", fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, len(names))
 	}
 	return names[col], nil
 }
 
 // ColumnType returns the data type of the column at the given index.
 // Maps mp_dataframe DataType to datatable DataType.
-// Returns an error if the column index is out of range.
+// Returns ErrInvalidColumn if the column index is out of range.
 func (a *Adapter) ColumnType(col int) (datatable.DataType, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	names := a.df.ColumnNames()
 	if col < 0 || col >= len(names) {
-		return 0, fmt.Errorf("column index %d out of range [0, %d)", col, len(names))
+		return 0, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, len(names))
 	}
 
 	series, err := a.df.Column(names[col])
@@ -100,19 +100,19 @@ func (a *Adapter) ColumnType(col int) (datatable.DataType, error) {
 }
 
 // Cell returns the value at the specified row and column.
-// Returns an error if the row or column index is out of range.
+// Returns ErrInvalidRow or ErrInvalidColumn if an index is out of range.
 func (a *Adapter) Cell(row, col int) (datatable.Value, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	// Validate indices
 	if row < 0 || row >= a.df.RowCount() {
-		return datatable.Value{}, fmt.Errorf("row index %d out of range [0, %d)", row, a.df.RowCount())
+		return datatable.Value{}, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidRow, row, a.df.RowCount())
 	}
 
 	names := a.df.ColumnNames()
 	if col < 0 || col >= len(names) {
-		return datatable.Value{}, fmt.Errorf("column index %d out of range [0, %d)", col, len(names))
+		return datatable.Value{}, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidColumn, col, len(names))
 	}
 
 	// Get the column
@@ -132,14 +132,14 @@ func (a *Adapter) Cell(row, col int) (datatable.Value, error) {
 }
 
 // Row returns all values for the specified row.
-// Returns an error if the row index is out of range.
+// Returns ErrInvalidRow if the row index is out of range.
 func (a *Adapter) Row(row int) ([]datatable.Value, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
 
 	// Validate row index
 	if row < 0 || row >= a.df.RowCount() {
-		return nil, fmt.Errorf("row index %d out of range [0, %d)", row, a.df.RowCount())
+		return nil, fmt.Errorf("%w: %d out of range [0, %d)", datatable.ErrInvalidRow, row, a.df.RowCount())
 	}
 
 	names := a.df.ColumnNames()
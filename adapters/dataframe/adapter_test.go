@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 	mpdf "github.com/magpierre/mp_dataframe/dataframe"
 )
 
@@ -475,3 +476,15 @@ func TestAdapterWithEmptyDataFrame(t *testing.T) {
 		t.Error("Expected error accessing cell in empty DataFrame")
 	}
 }
+
+// TestConformance runs the shared DataSource conformance suite.
+func TestConformance(t *testing.T) {
+	df, err := createTestDataFrame()
+	if err != nil {
+		t.Fatalf("Failed to create test DataFrame: %v", err)
+	}
+
+	datatabletest.RunConformance(t, func() datatable.DataSource {
+		return NewAdapter(df)
+	})
+}
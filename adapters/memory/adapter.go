@@ -18,6 +18,7 @@ package memory
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/magpierre/fyne-datatable/datatable"
 )
@@ -90,6 +91,114 @@ func NewDataSourceFromValues(data [][]datatable.Value, columnNames []string, col
 	}, nil
 }
 
+// NewTypedDataSource creates a new in-memory data source from typed rows
+// with a known schema, skipping type inference. Each cell is stored as the
+// Raw type of its column: int64 for TypeInt, float64 for TypeFloat, bool for
+// TypeBool, string for TypeString, time.Time for TypeDate, TypeTimestamp
+// and TypeTime, and time.Duration for TypeDuration. Integers are accepted in float columns, and nil entries
+// become null values of the column's type. Cells of other column types are
+// stored as given.
+// Returns an error if a row's length differs from the headers, or a cell
+// does not match its column's type.
+func NewTypedDataSource(data [][]any, headers []string, types []datatable.DataType) (*MemoryDataSource, error) {
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("%w: no columns provided", datatable.ErrEmptyData)
+	}
+
+	if len(headers) != len(types) {
+		return nil, fmt.Errorf("column names (%d) and types (%d) length mismatch", len(headers), len(types))
+	}
+
+	values := make([][]datatable.Value, len(data))
+	for i, row := range data {
+		if len(row) != len(headers) {
+			return nil, fmt.Errorf("row %d has %d columns, expected %d", i, len(row), len(headers))
+		}
+		values[i] = make([]datatable.Value, len(row))
+		for j, cell := range row {
+			value, err := typedValue(cell, types[j])
+			if err != nil {
+				return nil, fmt.Errorf("row %d, column %s: %w", i, headers[j], err)
+			}
+			values[i][j] = value
+		}
+	}
+
+	return &MemoryDataSource{
+		data:        values,
+		columnNames: headers,
+		columnTypes: types,
+		metadata:    make(datatable.Metadata),
+	}, nil
+}
+
+// typedValue converts v to a Value of dataType, normalizing the Raw type
+// as described by NewTypedDataSource.
+func typedValue(v any, dataType datatable.DataType) (datatable.Value, error) {
+	if v == nil {
+		return datatable.NewNullValue(dataType), nil
+	}
+
+	var raw any
+	switch dataType {
+	case datatable.TypeInt:
+		switch val := v.(type) {
+		case int:
+			raw = int64(val)
+		case int8:
+			raw = int64(val)
+		case int16:
+			raw = int64(val)
+		case int32:
+			raw = int64(val)
+		case int64:
+			raw = val
+		case uint8:
+			raw = int64(val)
+		case uint16:
+			raw = int64(val)
+		case uint32:
+			raw = int64(val)
+		}
+	case datatable.TypeFloat:
+		switch val := v.(type) {
+		case float32:
+			raw = float64(val)
+		case float64:
+			raw = val
+		case int:
+			raw = float64(val)
+		case int32:
+			raw = float64(val)
+		case int64:
+			raw = float64(val)
+		}
+	case datatable.TypeBool:
+		if val, ok := v.(bool); ok {
+			raw = val
+		}
+	case datatable.TypeString:
+		if val, ok := v.(string); ok {
+			raw = val
+		}
+	case datatable.TypeDate, datatable.TypeTimestamp, datatable.TypeTime:
+		if val, ok := v.(time.Time); ok {
+			raw = val
+		}
+	case datatable.TypeDuration:
+		if val, ok := v.(time.Duration); ok {
+			raw = val
+		}
+	default:
+		raw = v
+	}
+
+	if raw == nil {
+		return datatable.Value{}, fmt.Errorf("cannot use %T value %v as %s", v, v, dataType)
+	}
+	return datatable.NewValue(raw, dataType), nil
+}
+
 // RowCount returns the total number of rows.
 func (m *MemoryDataSource) RowCount() int {
 	m.mu.RLock()
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
 func TestNewDataSource(t *testing.T) {
@@ -85,6 +86,77 @@ func TestNewDataSource(t *testing.T) {
 	}
 }
 
+func TestNewTypedDataSource(t *testing.T) {
+	headers := []string{"Name", "Age", "Score", "Active"}
+	types := []datatable.DataType{datatable.TypeString, datatable.TypeInt, datatable.TypeFloat, datatable.TypeBool}
+
+	ds, err := NewTypedDataSource([][]any{
+		{"Alice", 30, 91.5, true},
+		{"Bob", int32(25), 80, nil},
+		{nil, nil, nil, false},
+	}, headers, types)
+	if err != nil {
+		t.Fatalf("NewTypedDataSource() error = %v", err)
+	}
+
+	for col, want := range types {
+		if got, _ := ds.ColumnType(col); got != want {
+			t.Errorf("ColumnType(%d) = %v, want %v", col, got, want)
+		}
+	}
+
+	tests := []struct {
+		row, col int
+		want     any
+	}{
+		{0, 0, "Alice"},
+		{0, 1, int64(30)},
+		{1, 1, int64(25)},
+		{0, 2, 91.5},
+		{1, 2, float64(80)}, // Integers are accepted in float columns
+		{0, 3, true},
+		{2, 3, false},
+	}
+	for _, tt := range tests {
+		cell, err := ds.Cell(tt.row, tt.col)
+		if err != nil {
+			t.Fatalf("Cell(%d, %d) error = %v", tt.row, tt.col, err)
+		}
+		if cell.Raw != tt.want || cell.IsNull {
+			t.Errorf("Cell(%d, %d).Raw = %#v, want %#v", tt.row, tt.col, cell.Raw, tt.want)
+		}
+	}
+
+	// nil entries are real nulls of the column's type
+	for col := range 3 {
+		cell, _ := ds.Cell(2, col)
+		if !cell.IsNull || cell.Raw != nil || cell.Type != types[col] {
+			t.Errorf("Cell(2, %d) = %+v, want a null %v", col, cell, types[col])
+		}
+	}
+
+	errorTests := []struct {
+		name    string
+		data    [][]any
+		headers []string
+		types   []datatable.DataType
+	}{
+		{"no columns", nil, nil, nil},
+		{"types length mismatch", nil, headers, types[:2]},
+		{"short row", [][]any{{"Alice", 30, 91.5}}, headers, types},
+		{"long row", [][]any{{"Alice", 30, 91.5, true, "x"}}, headers, types},
+		{"wrong type", [][]any{{"Alice", "30", 91.5, true}}, headers, types},
+		{"float in int column", [][]any{{"Alice", 30.5, 91.5, true}}, headers, types},
+	}
+	for _, tt := range errorTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTypedDataSource(tt.data, tt.headers, tt.types); err == nil {
+				t.Error("NewTypedDataSource() expected error")
+			}
+		})
+	}
+}
+
 func TestMemoryDataSource_ColumnName(t *testing.T) {
 	ds, _ := NewDataSource(
 		[][]string{{"Alice", "30"}},
@@ -286,3 +358,33 @@ func TestMemoryDataSource_ErrorTypes(t *testing.T) {
 		t.Errorf("Expected ErrInvalidRow, got %v", err)
 	}
 }
+
+func TestMemoryDataSource_ValidateSource(t *testing.T) {
+	data := make([][]string, 250)
+	for i := range data {
+		data[i] = []string{"name", "30", "role"}
+	}
+
+	for _, rows := range [][][]string{data, {}} {
+		ds, err := NewDataSource(rows, []string{"Name", "Age", "Role"})
+		if err != nil {
+			t.Fatalf("NewDataSource() error = %v", err)
+		}
+		if err := datatable.ValidateSource(ds); err != nil {
+			t.Errorf("ValidateSource() with %d rows error = %v", len(rows), err)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	datatabletest.RunConformance(t, func() datatable.DataSource {
+		ds, err := NewDataSource(
+			[][]string{{"Alice", "30"}, {"Bob", "25"}, {"Charlie", "35"}},
+			[]string{"Name", "Age"},
+		)
+		if err != nil {
+			t.Fatalf("NewDataSource() error = %v", err)
+		}
+		return ds
+	})
+}
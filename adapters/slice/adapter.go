@@ -33,7 +33,11 @@ type SliceDataSource struct {
 }
 
 // NewFromInterfaces creates a DataSource from [][]any.
-// Column names must be provided. Types are inferred from data.
+// Column names must be provided. Each column's type is inferred from all of
+// its non-nil values: a column mixing integers and floats is a float column,
+// and a column mixing otherwise incompatible types is a string column holding
+// the formatted values. A column that is entirely nil is a string column.
+// Nil cells become null values.
 func NewFromInterfaces(data [][]any, columnNames []string) (*SliceDataSource, error) {
 	if data == nil {
 		return nil, fmt.Errorf("data cannot be nil")
@@ -127,7 +131,100 @@ func NewFromMaps(data []map[string]any) (*SliceDataSource, error) {
 	return NewFromInterfaces(rows, columnNames)
 }
 
+// NewFromColumns creates a DataSource from column slices keyed by name.
+// Columns appear in the order given by order, which must list every key of
+// columns exactly once. All columns must have the same length.
+//
+// Each column's type is taken from its non-null values, which must all
+// convert to the same type, except that integers and floats may be mixed in a
+// float column. Any other mismatch returns an error wrapping
+// datatable.ErrTypeMismatch. Null cells get the column's type. A column of
+// only nulls is a string column.
+func NewFromColumns(columns map[string][]any, order []string) (*SliceDataSource, error) {
+	if len(order) == 0 {
+		return nil, fmt.Errorf("column order cannot be empty")
+	}
+	if len(columns) != len(order) {
+		return nil, fmt.Errorf("column order lists %d columns, data has %d", len(order), len(columns))
+	}
+
+	rowCount := -1
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		values, ok := columns[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", datatable.ErrColumnNotFound, name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate column %q in order", name)
+		}
+		seen[name] = true
+
+		if rowCount < 0 {
+			rowCount = len(values)
+		} else if len(values) != rowCount {
+			return nil, fmt.Errorf("inconsistent length for column %q: expected %d, got %d",
+				name, rowCount, len(values))
+		}
+	}
+
+	valueData := make([][]datatable.Value, rowCount)
+	for i := range valueData {
+		valueData[i] = make([]datatable.Value, len(order))
+	}
+
+	columnTypes := make([]datatable.DataType, len(order))
+	for col, name := range order {
+		colType, err := convertColumn(columns[name], col, valueData)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", name, err)
+		}
+		columnTypes[col] = colType
+	}
+
+	return &SliceDataSource{
+		data:        valueData,
+		columnNames: append([]string(nil), order...),
+		columnTypes: columnTypes,
+		metadata:    make(datatable.Metadata),
+	}, nil
+}
+
+// convertColumn converts values into column col of data and returns the
+// column's type.
+func convertColumn(values []any, col int, data [][]datatable.Value) (datatable.DataType, error) {
+	colType := datatable.TypeString
+	typed := false
+
+	for row, v := range values {
+		value := convertToValue(v)
+		data[row][col] = value
+		if value.IsNull {
+			continue
+		}
+		if !typed {
+			colType, typed = value.Type, true
+			continue
+		}
+		unified, ok := unifyTypes(colType, value.Type)
+		if !ok {
+			return colType, fmt.Errorf("%w: row %d is %s, column is %s",
+				datatable.ErrTypeMismatch, row, value.Type, colType)
+		}
+		colType = unified
+	}
+
+	for row := range values {
+		data[row][col] = coerceValue(data[row][col], colType)
+	}
+
+	return colType, nil
+}
+
 // convertToValue converts an any to a Value with type inference.
+// Integers are stored as int64 (uint64 as uint64), floats as float32 or
+// float64 and booleans as bool. Unknown types fall back to their string
+// representation.
 func convertToValue(v any) datatable.Value {
 	if v == nil {
 		return datatable.NewNullValue(datatable.TypeString)
@@ -137,58 +234,101 @@ func convertToValue(v any) datatable.Value {
 	case string:
 		return datatable.NewValue(val, datatable.TypeString)
 	case int:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(int64(val), datatable.TypeInt)
 	case int32:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(int64(val), datatable.TypeInt)
 	case int64:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(val, datatable.TypeInt)
 	case uint:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(uint64(val), datatable.TypeInt)
 	case uint32:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(int64(val), datatable.TypeInt)
 	case uint64:
-		return datatable.NewValue(fmt.Sprintf("%d", val), datatable.TypeInt)
+		return datatable.NewValue(val, datatable.TypeInt)
 	case float32:
-		return datatable.NewValue(fmt.Sprintf("%f", val), datatable.TypeFloat)
+		return datatable.NewValue(val, datatable.TypeFloat)
 	case float64:
-		return datatable.NewValue(fmt.Sprintf("%f", val), datatable.TypeFloat)
+		return datatable.NewValue(val, datatable.TypeFloat)
 	case bool:
-		return datatable.NewValue(fmt.Sprintf("%t", val), datatable.TypeBool)
+		return datatable.NewValue(val, datatable.TypeBool)
 	default:
 		// Fallback to string representation
 		return datatable.NewValue(fmt.Sprintf("%v", val), datatable.TypeString)
 	}
 }
 
-// inferTypes infers column types from the data.
+// unifyTypes returns the column type that holds values of types a and b:
+// the type itself when they are equal, and TypeFloat for a mix of TypeInt
+// and TypeFloat. ok is false for any other combination.
+func unifyTypes(a, b datatable.DataType) (datatable.DataType, bool) {
+	switch {
+	case a == b:
+		return a, true
+	case a == datatable.TypeInt && b == datatable.TypeFloat,
+		a == datatable.TypeFloat && b == datatable.TypeInt:
+		return datatable.TypeFloat, true
+	}
+	return datatable.TypeString, false
+}
+
+// coerceValue converts value to colType. Nulls take the column's type,
+// integers in a float column become float64 and values in a string column
+// keep their formatted text. Other values are returned unchanged.
+func coerceValue(value datatable.Value, colType datatable.DataType) datatable.Value {
+	if value.IsNull {
+		value.Type = colType
+		return value
+	}
+	if value.Type == colType {
+		return value
+	}
+
+	switch colType {
+	case datatable.TypeFloat:
+		switch raw := value.Raw.(type) {
+		case int64:
+			return datatable.NewValue(float64(raw), datatable.TypeFloat)
+		case uint64:
+			return datatable.NewValue(float64(raw), datatable.TypeFloat)
+		}
+	case datatable.TypeString:
+		value.EnsureFormatted()
+		return datatable.NewValue(value.Formatted, datatable.TypeString)
+	}
+	return value
+}
+
+// inferTypes returns the type of each column, scanning all of its non-null
+// values. Integer and float columns are promoted to float and incompatible
+// mixes to string, converting the cells to match. Columns with only null
+// values are string columns. Null cells are given their column's type.
 func inferTypes(data [][]datatable.Value, numCols int) []datatable.DataType {
 	types := make([]datatable.DataType, numCols)
 
-	// Count types for each column
 	for col := 0; col < numCols; col++ {
-		typeCount := make(map[datatable.DataType]int)
-
-		for row := 0; row < len(data); row++ {
-			if col >= len(data[row]) {
+		colType := datatable.TypeString
+		typed := false
+		for row := range data {
+			value := data[row][col]
+			if value.IsNull {
 				continue
 			}
-
-			value := data[row][col]
-			if !value.IsNull {
-				typeCount[value.Type]++
+			if !typed {
+				colType, typed = value.Type, true
+				continue
 			}
+			unified, ok := unifyTypes(colType, value.Type)
+			if !ok {
+				colType = datatable.TypeString
+				break
+			}
+			colType = unified
 		}
 
-		// Use most common type, defaulting to String
-		maxCount := 0
-		types[col] = datatable.TypeString
-
-		for dtype, count := range typeCount {
-			if count > maxCount {
-				maxCount = count
-				types[col] = dtype
-			}
+		for row := range data {
+			data[row][col] = coerceValue(data[row][col], colType)
 		}
+		types[col] = colType
 	}
 
 	return types
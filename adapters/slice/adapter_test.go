@@ -15,9 +15,11 @@
 package slice
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
 func TestNewFromInterfaces_Basic(t *testing.T) {
@@ -111,6 +113,103 @@ func TestNewFromInterfaces_NilValues(t *testing.T) {
 	}
 }
 
+func TestNewFromInterfaces_NilInColumn(t *testing.T) {
+	data := [][]any{
+		{"Alice", 30, nil},
+		{"Bob", nil, nil},
+		{"Charlie", 35, nil},
+		{"Dana", 28, nil},
+	}
+	headers := []string{"Name", "Age", "Notes"}
+
+	source, err := NewFromInterfaces(data, headers)
+	if err != nil {
+		t.Fatalf("NewFromInterfaces failed: %v", err)
+	}
+
+	// Nil in the middle is a null of the column's type
+	cell, err := source.Cell(1, 1)
+	if err != nil {
+		t.Fatalf("Cell(1,1) error: %v", err)
+	}
+	if !cell.IsNull || cell.Type != datatable.TypeInt {
+		t.Errorf("Cell(1,1) = %+v, want null int", cell)
+	}
+
+	// Age takes its type from the non-nil values
+	if colType, _ := source.ColumnType(1); colType != datatable.TypeInt {
+		t.Errorf("ColumnType(1) = %v, want %v", colType, datatable.TypeInt)
+	}
+
+	// An all-nil column defaults to string
+	if colType, _ := source.ColumnType(2); colType != datatable.TypeString {
+		t.Errorf("ColumnType(2) = %v, want %v", colType, datatable.TypeString)
+	}
+}
+
+func TestNewFromInterfaces_MixedNumeric(t *testing.T) {
+	data := [][]any{
+		{"Alice", 30},
+		{"Bob", 25.5},
+		{"Charlie", nil},
+		{"Dana", int64(35)},
+	}
+	headers := []string{"Name", "Age"}
+
+	source, err := NewFromInterfaces(data, headers)
+	if err != nil {
+		t.Fatalf("NewFromInterfaces failed: %v", err)
+	}
+
+	if colType, _ := source.ColumnType(1); colType != datatable.TypeFloat {
+		t.Errorf("ColumnType(1) = %v, want %v", colType, datatable.TypeFloat)
+	}
+
+	want := []float64{30, 25.5, 0, 35}
+	for row, expected := range want {
+		cell, err := source.Cell(row, 1)
+		if err != nil {
+			t.Fatalf("Cell(%d,1) error: %v", row, err)
+		}
+		if cell.Type != datatable.TypeFloat {
+			t.Errorf("Cell(%d,1) type = %v, want %v", row, cell.Type, datatable.TypeFloat)
+		}
+		if row == 2 {
+			if !cell.IsNull {
+				t.Errorf("Cell(2,1) = %+v, want null", cell)
+			}
+			continue
+		}
+		if raw, ok := cell.Raw.(float64); !ok || raw != expected {
+			t.Errorf("Cell(%d,1) raw = %#v, want float64 %v", row, cell.Raw, expected)
+		}
+	}
+}
+
+func TestNewFromInterfaces_IncompatibleTypes(t *testing.T) {
+	data := [][]any{
+		{30},
+		{"unknown"},
+		{true},
+	}
+
+	source, err := NewFromInterfaces(data, []string{"Age"})
+	if err != nil {
+		t.Fatalf("NewFromInterfaces failed: %v", err)
+	}
+
+	if colType, _ := source.ColumnType(0); colType != datatable.TypeString {
+		t.Errorf("ColumnType(0) = %v, want %v", colType, datatable.TypeString)
+	}
+
+	for row, expected := range []string{"30", "unknown", "true"} {
+		cell, _ := source.Cell(row, 0)
+		if cell.Type != datatable.TypeString || cell.Raw != expected {
+			t.Errorf("Cell(%d,0) = %+v, want string %q", row, cell, expected)
+		}
+	}
+}
+
 func TestNewFromInterfaces_InconsistentColumns(t *testing.T) {
 	data := [][]any{
 		{"Alice", 30, "Engineer"},
@@ -200,6 +299,97 @@ func TestNewFromMaps_NilData(t *testing.T) {
 	}
 }
 
+func TestNewFromColumns(t *testing.T) {
+	columns := map[string][]any{
+		"Name":   {"Alice", "Bob", nil},
+		"Age":    {nil, 25, int64(35)},
+		"Score":  {91.5, 78.0, 88.25},
+		"Active": {true, false, true},
+		"Notes":  {nil, nil, nil},
+	}
+	order := []string{"Name", "Age", "Score", "Active", "Notes"}
+
+	source, err := NewFromColumns(columns, order)
+	if err != nil {
+		t.Fatalf("NewFromColumns failed: %v", err)
+	}
+
+	if source.RowCount() != 3 || source.ColumnCount() != 5 {
+		t.Fatalf("size = %dx%d, want 3x5", source.RowCount(), source.ColumnCount())
+	}
+
+	wantTypes := []datatable.DataType{
+		datatable.TypeString, datatable.TypeInt, datatable.TypeFloat, datatable.TypeBool, datatable.TypeString,
+	}
+	for i, name := range order {
+		gotName, _ := source.ColumnName(i)
+		if gotName != name {
+			t.Errorf("ColumnName(%d) = %s, want %s", i, gotName, name)
+		}
+		gotType, _ := source.ColumnType(i)
+		if gotType != wantTypes[i] {
+			t.Errorf("ColumnType(%d) = %v, want %v", i, gotType, wantTypes[i])
+		}
+	}
+
+	// Nulls carry their column's type rather than a per-cell default
+	cell, _ := source.Cell(0, 1)
+	if !cell.IsNull || cell.Type != datatable.TypeInt {
+		t.Errorf("Cell(0,1) = %+v, want null int", cell)
+	}
+	cell, _ = source.Cell(2, 1)
+	if cell.Formatted != "35" || cell.Type != datatable.TypeInt {
+		t.Errorf("Cell(2,1) = %+v, want int 35", cell)
+	}
+}
+
+func TestNewFromColumns_MixedNumeric(t *testing.T) {
+	source, err := NewFromColumns(map[string][]any{"Score": {1, 2.5, nil}}, []string{"Score"})
+	if err != nil {
+		t.Fatalf("NewFromColumns failed: %v", err)
+	}
+
+	if colType, _ := source.ColumnType(0); colType != datatable.TypeFloat {
+		t.Errorf("ColumnType(0) = %v, want %v", colType, datatable.TypeFloat)
+	}
+	cell, _ := source.Cell(0, 0)
+	if raw, ok := cell.Raw.(float64); !ok || raw != 1 {
+		t.Errorf("Cell(0,0) raw = %#v, want float64 1", cell.Raw)
+	}
+	cell, _ = source.Cell(2, 0)
+	if !cell.IsNull || cell.Type != datatable.TypeFloat {
+		t.Errorf("Cell(2,0) = %+v, want null float", cell)
+	}
+}
+
+func TestNewFromColumns_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns map[string][]any
+		order   []string
+		wantErr error
+	}{
+		{"empty order", map[string][]any{"A": {1}}, nil, nil},
+		{"missing column", map[string][]any{"A": {1}, "B": {2}}, []string{"A", "C"}, datatable.ErrColumnNotFound},
+		{"unlisted column", map[string][]any{"A": {1}, "B": {2}}, []string{"A"}, nil},
+		{"duplicate column", map[string][]any{"A": {1}, "B": {2}}, []string{"A", "A"}, nil},
+		{"ragged columns", map[string][]any{"A": {1, 2}, "B": {3}}, []string{"A", "B"}, nil},
+		{"mixed types", map[string][]any{"A": {1, "two"}}, []string{"A"}, datatable.ErrTypeMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFromColumns(tt.columns, tt.order)
+			if err == nil {
+				t.Fatal("Expected error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestSliceDataSource_Cell(t *testing.T) {
 	data := [][]any{
 		{"Alice", 30},
@@ -366,3 +556,16 @@ func TestConvertToValue_Nil(t *testing.T) {
 		t.Error("convertToValue(nil) should create null value")
 	}
 }
+
+func TestConformance(t *testing.T) {
+	datatabletest.RunConformance(t, func() datatable.DataSource {
+		source, err := NewFromInterfaces(
+			[][]any{{"Alice", 30, nil}, {nil, 25, "Engineer"}},
+			[]string{"Name", "Age", "Role"},
+		)
+		if err != nil {
+			t.Fatalf("NewFromInterfaces() error = %v", err)
+		}
+		return source
+	})
+}
@@ -101,6 +101,55 @@ func (b *BaseAggregateFunction) SetSupportsGrouped(supported bool) {
 	b.supportsGrouped = supported
 }
 
+// BaseBinaryFunction provides common functionality for BinaryFunction implementations.
+type BaseBinaryFunction struct {
+	name        string
+	description string
+	inputTypes  []arrow.DataType
+}
+
+// NewBaseBinaryFunction creates a new base binary function. Both inputs must
+// be one of inputTypes; an empty slice accepts any type.
+func NewBaseBinaryFunction(name, description string, inputTypes []arrow.DataType) BaseBinaryFunction {
+	return BaseBinaryFunction{
+		name:        name,
+		description: description,
+		inputTypes:  inputTypes,
+	}
+}
+
+// Name returns the function name.
+func (b *BaseBinaryFunction) Name() string {
+	return b.name
+}
+
+// Description returns the function description.
+func (b *BaseBinaryFunction) Description() string {
+	return b.description
+}
+
+// Validate checks that both input types are acceptable for this function.
+func (b *BaseBinaryFunction) Validate(leftType, rightType arrow.DataType) error {
+	if len(b.inputTypes) == 0 {
+		return nil
+	}
+
+	for _, inputType := range []arrow.DataType{leftType, rightType} {
+		supported := false
+		for _, acceptable := range b.inputTypes {
+			if arrow.TypeEqual(inputType, acceptable) {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("function %q does not support input type %v", b.name, inputType)
+		}
+	}
+
+	return nil
+}
+
 // Helper functions for type checking
 
 // IsNumericType checks if an Arrow type is numeric.
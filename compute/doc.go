@@ -92,6 +92,14 @@ Functions are organized into categories:
   - Boolean: and, or, not (logical operations)
   - Comparison: eq, ne, gt, lt (value comparisons)
 
+# Binary Functions
+
+Functions that take two arrays implement BinaryFunction and are registered
+separately with MustRegisterBinary and looked up with GetBinary:
+
+	corrFn, err := compute.GetBinary("corr")
+	result, err := corrFn.Execute(left, right, memory.NewGoAllocator())
+
 # Thread Safety
 
 The FunctionRegistry is thread-safe and can be used concurrently from
@@ -17,18 +17,24 @@ package functions
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	computepkg "github.com/magpierre/fyne-datatable/compute"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
 )
 
 // CastFunction converts array to different data type.
 type CastFunction struct {
 	computepkg.BaseVectorFunction
 	targetType arrow.DataType
+	grouping   bool         // Group digits when casting numbers to string
+	locale     language.Tag // Locale for digit grouping
 }
 
 // NewCastFunction creates a new cast function for a specific target type.
@@ -41,9 +47,26 @@ func NewCastFunction(targetType arrow.DataType, name string) *CastFunction {
 			[]arrow.DataType{}, // Accept any type
 		),
 		targetType: targetType,
+		locale:     language.English,
 	}
 }
 
+// SetGrouping turns thousands separators on or off for numbers cast to
+// string, so 1234567 becomes "1,234,567". It is off by default and has no
+// effect on casts to other types. Separators follow the locale set with
+// SetLocale (English by default).
+//
+// The cast functions in the registry are shared; create a function with
+// NewCastFunction to group digits without affecting other users.
+func (f *CastFunction) SetGrouping(enabled bool) {
+	f.grouping = enabled
+}
+
+// SetLocale sets the locale whose separators are used when grouping digits.
+func (f *CastFunction) SetLocale(tag language.Tag) {
+	f.locale = tag
+}
+
 // OutputType returns the target type.
 func (f *CastFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
 	return f.targetType, nil
@@ -51,6 +74,9 @@ func (f *CastFunction) OutputType(inputType arrow.DataType) (arrow.DataType, err
 
 // Execute performs the type cast.
 func (f *CastFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if f.grouping && f.targetType.ID() == arrow.STRING {
+		return castToGroupedString(input, f.locale, mem)
+	}
 	return performCast(input, f.targetType, mem)
 }
 
@@ -100,6 +126,60 @@ func init() {
 	))
 }
 
+// castToGroupedString casts a numeric array to strings with the digits
+// grouped by the separators of locale.
+func castToGroupedString(input arrow.Array, locale language.Tag, mem memory.Allocator) (arrow.Array, error) {
+	builder := array.NewStringBuilder(mem)
+	defer builder.Release()
+
+	printer := message.NewPrinter(locale)
+	for i := 0; i < input.Len(); i++ {
+		if input.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		var value any
+		switch arr := input.(type) {
+		case *array.Int64:
+			value = arr.Value(i)
+		case *array.Float64:
+			value = arr.Value(i)
+		default:
+			return nil, fmt.Errorf("cast from %v to grouped string not implemented", input.DataType())
+		}
+		builder.Append(formatGrouped(printer, value))
+	}
+	return builder.NewArray(), nil
+}
+
+// FormatGrouped formats a number with the thousands separators of locale,
+// e.g. 1234567.5 as "1,234,567.5" in English. Floats keep all the digits
+// of their shortest representation. Non-numeric values are formatted
+// with %v.
+func FormatGrouped(value any, locale language.Tag) string {
+	return formatGrouped(message.NewPrinter(locale), value)
+}
+
+// formatGrouped formats value with printer's digit grouping.
+func formatGrouped(printer *message.Printer, value any) string {
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return printer.Sprint(number.Decimal(v))
+	case float32:
+		return formatGrouped(printer, float64(v))
+	case float64:
+		// Keep the digits strconv would show rather than rounding to the
+		// printer's default of three decimals
+		digits := 0
+		if _, frac, ok := strings.Cut(strconv.FormatFloat(v, 'f', -1, 64), "."); ok {
+			digits = len(frac)
+		}
+		return printer.Sprint(number.Decimal(v, number.MaxFractionDigits(digits)))
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
 // performCast performs the actual type conversion
 func performCast(input arrow.Array, targetType arrow.DataType, mem memory.Allocator) (arrow.Array, error) {
 	targetID := targetType.ID()
@@ -17,9 +17,11 @@ package functions
 import (
 	"testing"
 
+	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	computepkg "github.com/magpierre/fyne-datatable/compute"
+	"golang.org/x/text/language"
 )
 
 func TestCastIntToFloat(t *testing.T) {
@@ -171,3 +173,63 @@ func TestCastBool(t *testing.T) {
 		}
 	}
 }
+
+func TestGroupedCastString(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	intBuilder := array.NewInt64Builder(mem)
+	defer intBuilder.Release()
+	intBuilder.AppendValues([]int64{1234567, -9876, 12}, nil)
+	intBuilder.AppendNull()
+	ints := intBuilder.NewArray()
+	defer ints.Release()
+
+	floatBuilder := array.NewFloat64Builder(mem)
+	defer floatBuilder.Release()
+	floatBuilder.AppendValues([]float64{1234567.25, 0.125}, nil)
+	floats := floatBuilder.NewArray()
+	defer floats.Release()
+
+	fn := NewCastFunction(arrow.BinaryTypes.String, "cast_string")
+	cast := func(arr arrow.Array) *array.String {
+		t.Helper()
+		result, err := fn.Execute(arr, mem, false)
+		if err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+		t.Cleanup(result.Release)
+		return result.(*array.String)
+	}
+
+	// Off by default
+	if got := cast(ints).Value(0); got != "1234567" {
+		t.Errorf("Expected plain %q, got %q", "1234567", got)
+	}
+
+	fn.SetGrouping(true)
+	strArr := cast(ints)
+	for i, exp := range []string{"1,234,567", "-9,876", "12"} {
+		if strArr.Value(i) != exp {
+			t.Errorf("Expected %q at index %d, got %q", exp, i, strArr.Value(i))
+		}
+	}
+	if !strArr.IsNull(3) {
+		t.Error("Expected null to stay null")
+	}
+	strArr = cast(floats)
+	for i, exp := range []string{"1,234,567.25", "0.125"} {
+		if strArr.Value(i) != exp {
+			t.Errorf("Expected %q at index %d, got %q", exp, i, strArr.Value(i))
+		}
+	}
+
+	fn.SetLocale(language.German)
+	if got := cast(floats).Value(0); got != "1.234.567,25" {
+		t.Errorf("Expected German grouping %q, got %q", "1.234.567,25", got)
+	}
+
+	fn.SetGrouping(false)
+	if got := cast(ints).Value(0); got != "1234567" {
+		t.Errorf("Expected plain %q after turning grouping off, got %q", "1234567", got)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+// CorrFunction computes the Pearson correlation coefficient of two numeric arrays.
+type CorrFunction struct {
+	computepkg.BaseBinaryFunction
+}
+
+func init() {
+	computepkg.MustRegisterBinary(NewCorrFunction())
+}
+
+// NewCorrFunction creates a new correlation function.
+func NewCorrFunction() *CorrFunction {
+	return &CorrFunction{
+		BaseBinaryFunction: computepkg.NewBaseBinaryFunction(
+			"corr",
+			"Compute Pearson correlation of two columns",
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns float64 for correlation.
+func (f *CorrFunction) OutputType(leftType, rightType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(leftType, rightType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Float64, nil
+}
+
+// Execute computes the correlation and returns a single-element Float64 array.
+// Rows where either side is null are skipped. The result is null when fewer
+// than two pairs remain or either side has zero variance.
+func (f *CorrFunction) Execute(left, right arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	if err := f.Validate(left.DataType(), right.DataType()); err != nil {
+		return nil, err
+	}
+	if left.Len() != right.Len() {
+		return nil, fmt.Errorf("corr requires arrays of equal length, got %d and %d", left.Len(), right.Len())
+	}
+
+	corrVal, err := computeCorr(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+
+	if corrVal != nil {
+		builder.Append(corrVal.(float64))
+	} else {
+		builder.AppendNull()
+	}
+
+	return builder.NewArray(), nil
+}
+
+// computeCorr computes the Pearson correlation over pairwise non-null values.
+// Returns nil when the correlation is undefined.
+func computeCorr(left, right arrow.Array) (any, error) {
+	var xs, ys []float64
+	for i := 0; i < left.Len(); i++ {
+		if left.IsNull(i) || right.IsNull(i) {
+			continue
+		}
+		x, err := numericValue(left, i)
+		if err != nil {
+			return nil, err
+		}
+		y, err := numericValue(right, i)
+		if err != nil {
+			return nil, err
+		}
+		xs = append(xs, x)
+		ys = append(ys, y)
+	}
+
+	n := float64(len(xs))
+	if len(xs) < 2 {
+		return nil, nil
+	}
+
+	var meanX, meanY float64
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= n
+	meanY /= n
+
+	var cov, varX, varY float64
+	for i := range xs {
+		dx := xs[i] - meanX
+		dy := ys[i] - meanY
+		cov += dx * dy
+		varX += dx * dx
+		varY += dy * dy
+	}
+
+	if varX == 0 || varY == 0 {
+		return nil, nil
+	}
+
+	return cov / math.Sqrt(varX*varY), nil
+}
+
+// numericValue returns element i of a numeric array as float64.
+func numericValue(arr arrow.Array, i int) (float64, error) {
+	switch a := arr.(type) {
+	case *array.Int8:
+		return float64(a.Value(i)), nil
+	case *array.Int16:
+		return float64(a.Value(i)), nil
+	case *array.Int32:
+		return float64(a.Value(i)), nil
+	case *array.Int64:
+		return float64(a.Value(i)), nil
+	case *array.Uint8:
+		return float64(a.Value(i)), nil
+	case *array.Uint16:
+		return float64(a.Value(i)), nil
+	case *array.Uint32:
+		return float64(a.Value(i)), nil
+	case *array.Uint64:
+		return float64(a.Value(i)), nil
+	case *array.Float32:
+		return float64(a.Value(i)), nil
+	case *array.Float64:
+		return a.Value(i), nil
+	default:
+		return 0, fmt.Errorf("unsupported numeric type: %v", arr.DataType())
+	}
+}
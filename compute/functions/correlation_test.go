@@ -0,0 +1,132 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+// executeCorr runs the registered corr function over two float64 slices.
+// A false entry in valid marks the left value as null.
+func executeCorr(t *testing.T, left, right []float64, valid []bool) *array.Float64 {
+	t.Helper()
+	mem := memory.NewGoAllocator()
+
+	leftBuilder := array.NewFloat64Builder(mem)
+	defer leftBuilder.Release()
+	leftBuilder.AppendValues(left, valid)
+	leftArr := leftBuilder.NewArray()
+	defer leftArr.Release()
+
+	rightBuilder := array.NewFloat64Builder(mem)
+	defer rightBuilder.Release()
+	rightBuilder.AppendValues(right, nil)
+	rightArr := rightBuilder.NewArray()
+	defer rightArr.Release()
+
+	fn, err := computepkg.GetBinary("corr")
+	if err != nil {
+		t.Fatalf("Failed to get corr function: %v", err)
+	}
+
+	result, err := fn.Execute(leftArr, rightArr, mem)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if result.Len() != 1 {
+		t.Fatalf("Expected 1 element, got %d", result.Len())
+	}
+	return result.(*array.Float64)
+}
+
+func TestCorrFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		left     []float64
+		right    []float64
+		expected float64
+	}{
+		{"perfectly correlated", []float64{1, 2, 3, 4}, []float64{2, 4, 6, 8}, 1.0},
+		{"anti-correlated", []float64{1, 2, 3, 4}, []float64{8, 6, 4, 2}, -1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := executeCorr(t, tt.left, tt.right, nil)
+			defer result.Release()
+
+			if result.IsNull(0) {
+				t.Fatal("Expected non-null correlation")
+			}
+			if got := result.Value(0); math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Expected corr %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCorrFunctionSkipsNullPairs(t *testing.T) {
+	// The middle pair would break the correlation if it were used
+	result := executeCorr(t,
+		[]float64{1, 100, 2, 3},
+		[]float64{1, -50, 2, 3},
+		[]bool{true, false, true, true},
+	)
+	defer result.Release()
+
+	if got := result.Value(0); math.Abs(got-1.0) > 1e-9 {
+		t.Errorf("Expected corr 1.0, got %v", got)
+	}
+}
+
+func TestCorrFunctionUndefined(t *testing.T) {
+	tests := []struct {
+		name  string
+		left  []float64
+		right []float64
+		valid []bool
+	}{
+		{"fewer than two pairs", []float64{1, 2}, []float64{3, 4}, []bool{true, false}},
+		{"zero variance", []float64{5, 5, 5}, []float64{1, 2, 3}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := executeCorr(t, tt.left, tt.right, tt.valid)
+			defer result.Release()
+
+			if !result.IsNull(0) {
+				t.Errorf("Expected null correlation, got %v", result.Value(0))
+			}
+		})
+	}
+}
+
+func TestCorrFunctionValidation(t *testing.T) {
+	fn := NewCorrFunction()
+
+	if _, err := fn.OutputType(arrow.PrimitiveTypes.Int64, arrow.PrimitiveTypes.Float64); err != nil {
+		t.Errorf("Expected numeric inputs to be valid, got %v", err)
+	}
+	if err := fn.Validate(arrow.BinaryTypes.String, arrow.PrimitiveTypes.Float64); err == nil {
+		t.Error("Expected error for string input")
+	}
+}
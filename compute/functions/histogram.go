@@ -0,0 +1,196 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+// defaultHistogramBins is the bin count used until SetBins is called.
+const defaultHistogramBins = 10
+
+// HistogramFunction counts the values of a numeric array per bin.
+//
+// Every bin includes its lower edge and excludes its upper edge, except the
+// last bin which includes both, so the maximum value is always counted.
+// Nulls are excluded. With equal-width bins the range is the data's min to
+// max; with explicit edges, values outside the edges are not counted.
+type HistogramFunction struct {
+	computepkg.BaseVectorFunction
+
+	mu            sync.Mutex
+	bins          int
+	explicitEdges []float64 // Set by SetEdges; nil for equal-width bins
+	edges         []float64 // Edges used by the last Execute
+}
+
+func init() {
+	computepkg.MustRegister(NewHistogramFunction())
+}
+
+// NewHistogramFunction creates a new histogram function with 10 equal-width bins.
+func NewHistogramFunction() *HistogramFunction {
+	return &HistogramFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"histogram",
+			"Count values per equal-width bin",
+			computepkg.CategoryAggregate,
+			computepkg.NumericTypes(),
+		),
+		bins: defaultHistogramBins,
+	}
+}
+
+// SetBins sets the number of equal-width bins, replacing any explicit edges.
+// Returns an error if n is not positive.
+func (f *HistogramFunction) SetBins(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("histogram bins must be positive, got %d", n)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.bins = n
+	f.explicitEdges = nil
+	return nil
+}
+
+// SetEdges sets explicit bin edges, giving len(edges)-1 bins.
+// Edges must be strictly increasing and at least two long.
+func (f *HistogramFunction) SetEdges(edges []float64) error {
+	if len(edges) < 2 {
+		return fmt.Errorf("histogram needs at least 2 edges, got %d", len(edges))
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i] <= edges[i-1] {
+			return fmt.Errorf("histogram edges must be strictly increasing")
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.explicitEdges = append([]float64(nil), edges...)
+	f.bins = len(edges) - 1
+	return nil
+}
+
+// Edges returns the bin edges (bins+1 values) used by the last Execute,
+// or the explicit edges if Execute has not run since SetEdges.
+func (f *HistogramFunction) Edges() []float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.edges != nil {
+		return append([]float64(nil), f.edges...)
+	}
+	return append([]float64(nil), f.explicitEdges...)
+}
+
+// OutputType returns int64 for bin counts.
+func (f *HistogramFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Int64, nil
+}
+
+// Execute returns an Int64 array with the count of values in each bin.
+func (f *HistogramFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+
+	values := make([]float64, 0, input.Len()-input.NullN())
+	for i := 0; i < input.Len(); i++ {
+		if input.IsNull(i) {
+			continue
+		}
+		v, err := numericValue(input, i)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	edges := f.explicitEdges
+	if edges == nil {
+		edges = equalWidthEdges(values, f.bins)
+	}
+	f.edges = append([]float64(nil), edges...)
+
+	counts := make([]int64, len(edges)-1)
+	for _, v := range values {
+		if bin := binIndex(edges, v); bin >= 0 {
+			counts[bin]++
+		}
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues(counts, nil)
+
+	return builder.NewArray(), nil
+}
+
+// equalWidthEdges splits the range of values into n equal-width bins.
+// A single distinct value (or no values) gets a unit-wide range around it.
+func equalWidthEdges(values []float64, n int) []float64 {
+	var lo, hi float64
+	for i, v := range values {
+		if i == 0 || v < lo {
+			lo = v
+		}
+		if i == 0 || v > hi {
+			hi = v
+		}
+	}
+	if lo == hi {
+		lo -= 0.5
+		hi += 0.5
+	}
+
+	edges := make([]float64, n+1)
+	width := (hi - lo) / float64(n)
+	for i := range edges {
+		edges[i] = lo + float64(i)*width
+	}
+	edges[n] = hi // Avoid rounding drift on the last edge
+	return edges
+}
+
+// binIndex returns the bin holding v, or -1 if v is outside the edges.
+func binIndex(edges []float64, v float64) int {
+	last := len(edges) - 1
+	if v < edges[0] || v > edges[last] {
+		return -1
+	}
+	if v == edges[last] {
+		return last - 1
+	}
+	// First edge strictly greater than v closes v's bin
+	return sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+}
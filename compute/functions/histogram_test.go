@@ -0,0 +1,134 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+// executeHistogram runs fn over values and returns the bin counts.
+func executeHistogram(t *testing.T, fn *HistogramFunction, values []float64, valid []bool) []int64 {
+	t.Helper()
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues(values, valid)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	return append([]int64(nil), result.(*array.Int64).Int64Values()...)
+}
+
+func assertCounts(t *testing.T, expected, got []int64) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d bins, got %d (%v)", len(expected), len(got), got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Bin %d: expected %d, got %d", i, expected[i], got[i])
+		}
+	}
+}
+
+func TestHistogramFunction(t *testing.T) {
+	fn := NewHistogramFunction()
+	if err := fn.SetBins(2); err != nil {
+		t.Fatalf("SetBins failed: %v", err)
+	}
+
+	counts := executeHistogram(t, fn, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, nil)
+	assertCounts(t, []int64{5, 5}, counts)
+
+	edges := fn.Edges()
+	expectedEdges := []float64{1, 5.5, 10}
+	if len(edges) != len(expectedEdges) {
+		t.Fatalf("Expected %d edges, got %v", len(expectedEdges), edges)
+	}
+	for i := range expectedEdges {
+		if edges[i] != expectedEdges[i] {
+			t.Errorf("Edge %d: expected %v, got %v", i, expectedEdges[i], edges[i])
+		}
+	}
+}
+
+func TestHistogramFunctionBoundaries(t *testing.T) {
+	// Values on an inner edge go to the upper bin; the max goes to the last bin
+	fn := NewHistogramFunction()
+	fn.SetBins(2)
+	assertCounts(t, []int64{1, 2}, executeHistogram(t, fn, []float64{0, 5, 10}, nil))
+
+	// Explicit edges follow the same rule and drop out-of-range values
+	if err := fn.SetEdges([]float64{0, 5, 10}); err != nil {
+		t.Fatalf("SetEdges failed: %v", err)
+	}
+	assertCounts(t, []int64{1, 2}, executeHistogram(t, fn, []float64{-1, 0, 5, 10, 11}, nil))
+}
+
+func TestHistogramFunctionNulls(t *testing.T) {
+	fn := NewHistogramFunction()
+	fn.SetBins(2)
+
+	counts := executeHistogram(t, fn,
+		[]float64{1, 100, 2, 3, 4},
+		[]bool{true, false, true, true, true},
+	)
+	assertCounts(t, []int64{2, 2}, counts)
+}
+
+func TestHistogramFunctionConstantColumn(t *testing.T) {
+	fn := NewHistogramFunction()
+	fn.SetBins(3)
+
+	assertCounts(t, []int64{0, 4, 0}, executeHistogram(t, fn, []float64{7, 7, 7, 7}, nil))
+}
+
+func TestHistogramFunctionValidation(t *testing.T) {
+	fn := NewHistogramFunction()
+
+	if err := fn.SetBins(0); err == nil {
+		t.Error("Expected error for zero bins")
+	}
+	if err := fn.SetBins(-3); err == nil {
+		t.Error("Expected error for negative bins")
+	}
+	if err := fn.SetEdges([]float64{1}); err == nil {
+		t.Error("Expected error for a single edge")
+	}
+	if err := fn.SetEdges([]float64{0, 5, 5}); err == nil {
+		t.Error("Expected error for non-increasing edges")
+	}
+}
+
+func TestHistogramFunctionRegistered(t *testing.T) {
+	fn, err := computepkg.Get("histogram")
+	if err != nil {
+		t.Fatalf("Failed to get histogram function: %v", err)
+	}
+	if _, ok := fn.(*HistogramFunction); !ok {
+		t.Errorf("Expected *HistogramFunction, got %T", fn)
+	}
+}
@@ -254,3 +254,288 @@ func (f *FloorFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace
 
 	return builder.NewArray(), nil
 }
+
+// NegateFunction multiplies each element by -1.
+type NegateFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewNegateFunction())
+}
+
+// NewNegateFunction creates a new negate function.
+func NewNegateFunction() *NegateFunction {
+	return &NegateFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"negate",
+			"Multiply by -1",
+			computepkg.CategoryMath,
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns the same type as input.
+func (f *NegateFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return inputType, nil
+}
+
+// Execute negates each element. Unsigned integers cannot be negated and
+// return an error.
+func (f *NegateFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+
+	switch arr := input.(type) {
+	case *array.Int32:
+		builder := array.NewInt32Builder(mem)
+		defer builder.Release()
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				builder.AppendNull()
+			} else {
+				builder.Append(-arr.Value(i))
+			}
+		}
+		return builder.NewArray(), nil
+
+	case *array.Int64:
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				builder.AppendNull()
+			} else {
+				builder.Append(-arr.Value(i))
+			}
+		}
+		return builder.NewArray(), nil
+
+	case *array.Float32:
+		builder := array.NewFloat32Builder(mem)
+		defer builder.Release()
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				builder.AppendNull()
+			} else {
+				builder.Append(-arr.Value(i))
+			}
+		}
+		return builder.NewArray(), nil
+
+	case *array.Float64:
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsNull(i) {
+				builder.AppendNull()
+			} else {
+				builder.Append(-arr.Value(i))
+			}
+		}
+		return builder.NewArray(), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type for negate: %v", input.DataType())
+	}
+}
+
+// ReciprocalFunction computes 1/x for each element.
+type ReciprocalFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewReciprocalFunction())
+}
+
+// NewReciprocalFunction creates a new reciprocal function.
+func NewReciprocalFunction() *ReciprocalFunction {
+	return &ReciprocalFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"reciprocal",
+			"Compute 1/x",
+			computepkg.CategoryMath,
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns float64 for any numeric input.
+func (f *ReciprocalFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Float64, nil
+}
+
+// Execute computes the reciprocal of each element. Zero has no reciprocal
+// and yields null.
+func (f *ReciprocalFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+
+	for i := 0; i < input.Len(); i++ {
+		if input.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		val, err := numericValue(input, i)
+		if err != nil {
+			return nil, err
+		}
+		if val == 0 {
+			builder.AppendNull()
+		} else {
+			builder.Append(1 / val)
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// DegreesFunction converts angles from radians to degrees.
+type DegreesFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewDegreesFunction())
+}
+
+// NewDegreesFunction creates a new degrees function.
+func NewDegreesFunction() *DegreesFunction {
+	return &DegreesFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"degrees",
+			"Convert radians to degrees",
+			computepkg.CategoryMath,
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns float64 for any numeric input.
+func (f *DegreesFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Float64, nil
+}
+
+// Execute converts each element to degrees.
+func (f *DegreesFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+	return mapToFloat64(input, mem, func(v float64) float64 {
+		return v * 180 / math.Pi
+	})
+}
+
+// RadiansFunction converts angles from degrees to radians.
+type RadiansFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewRadiansFunction())
+}
+
+// NewRadiansFunction creates a new radians function.
+func NewRadiansFunction() *RadiansFunction {
+	return &RadiansFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"radians",
+			"Convert degrees to radians",
+			computepkg.CategoryMath,
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns float64 for any numeric input.
+func (f *RadiansFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Float64, nil
+}
+
+// Execute converts each element to radians.
+func (f *RadiansFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+	return mapToFloat64(input, mem, func(v float64) float64 {
+		return v * math.Pi / 180
+	})
+}
+
+// TruncFunction rounds toward zero, so trunc(-2.7) is -2 where floor
+// gives -3.
+type TruncFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewTruncFunction())
+}
+
+// NewTruncFunction creates a new trunc function.
+func NewTruncFunction() *TruncFunction {
+	return &TruncFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"trunc",
+			"Round toward zero",
+			computepkg.CategoryMath,
+			computepkg.NumericTypes(),
+		),
+	}
+}
+
+// OutputType returns float64 for any numeric input.
+func (f *TruncFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Float64, nil
+}
+
+// Execute truncates each element toward zero.
+func (f *TruncFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+	return mapToFloat64(input, mem, math.Trunc)
+}
+
+// mapToFloat64 applies fn to each element of a numeric array, producing a
+// Float64 array. Nulls are preserved.
+func mapToFloat64(input arrow.Array, mem memory.Allocator, fn func(float64) float64) (arrow.Array, error) {
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+
+	for i := 0; i < input.Len(); i++ {
+		if input.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		val, err := numericValue(input, i)
+		if err != nil {
+			return nil, err
+		}
+		builder.Append(fn(val))
+	}
+
+	return builder.NewArray(), nil
+}
@@ -175,3 +175,252 @@ func TestFloorFunction(t *testing.T) {
 		}
 	}
 }
+
+func TestNegateFunction(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{-1, 2, -3}, nil)
+	builder.AppendNull()
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("negate")
+	if err != nil {
+		t.Fatalf("Failed to get negate function: %v", err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	// The input type is preserved
+	intArr := result.(*array.Int64)
+	expected := []int64{1, -2, 3}
+
+	for i, exp := range expected {
+		if intArr.Value(i) != exp {
+			t.Errorf("Expected %d at index %d, got %d", exp, i, intArr.Value(i))
+		}
+	}
+	if !intArr.IsNull(3) {
+		t.Error("Expected null at index 3")
+	}
+}
+
+func TestNegateWithFloats(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewFloat32Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]float32{-1.5, 2.25}, nil)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("negate")
+	if err != nil {
+		t.Fatalf("Failed to get negate function: %v", err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	floatArr := result.(*array.Float32)
+	expected := []float32{1.5, -2.25}
+
+	for i, exp := range expected {
+		if floatArr.Value(i) != exp {
+			t.Errorf("Expected %f at index %d, got %f", exp, i, floatArr.Value(i))
+		}
+	}
+}
+
+func TestReciprocalFunction(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{2, 4, 0}, nil)
+	builder.AppendNull()
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("reciprocal")
+	if err != nil {
+		t.Fatalf("Failed to get reciprocal function: %v", err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	// Integers produce floats
+	floatArr := result.(*array.Float64)
+	expected := []float64{0.5, 0.25}
+
+	for i, exp := range expected {
+		if floatArr.Value(i) != exp {
+			t.Errorf("Expected %f at index %d, got %f", exp, i, floatArr.Value(i))
+		}
+	}
+	if !floatArr.IsNull(2) {
+		t.Error("Expected null for the reciprocal of zero")
+	}
+	if !floatArr.IsNull(3) {
+		t.Error("Expected null for null input")
+	}
+}
+
+func TestDegreesRadiansFunctions(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	tests := []struct {
+		name     string
+		input    []float64
+		expected []float64
+	}{
+		{"radians", []float64{180, 90, -360}, []float64{math.Pi, math.Pi / 2, -2 * math.Pi}},
+		{"degrees", []float64{math.Pi, math.Pi / 4, 0}, []float64{180, 45, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := array.NewFloat64Builder(mem)
+			defer builder.Release()
+			builder.AppendValues(tt.input, nil)
+			builder.AppendNull()
+			arr := builder.NewArray()
+			defer arr.Release()
+
+			fn, err := computepkg.Get(tt.name)
+			if err != nil {
+				t.Fatalf("Failed to get %s function: %v", tt.name, err)
+			}
+
+			result, err := fn.Execute(arr, mem, false)
+			if err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			defer result.Release()
+
+			floatArr := result.(*array.Float64)
+			for i, exp := range tt.expected {
+				if math.Abs(floatArr.Value(i)-exp) > 1e-9 {
+					t.Errorf("Expected %f at index %d, got %f", exp, i, floatArr.Value(i))
+				}
+			}
+			if !floatArr.IsNull(len(tt.input)) {
+				t.Error("Expected null for null input")
+			}
+		})
+	}
+}
+
+func TestRadiansWithIntegers(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt32Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int32{180}, nil)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("radians")
+	if err != nil {
+		t.Fatalf("Failed to get radians function: %v", err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	if got := result.(*array.Float64).Value(0); math.Abs(got-math.Pi) > 1e-9 {
+		t.Errorf("radians(180) = %f, want %f", got, math.Pi)
+	}
+}
+
+func TestTruncFunction(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	input := []float64{2.7, -2.7, 2.2, -2.2, 0}
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues(input, nil)
+	builder.AppendNull()
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	// Each function rounds the negatives differently
+	tests := []struct {
+		name     string
+		expected []float64
+	}{
+		{"trunc", []float64{2, -2, 2, -2, 0}},
+		{"floor", []float64{2, -3, 2, -3, 0}},
+		{"ceil", []float64{3, -2, 3, -2, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := computepkg.Get(tt.name)
+			if err != nil {
+				t.Fatalf("Failed to get %s function: %v", tt.name, err)
+			}
+
+			result, err := fn.Execute(arr, mem, false)
+			if err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			defer result.Release()
+
+			floatArr := result.(*array.Float64)
+			for i, exp := range tt.expected {
+				if floatArr.Value(i) != exp {
+					t.Errorf("%s(%v) = %v, want %v", tt.name, input[i], floatArr.Value(i), exp)
+				}
+			}
+			if !floatArr.IsNull(len(input)) {
+				t.Error("Expected null for null input")
+			}
+		})
+	}
+}
+
+func TestTruncWithIntegers(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{-3, 4}, nil)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("trunc")
+	if err != nil {
+		t.Fatalf("Failed to get trunc function: %v", err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	floatArr := result.(*array.Float64)
+	expected := []float64{-3, 4}
+	for i, exp := range expected {
+		if floatArr.Value(i) != exp {
+			t.Errorf("Expected %f at index %d, got %f", exp, i, floatArr.Value(i))
+		}
+	}
+}
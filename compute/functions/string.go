@@ -16,6 +16,7 @@ package functions
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
@@ -173,7 +174,8 @@ func (f *TrimFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace
 	return builder.NewArray(), nil
 }
 
-// LengthFunction computes string length.
+// LengthFunction computes string length in bytes.
+// See CharCountFunction for a count of Unicode characters.
 type LengthFunction struct {
 	computepkg.BaseVectorFunction
 }
@@ -187,7 +189,7 @@ func NewLengthFunction() *LengthFunction {
 	return &LengthFunction{
 		BaseVectorFunction: computepkg.NewBaseVectorFunction(
 			"length",
-			"Compute string length in characters",
+			"Compute string length in bytes",
 			computepkg.CategoryString,
 			computepkg.StringTypes(),
 		),
@@ -223,6 +225,57 @@ func (f *LengthFunction) Execute(input arrow.Array, mem memory.Allocator, inPlac
 	return builder.NewArray(), nil
 }
 
+// CharCountFunction computes string length in Unicode characters (runes),
+// so "café" has 4 characters where LengthFunction counts 5 bytes.
+type CharCountFunction struct {
+	computepkg.BaseVectorFunction
+}
+
+func init() {
+	computepkg.MustRegister(NewCharCountFunction())
+}
+
+// NewCharCountFunction creates a new character count function.
+func NewCharCountFunction() *CharCountFunction {
+	return &CharCountFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"char_count",
+			"Compute string length in Unicode characters",
+			computepkg.CategoryString,
+			computepkg.StringTypes(),
+		),
+	}
+}
+
+// OutputType returns int64 for the character count.
+func (f *CharCountFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Int64, nil
+}
+
+// Execute counts the runes of each string.
+func (f *CharCountFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+
+	strArr := input.(*array.String)
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+
+	for i := 0; i < strArr.Len(); i++ {
+		if strArr.IsNull(i) {
+			builder.AppendNull()
+		} else {
+			builder.Append(int64(utf8.RuneCountInString(strArr.Value(i))))
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
 // SubstringFunction extracts a substring from each string.
 type SubstringFunction struct {
 	computepkg.BaseVectorFunction
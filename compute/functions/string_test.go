@@ -17,6 +17,7 @@ package functions
 import (
 	"testing"
 
+	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	computepkg "github.com/magpierre/fyne-datatable/compute"
@@ -142,6 +143,45 @@ func TestLengthFunction(t *testing.T) {
 	}
 }
 
+func TestCharCountFunction(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	input := []string{"hello", "café", "日本語", "👍🏽", ""}
+	builder := array.NewStringBuilder(mem)
+	defer builder.Release()
+	builder.AppendValues(input, nil)
+	builder.AppendNull()
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	fn, err := computepkg.Get("char_count")
+	if err != nil {
+		t.Fatalf("Failed to get char_count function: %v", err)
+	}
+	outputType, err := fn.OutputType(arr.DataType())
+	if err != nil || outputType.ID() != arrow.INT64 {
+		t.Errorf("OutputType() = %v, %v, want int64", outputType, err)
+	}
+
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+
+	// Byte lengths are 5, 5, 9, 8 and 0
+	intArr := result.(*array.Int64)
+	expected := []int64{5, 4, 3, 2, 0}
+	for i, exp := range expected {
+		if intArr.Value(i) != exp {
+			t.Errorf("Expected %d characters in %q, got %d", exp, input[i], intArr.Value(i))
+		}
+	}
+	if !intArr.IsNull(len(input)) {
+		t.Error("Expected null for null input")
+	}
+}
+
 func TestStringFunctionWithNulls(t *testing.T) {
 	mem := memory.NewGoAllocator()
 
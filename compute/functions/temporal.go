@@ -0,0 +1,232 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+// Epoch units accepted by ToEpochFunction.SetUnit.
+const (
+	EpochSeconds      = "s"
+	EpochMilliseconds = "ms"
+	EpochMicroseconds = "us"
+)
+
+// epochTicksPerSecond maps each epoch unit to its ticks per second.
+var epochTicksPerSecond = map[string]int64{
+	EpochSeconds:      1,
+	EpochMilliseconds: 1_000,
+	EpochMicroseconds: 1_000_000,
+}
+
+// epochTimeUnits maps each epoch unit to the matching Arrow time unit.
+var epochTimeUnits = map[string]arrow.TimeUnit{
+	EpochSeconds:      arrow.Second,
+	EpochMilliseconds: arrow.Millisecond,
+	EpochMicroseconds: arrow.Microsecond,
+}
+
+// checkEpochUnit returns an error unless unit is one of the Epoch* units.
+func checkEpochUnit(unit string) error {
+	if _, ok := epochTicksPerSecond[unit]; !ok {
+		return fmt.Errorf("unsupported epoch unit %q: use s, ms or us", unit)
+	}
+	return nil
+}
+
+// ToEpochFunction converts dates and timestamps to Unix time.
+type ToEpochFunction struct {
+	computepkg.BaseVectorFunction
+	unit string // One of the Epoch* units
+}
+
+func init() {
+	computepkg.MustRegister(NewToEpochFunction())
+	computepkg.MustRegister(NewFromEpochFunction())
+}
+
+// NewToEpochFunction creates a new to_epoch function returning seconds.
+func NewToEpochFunction() *ToEpochFunction {
+	return &ToEpochFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"to_epoch",
+			"Convert dates and timestamps to Unix time",
+			computepkg.CategoryTemporal,
+			[]arrow.DataType{}, // Validate accepts timestamps of any unit and zone
+		),
+		unit: EpochSeconds,
+	}
+}
+
+// SetUnit sets the unit of the epoch values: "s" (the default), "ms" or
+// "us". Values are rounded down, so times before 1970 with a fraction of a
+// unit give the next lower value, as time.Time.Unix does.
+// Returns an error for any other unit.
+func (f *ToEpochFunction) SetUnit(unit string) error {
+	if err := checkEpochUnit(unit); err != nil {
+		return err
+	}
+	f.unit = unit
+	return nil
+}
+
+// Unit returns the unit of the epoch values.
+func (f *ToEpochFunction) Unit() string {
+	return f.unit
+}
+
+// Validate accepts Date32, Date64 and timestamp arrays.
+func (f *ToEpochFunction) Validate(inputType arrow.DataType) error {
+	switch inputType.ID() {
+	case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP:
+		return nil
+	}
+	return fmt.Errorf("function %q does not support input type %v", f.Name(), inputType)
+}
+
+// OutputType returns Int64.
+func (f *ToEpochFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return arrow.PrimitiveTypes.Int64, nil
+}
+
+// Execute converts each date or timestamp to epoch values in the set unit.
+// Nulls stay null.
+func (f *ToEpochFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	if err := f.Validate(input.DataType()); err != nil {
+		return nil, err
+	}
+
+	// Read each value as a count of source ticks
+	var ticksPerSecond int64
+	var value func(i int) int64
+	switch arr := input.(type) {
+	case *array.Date32:
+		// Days; a second count keeps the whole range exact
+		ticksPerSecond = 1
+		value = func(i int) int64 { return int64(arr.Value(i)) * 86_400 }
+	case *array.Date64:
+		ticksPerSecond = 1_000
+		value = func(i int) int64 { return int64(arr.Value(i)) }
+	case *array.Timestamp:
+		unit := arr.DataType().(*arrow.TimestampType).Unit
+		ticksPerSecond = int64(time.Second / unit.Multiplier())
+		value = func(i int) int64 { return int64(arr.Value(i)) }
+	default:
+		return nil, fmt.Errorf("unsupported type for to_epoch: %T", input)
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+
+	target := epochTicksPerSecond[f.unit]
+	for i := 0; i < input.Len(); i++ {
+		if input.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(convertTicks(value(i), ticksPerSecond, target))
+	}
+
+	return builder.NewArray(), nil
+}
+
+// convertTicks converts v from one tick rate to another, rounding down.
+func convertTicks(v, from, to int64) int64 {
+	if to >= from {
+		return v * (to / from)
+	}
+	factor := from / to
+	q := v / factor
+	if v%factor < 0 {
+		q--
+	}
+	return q
+}
+
+// FromEpochFunction converts Unix time to timestamps, the inverse of
+// ToEpochFunction.
+type FromEpochFunction struct {
+	computepkg.BaseVectorFunction
+	unit string // One of the Epoch* units
+}
+
+// NewFromEpochFunction creates a new from_epoch function reading seconds.
+func NewFromEpochFunction() *FromEpochFunction {
+	return &FromEpochFunction{
+		BaseVectorFunction: computepkg.NewBaseVectorFunction(
+			"from_epoch",
+			"Convert Unix time to timestamps",
+			computepkg.CategoryTemporal,
+			[]arrow.DataType{arrow.PrimitiveTypes.Int64},
+		),
+		unit: EpochSeconds,
+	}
+}
+
+// SetUnit sets the unit of the epoch values: "s" (the default), "ms" or
+// "us". The timestamps keep that unit, so no precision is lost.
+// Returns an error for any other unit.
+func (f *FromEpochFunction) SetUnit(unit string) error {
+	if err := checkEpochUnit(unit); err != nil {
+		return err
+	}
+	f.unit = unit
+	return nil
+}
+
+// Unit returns the unit of the epoch values.
+func (f *FromEpochFunction) Unit() string {
+	return f.unit
+}
+
+// OutputType returns a UTC timestamp in the set unit.
+func (f *FromEpochFunction) OutputType(inputType arrow.DataType) (arrow.DataType, error) {
+	if err := f.Validate(inputType); err != nil {
+		return nil, err
+	}
+	return &arrow.TimestampType{Unit: epochTimeUnits[f.unit], TimeZone: "UTC"}, nil
+}
+
+// Execute converts each epoch value to a timestamp. Nulls stay null.
+func (f *FromEpochFunction) Execute(input arrow.Array, mem memory.Allocator, inPlace bool) (arrow.Array, error) {
+	outputType, err := f.OutputType(input.DataType())
+	if err != nil {
+		return nil, err
+	}
+	arr := input.(*array.Int64)
+
+	builder := array.NewTimestampBuilder(mem, outputType.(*arrow.TimestampType))
+	defer builder.Release()
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+		builder.Append(arrow.Timestamp(arr.Value(i)))
+	}
+
+	return builder.NewArray(), nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package functions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	computepkg "github.com/magpierre/fyne-datatable/compute"
+)
+
+func TestToEpochFunction(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	// 2024-01-15T10:30:00.250Z and 1969-12-31T23:59:59.500Z
+	known := time.Date(2024, 1, 15, 10, 30, 0, 250_000_000, time.UTC)
+	beforeEpoch := time.Date(1969, 12, 31, 23, 59, 59, 500_000_000, time.UTC)
+
+	tsType := &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "Europe/Stockholm"}
+	builder := array.NewTimestampBuilder(mem, tsType)
+	defer builder.Release()
+	builder.Append(arrow.Timestamp(known.UnixNano()))
+	builder.AppendNull()
+	builder.Append(arrow.Timestamp(beforeEpoch.UnixNano()))
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	tests := []struct {
+		unit string
+		want []int64
+	}{
+		{EpochSeconds, []int64{1705314600, 0, -1}},
+		{EpochMilliseconds, []int64{1705314600250, 0, -500}},
+		{EpochMicroseconds, []int64{1705314600250000, 0, -500000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit, func(t *testing.T) {
+			fn := NewToEpochFunction()
+			if err := fn.SetUnit(tt.unit); err != nil {
+				t.Fatalf("SetUnit(%q) failed: %v", tt.unit, err)
+			}
+			result, err := fn.Execute(arr, mem, false)
+			if err != nil {
+				t.Fatalf("Execute failed: %v", err)
+			}
+			defer result.Release()
+
+			intArr := result.(*array.Int64)
+			if !intArr.IsNull(1) {
+				t.Error("Expected null to stay null")
+			}
+			for i, want := range tt.want {
+				if i != 1 && intArr.Value(i) != want {
+					t.Errorf("Expected %d at index %d, got %d", want, i, intArr.Value(i))
+				}
+			}
+		})
+	}
+
+	if err := NewToEpochFunction().SetUnit("ns"); err == nil {
+		t.Error("Expected error for unsupported unit")
+	}
+}
+
+func TestToEpochFunction_Dates(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	fn, err := computepkg.Get("to_epoch")
+	if err != nil {
+		t.Fatalf("Failed to get to_epoch function: %v", err)
+	}
+	if fn.Category() != computepkg.CategoryTemporal {
+		t.Errorf("Expected CategoryTemporal, got %v", fn.Category())
+	}
+
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	date32Builder := array.NewDate32Builder(mem)
+	defer date32Builder.Release()
+	date32Builder.Append(arrow.Date32FromTime(day))
+	date32 := date32Builder.NewArray()
+	defer date32.Release()
+
+	date64Builder := array.NewDate64Builder(mem)
+	defer date64Builder.Release()
+	date64Builder.Append(arrow.Date64FromTime(day))
+	date64 := date64Builder.NewArray()
+	defer date64.Release()
+
+	for _, arr := range []arrow.Array{date32, date64} {
+		result, err := fn.Execute(arr, mem, false)
+		if err != nil {
+			t.Fatalf("Execute(%s) failed: %v", arr.DataType(), err)
+		}
+		if got := result.(*array.Int64).Value(0); got != day.Unix() {
+			t.Errorf("to_epoch(%s) = %d, want %d", arr.DataType(), got, day.Unix())
+		}
+		result.Release()
+	}
+
+	strBuilder := array.NewStringBuilder(mem)
+	defer strBuilder.Release()
+	strBuilder.Append("2024-01-15")
+	str := strBuilder.NewArray()
+	defer str.Release()
+	if _, err := fn.Execute(str, mem, false); err == nil {
+		t.Error("Expected error for string input")
+	}
+}
+
+func TestFromEpochFunction_RoundTrip(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	times := []time.Time{
+		time.Date(2024, 1, 15, 10, 30, 0, 250_000_000, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Unix(0, 0).UTC(),
+	}
+
+	for _, unit := range []string{EpochSeconds, EpochMilliseconds, EpochMicroseconds} {
+		t.Run(unit, func(t *testing.T) {
+			tsType := &arrow.TimestampType{Unit: epochTimeUnits[unit], TimeZone: "UTC"}
+			builder := array.NewTimestampBuilder(mem, tsType)
+			defer builder.Release()
+			for _, ts := range times {
+				value, err := arrow.TimestampFromTime(ts, tsType.Unit)
+				if err != nil {
+					t.Fatalf("TimestampFromTime failed: %v", err)
+				}
+				builder.Append(value)
+			}
+			builder.AppendNull()
+			original := builder.NewArray()
+			defer original.Release()
+
+			toEpoch := NewToEpochFunction()
+			fromEpoch := NewFromEpochFunction()
+			if err := toEpoch.SetUnit(unit); err != nil {
+				t.Fatalf("SetUnit failed: %v", err)
+			}
+			if err := fromEpoch.SetUnit(unit); err != nil {
+				t.Fatalf("SetUnit failed: %v", err)
+			}
+
+			epochs, err := toEpoch.Execute(original, mem, false)
+			if err != nil {
+				t.Fatalf("to_epoch failed: %v", err)
+			}
+			defer epochs.Release()
+			roundTrip, err := fromEpoch.Execute(epochs, mem, false)
+			if err != nil {
+				t.Fatalf("from_epoch failed: %v", err)
+			}
+			defer roundTrip.Release()
+
+			if !arrow.TypeEqual(roundTrip.DataType(), original.DataType()) {
+				t.Errorf("Expected type %s, got %s", original.DataType(), roundTrip.DataType())
+			}
+			if !array.Equal(roundTrip, original) {
+				t.Errorf("Round trip = %v, want %v", roundTrip, original)
+			}
+		})
+	}
+
+	// The registered function reads seconds
+	fn, err := computepkg.Get("from_epoch")
+	if err != nil {
+		t.Fatalf("Failed to get from_epoch function: %v", err)
+	}
+	intBuilder := array.NewInt64Builder(mem)
+	defer intBuilder.Release()
+	intBuilder.Append(1705314600)
+	ints := intBuilder.NewArray()
+	defer ints.Release()
+	result, err := fn.Execute(ints, mem, false)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	defer result.Release()
+	got := result.(*array.Timestamp).Value(0).ToTime(arrow.Second)
+	if want := times[0].Truncate(time.Second); !got.Equal(want) {
+		t.Errorf("from_epoch(1705314600) = %v, want %v", got, want)
+	}
+
+	if err := NewFromEpochFunction().SetUnit("days"); err == nil {
+		t.Error("Expected error for unsupported unit")
+	}
+	if _, err := fn.Execute(result, mem, false); err == nil {
+		t.Error("Expected error for timestamp input")
+	}
+}
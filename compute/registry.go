@@ -23,16 +23,18 @@ import (
 // FunctionRegistry manages registered vectorized functions.
 // It provides thread-safe registration and lookup of functions by name.
 type FunctionRegistry struct {
-	functions map[string]VectorFunction
-	metadata  map[string]FunctionMetadata
-	mu        sync.RWMutex
+	functions       map[string]VectorFunction
+	metadata        map[string]FunctionMetadata
+	binaryFunctions map[string]BinaryFunction
+	mu              sync.RWMutex
 }
 
 // NewFunctionRegistry creates a new empty function registry.
 func NewFunctionRegistry() *FunctionRegistry {
 	return &FunctionRegistry{
-		functions: make(map[string]VectorFunction),
-		metadata:  make(map[string]FunctionMetadata),
+		functions:       make(map[string]VectorFunction),
+		metadata:        make(map[string]FunctionMetadata),
+		binaryFunctions: make(map[string]BinaryFunction),
 	}
 }
 
@@ -205,6 +207,66 @@ func (r *FunctionRegistry) Clear() {
 
 	r.functions = make(map[string]VectorFunction)
 	r.metadata = make(map[string]FunctionMetadata)
+	r.binaryFunctions = make(map[string]BinaryFunction)
+}
+
+// RegisterBinary adds a two-array function to the registry.
+// Binary functions live in their own namespace, separate from VectorFunctions.
+// Returns an error if the function is nil, unnamed, or already registered.
+func (r *FunctionRegistry) RegisterBinary(fn BinaryFunction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if fn == nil {
+		return fmt.Errorf("cannot register nil function")
+	}
+
+	name := fn.Name()
+	if name == "" {
+		return fmt.Errorf("function name cannot be empty")
+	}
+
+	if _, exists := r.binaryFunctions[name]; exists {
+		return fmt.Errorf("binary function %q already registered", name)
+	}
+
+	r.binaryFunctions[name] = fn
+	return nil
+}
+
+// GetBinary retrieves a binary function by name.
+// Returns an error if the function is not found.
+func (r *FunctionRegistry) GetBinary(name string) (BinaryFunction, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	fn, exists := r.binaryFunctions[name]
+	if !exists {
+		return nil, fmt.Errorf("binary function %q not found", name)
+	}
+
+	return fn, nil
+}
+
+// MustRegisterBinary registers a binary function or panics on error.
+func (r *FunctionRegistry) MustRegisterBinary(fn BinaryFunction) {
+	if err := r.RegisterBinary(fn); err != nil {
+		panic(fmt.Sprintf("failed to register binary function: %v", err))
+	}
+}
+
+// ListBinaryFunctions returns all registered binary function names in alphabetical order.
+func (r *FunctionRegistry) ListBinaryFunctions() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.binaryFunctions))
+	for name := range r.binaryFunctions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
 }
 
 // Package-level convenience functions that use the global registry.
@@ -249,6 +311,26 @@ func ListByCategory() map[FunctionCategory][]string {
 	return globalRegistry.ListByCategory()
 }
 
+// RegisterBinary adds a binary function to the global registry.
+func RegisterBinary(fn BinaryFunction) error {
+	return globalRegistry.RegisterBinary(fn)
+}
+
+// GetBinary retrieves a binary function from the global registry.
+func GetBinary(name string) (BinaryFunction, error) {
+	return globalRegistry.GetBinary(name)
+}
+
+// MustRegisterBinary registers a binary function to the global registry or panics.
+func MustRegisterBinary(fn BinaryFunction) {
+	globalRegistry.MustRegisterBinary(fn)
+}
+
+// ListBinaryFunctions returns all binary function names from the global registry.
+func ListBinaryFunctions() []string {
+	return globalRegistry.ListBinaryFunctions()
+}
+
 // GetGlobalRegistry returns the global registry instance.
 // This can be useful for advanced scenarios or testing.
 func GetGlobalRegistry() *FunctionRegistry {
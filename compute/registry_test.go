@@ -333,6 +333,57 @@ func TestGlobalRegistry(t *testing.T) {
 	GetGlobalRegistry().Clear()
 }
 
+// MockBinaryFunction is a simple mock implementation of BinaryFunction for testing.
+type MockBinaryFunction struct {
+	BaseBinaryFunction
+}
+
+func (m *MockBinaryFunction) OutputType(leftType, rightType arrow.DataType) (arrow.DataType, error) {
+	return leftType, nil
+}
+
+func (m *MockBinaryFunction) Execute(left, right arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	left.Retain()
+	return left, nil
+}
+
+func TestFunctionRegistryBinary(t *testing.T) {
+	registry := NewFunctionRegistry()
+
+	fn := &MockBinaryFunction{BaseBinaryFunction: NewBaseBinaryFunction("pair", "Mock binary function", NumericTypes())}
+	if err := registry.RegisterBinary(fn); err != nil {
+		t.Fatalf("Failed to register binary function: %v", err)
+	}
+
+	// Duplicate registration fails
+	if err := registry.RegisterBinary(fn); err == nil {
+		t.Error("Expected error for duplicate registration")
+	}
+
+	got, err := registry.GetBinary("pair")
+	if err != nil {
+		t.Fatalf("Failed to get binary function: %v", err)
+	}
+	if got.Name() != "pair" {
+		t.Errorf("Expected name 'pair', got %s", got.Name())
+	}
+
+	// Binary functions do not appear among vector functions
+	if registry.Has("pair") {
+		t.Error("Binary function should not be listed as a vector function")
+	}
+
+	names := registry.ListBinaryFunctions()
+	if len(names) != 1 || names[0] != "pair" {
+		t.Errorf("Expected [pair], got %v", names)
+	}
+
+	registry.Clear()
+	if _, err := registry.GetBinary("pair"); err == nil {
+		t.Error("Expected binary function to be cleared")
+	}
+}
+
 func TestBaseVectorFunctionValidate(t *testing.T) {
 	// Create function that only accepts int64
 	base := NewBaseVectorFunction(
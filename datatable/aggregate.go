@@ -0,0 +1,243 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"github.com/magpierre/fyne-datatable/compute"
+	_ "github.com/magpierre/fyne-datatable/compute/functions" // Register aggregates
+)
+
+// Aggregate operations supported by VisibleAggregate.
+const (
+	AggregateSum   = "sum"
+	AggregateMean  = "mean"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
+	AggregateCount = "count"
+)
+
+// VisibleAggregate computes an aggregate over the currently visible rows of
+// a visible column, so the result always reflects the active filter.
+//
+// Supported operations are "sum", "mean", "min", "max" and "count". Count
+// works on any column and returns the number of non-null values; the others
+// require a TypeInt, TypeFloat or TypeDecimal column. Sum, min and max keep
+// the column's type (decimals are summed as floats), mean is TypeFloat and
+// count is TypeInt. An aggregate over no values returns a null Value.
+// Returns ErrInvalidColumn if col is out of visible range.
+func (m *TableModel) VisibleAggregate(col int, op string) (Value, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if col < 0 || col >= len(m.visibleCols) {
+		return Value{}, fmt.Errorf("%w: %d (visible range: 0-%d)", ErrInvalidColumn, col, len(m.visibleCols)-1)
+	}
+
+	originalCol := m.visibleCols[col]
+	colType, err := m.source.ColumnType(originalCol)
+	if err != nil {
+		return Value{}, err
+	}
+
+	resultType, err := aggregateResultType(op, colType)
+	if err != nil {
+		return Value{}, err
+	}
+
+	fn, err := compute.Get(op)
+	if err != nil {
+		return Value{}, err
+	}
+	aggregate, ok := fn.(compute.AggregateFunction)
+	if !ok {
+		return Value{}, fmt.Errorf("function %s is not an aggregate", op)
+	}
+
+	input, err := m.visibleColumnArray(originalCol, op, colType)
+	if err != nil {
+		return Value{}, err
+	}
+	defer input.Release()
+
+	result, err := aggregate.Aggregate(input)
+	if err != nil {
+		return Value{}, fmt.Errorf("%s aggregate failed: %w", op, err)
+	}
+
+	return NewValue(result, resultType), nil
+}
+
+// NullCount returns the number of null values among the visible rows of
+// visible column col, so the count always reflects the active filter.
+// Cells that cannot be read are not counted. Returns 0 if col is out of
+// visible range.
+func (m *TableModel) NullCount(col int) int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if col < 0 || col >= len(m.visibleCols) {
+		return 0
+	}
+
+	originalCol := m.visibleCols[col]
+	count := 0
+	for _, row := range m.visibleRows {
+		if value, err := m.source.Cell(row, originalCol); err == nil && value.IsNull {
+			count++
+		}
+	}
+	return count
+}
+
+// aggregateResultType validates op against the column type and returns the
+// type of its result.
+func aggregateResultType(op string, colType DataType) (DataType, error) {
+	switch op {
+	case AggregateCount:
+		return TypeInt, nil
+	case AggregateSum, AggregateMin, AggregateMax, AggregateMean:
+	default:
+		return TypeString, fmt.Errorf("unsupported aggregate operation %q", op)
+	}
+
+	switch colType {
+	case TypeInt:
+		if op == AggregateMean {
+			return TypeFloat, nil
+		}
+		return TypeInt, nil
+	case TypeFloat, TypeDecimal:
+		return TypeFloat, nil
+	default:
+		return TypeString, fmt.Errorf("%w: %s requires a numeric column, got %s", ErrTypeMismatch, op, colType)
+	}
+}
+
+// visibleColumnArray collects the visible rows of an original column into
+// an Arrow array for op. Count only needs null positions, so it gets a
+// Boolean array; integer columns become Int64 and the rest Float64, with
+// values that cannot be read as numbers stored as nulls.
+// Callers must hold m.mu.
+func (m *TableModel) visibleColumnArray(originalCol int, op string, colType DataType) (arrow.Array, error) {
+	mem := memory.NewGoAllocator()
+
+	if op == AggregateCount {
+		builder := array.NewBooleanBuilder(mem)
+		defer builder.Release()
+		for _, row := range m.visibleRows {
+			value, err := m.source.Cell(row, originalCol)
+			if err != nil {
+				return nil, err
+			}
+			if value.IsNull || value.IsError() {
+				builder.AppendNull()
+			} else {
+				builder.Append(true)
+			}
+		}
+		return builder.NewArray(), nil
+	}
+
+	if colType == TypeInt {
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+		for _, row := range m.visibleRows {
+			value, err := m.source.Cell(row, originalCol)
+			if err != nil {
+				return nil, err
+			}
+			if n, ok := intFromValue(value); ok {
+				builder.Append(n)
+			} else {
+				builder.AppendNull()
+			}
+		}
+		return builder.NewArray(), nil
+	}
+
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+	for _, row := range m.visibleRows {
+		value, err := m.source.Cell(row, originalCol)
+		if err != nil {
+			return nil, err
+		}
+		if f, ok := floatFromValue(value); ok {
+			builder.Append(f)
+		} else {
+			builder.AppendNull()
+		}
+	}
+	return builder.NewArray(), nil
+}
+
+// intFromValue reads a non-null value as int64.
+func intFromValue(value Value) (int64, bool) {
+	if value.IsNull || value.IsError() {
+		return 0, false
+	}
+
+	switch v := value.Raw.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	}
+
+	value.EnsureFormatted()
+	n, err := strconv.ParseInt(strings.TrimSpace(value.Formatted), 10, 64)
+	return n, err == nil
+}
+
+// floatFromValue reads a non-null value as float64.
+func floatFromValue(value Value) (float64, bool) {
+	if value.IsNull || value.IsError() {
+		return 0, false
+	}
+
+	switch v := value.Raw.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	}
+	if n, ok := intFromValue(value); ok {
+		return float64(n), true
+	}
+
+	value.EnsureFormatted()
+	f, err := strconv.ParseFloat(strings.TrimSpace(value.Formatted), 64)
+	return f, err == nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"errors"
+	"testing"
+)
+
+// newEmployeeDataSource builds a small employee sample with Name, Age,
+// Salary and Department columns. Bob's salary is null.
+func newEmployeeDataSource() *mockDataSource {
+	employees := []struct {
+		name       string
+		age        int64
+		salary     any
+		department string
+	}{
+		{"Alice", 30, 75000.50, "Engineering"},
+		{"Bob", 25, nil, "Design"},
+		{"Charlie", 35, 85000.75, "Product"},
+		{"Eve", 32, 76000.00, "Engineering"},
+		{"Grace", 31, 72000.00, "Engineering"},
+	}
+
+	data := make([][]Value, len(employees))
+	for i, e := range employees {
+		data[i] = []Value{
+			NewValue(e.name, TypeString),
+			NewValue(e.age, TypeInt),
+			NewValue(e.salary, TypeFloat),
+			NewValue(e.department, TypeString),
+		}
+	}
+
+	return &mockDataSource{
+		rows:        len(employees),
+		cols:        4,
+		columnNames: []string{"Name", "Age", "Salary", "Department"},
+		columnTypes: []DataType{TypeString, TypeInt, TypeFloat, TypeString},
+		data:        data,
+	}
+}
+
+// departmentFilter keeps rows of one department.
+type departmentFilter struct {
+	department string
+}
+
+func (f *departmentFilter) Evaluate(row []Value, columnNames []string) (bool, error) {
+	return row[3].Formatted == f.department, nil
+}
+
+func (f *departmentFilter) Description() string {
+	return "Department = " + f.department
+}
+
+func TestTableModel_VisibleAggregate(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+
+	tests := []struct {
+		name     string
+		col      int
+		op       string
+		wantRaw  any
+		wantType DataType
+	}{
+		{"sum salary", 2, AggregateSum, 308001.25, TypeFloat},
+		{"mean age", 1, AggregateMean, 30.6, TypeFloat},
+		{"min age", 1, AggregateMin, int64(25), TypeInt},
+		{"max salary", 2, AggregateMax, 85000.75, TypeFloat},
+		{"count salary skips null", 2, AggregateCount, int64(4), TypeInt},
+		{"count strings", 0, AggregateCount, int64(5), TypeInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := model.VisibleAggregate(tt.col, tt.op)
+			if err != nil {
+				t.Fatalf("VisibleAggregate() error = %v", err)
+			}
+			if got.Raw != tt.wantRaw {
+				t.Errorf("VisibleAggregate() = %v (%T), want %v (%T)", got.Raw, got.Raw, tt.wantRaw, tt.wantRaw)
+			}
+			if got.Type != tt.wantType {
+				t.Errorf("VisibleAggregate() type = %v, want %v", got.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestTableModel_VisibleAggregate_Filtered(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+	if err := model.SetFilter(&departmentFilter{department: "Engineering"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	sum, err := model.VisibleAggregate(2, AggregateSum)
+	if err != nil {
+		t.Fatalf("VisibleAggregate() error = %v", err)
+	}
+	if sum.Raw != 223000.50 {
+		t.Errorf("Sum of Engineering salaries = %v, want 223000.5", sum.Raw)
+	}
+
+	mean, _ := model.VisibleAggregate(1, AggregateMean)
+	if mean.Raw != 31.0 {
+		t.Errorf("Mean Engineering age = %v, want 31", mean.Raw)
+	}
+
+	// Aggregates follow the visible column order
+	if err := model.SetVisibleColumns([]int{1, 0}); err != nil {
+		t.Fatalf("SetVisibleColumns failed: %v", err)
+	}
+	count, _ := model.VisibleAggregate(0, AggregateCount)
+	if count.Raw != int64(3) {
+		t.Errorf("Count of visible Engineering rows = %v, want 3", count.Raw)
+	}
+
+	// No visible rows gives a null result
+	if err := model.SetFilter(&departmentFilter{department: "Legal"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	empty, err := model.VisibleAggregate(0, AggregateSum)
+	if err != nil {
+		t.Fatalf("VisibleAggregate() error = %v", err)
+	}
+	if !empty.IsNull {
+		t.Errorf("Expected null sum over no rows, got %v", empty.Raw)
+	}
+}
+
+func TestTableModel_VisibleAggregate_Errors(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+
+	if _, err := model.VisibleAggregate(9, AggregateSum); !errors.Is(err, ErrInvalidColumn) {
+		t.Errorf("VisibleAggregate(9) error = %v, want ErrInvalidColumn", err)
+	}
+	if _, err := model.VisibleAggregate(0, AggregateSum); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("VisibleAggregate(string sum) error = %v, want ErrTypeMismatch", err)
+	}
+	if _, err := model.VisibleAggregate(1, "median"); err == nil {
+		t.Error("VisibleAggregate(median) expected error")
+	}
+}
+
+func TestTableModel_NullCount(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+
+	if got := model.NullCount(2); got != 1 {
+		t.Errorf("NullCount(Salary) = %d, want 1", got)
+	}
+	if got := model.NullCount(0); got != 0 {
+		t.Errorf("NullCount(Name) = %d, want 0", got)
+	}
+
+	// Only visible rows count: Bob (null salary) is in Design
+	if err := model.SetFilter(&departmentFilter{department: "Engineering"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	if got := model.NullCount(2); got != 0 {
+		t.Errorf("NullCount(Salary) for Engineering = %d, want 0", got)
+	}
+	if err := model.SetFilter(&departmentFilter{department: "Design"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	if got := model.NullCount(2); got != 1 {
+		t.Errorf("NullCount(Salary) for Design = %d, want 1", got)
+	}
+
+	// Columns are visible indices
+	if err := model.SetVisibleColumns([]int{2, 0}); err != nil {
+		t.Fatalf("SetVisibleColumns failed: %v", err)
+	}
+	if got := model.NullCount(0); got != 1 {
+		t.Errorf("NullCount(0) with Salary first = %d, want 1", got)
+	}
+	if got := model.NullCount(5); got != 0 {
+		t.Errorf("NullCount(5) = %d, want 0 for an out-of-range column", got)
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+// ToArrowTable copies every row of src into a new Arrow table, using each
+// column's ColumnType to pick the Arrow type:
+//
+//	TypeInt        int64
+//	TypeFloat      float64
+//	TypeBool       boolean
+//	TypeDate       date32
+//	TypeTimestamp  timestamp[us, UTC]
+//	TypeBinary     binary
+//	other types    string, holding the formatted value
+//
+// Null cells become Arrow nulls. A non-null value that cannot be converted
+// to its column's Arrow type returns an error wrapping ErrTypeMismatch.
+// If mem is nil a Go allocator is used. The caller must Release the table.
+func ToArrowTable(src DataSource, mem memory.Allocator) (arrow.Table, error) {
+	schema, err := SchemaOf(src)
+	if err != nil {
+		return nil, err
+	}
+	if mem == nil {
+		mem = memory.NewGoAllocator()
+	}
+
+	rowCount := src.RowCount()
+	fields := make([]arrow.Field, len(schema))
+	columns := make([]arrow.Column, 0, len(schema))
+	defer func() {
+		for i := range columns {
+			columns[i].Release()
+		}
+	}()
+
+	for col, colSchema := range schema {
+		fields[col] = arrow.Field{
+			Name:     colSchema.Name,
+			Type:     arrowTypeFor(colSchema.Type),
+			Nullable: true,
+		}
+
+		arr, err := columnToArrow(src, col, rowCount, fields[col].Type, mem)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", colSchema.Name, err)
+		}
+
+		chunked := arrow.NewChunked(fields[col].Type, []arrow.Array{arr})
+		arr.Release()
+		columns = append(columns, *arrow.NewColumn(fields[col], chunked))
+		chunked.Release()
+	}
+
+	// NewTable retains the columns; the deferred loop drops our references
+	return array.NewTable(arrow.NewSchema(fields, nil), columns, int64(rowCount)), nil
+}
+
+// arrowTypeFor returns the Arrow type ToArrowTable uses for a column type.
+func arrowTypeFor(t DataType) arrow.DataType {
+	switch t {
+	case TypeInt:
+		return arrow.PrimitiveTypes.Int64
+	case TypeFloat:
+		return arrow.PrimitiveTypes.Float64
+	case TypeBool:
+		return arrow.FixedWidthTypes.Boolean
+	case TypeDate:
+		return arrow.FixedWidthTypes.Date32
+	case TypeTimestamp:
+		return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	case TypeBinary:
+		return arrow.BinaryTypes.Binary
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// columnToArrow builds an array of arrowType from column col of src.
+func columnToArrow(src DataSource, col, rowCount int, arrowType arrow.DataType, mem memory.Allocator) (arrow.Array, error) {
+	builder := array.NewBuilder(mem, arrowType)
+	defer builder.Release()
+	builder.Reserve(rowCount)
+
+	for row := 0; row < rowCount; row++ {
+		value, err := src.Cell(row, col)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+		if value.IsNull {
+			builder.AppendNull()
+			continue
+		}
+		if err := appendArrowValue(builder, value); err != nil {
+			return nil, fmt.Errorf("row %d: %w", row, err)
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// appendArrowValue appends a non-null value to builder, converting it to the
+// builder's type.
+func appendArrowValue(builder array.Builder, value Value) error {
+	value.EnsureFormatted()
+
+	switch b := builder.(type) {
+	case *array.Int64Builder:
+		n, ok := intFromValue(value)
+		if !ok {
+			return convertError(value, "int64")
+		}
+		b.Append(n)
+
+	case *array.Float64Builder:
+		f, ok := floatFromValue(value)
+		if !ok {
+			return convertError(value, "float64")
+		}
+		b.Append(f)
+
+	case *array.BooleanBuilder:
+		v, ok := value.Raw.(bool)
+		if !ok {
+			var err error
+			if v, err = strconv.ParseBool(strings.TrimSpace(value.Formatted)); err != nil {
+				return convertError(value, "bool")
+			}
+		}
+		b.Append(v)
+
+	case *array.Date32Builder:
+		t, ok := timeFromValue(value, time.DateOnly)
+		if !ok {
+			return convertError(value, "date32")
+		}
+		b.Append(arrow.Date32FromTime(t))
+
+	case *array.TimestampBuilder:
+		t, ok := timeFromValue(value, time.RFC3339Nano)
+		if !ok {
+			return convertError(value, "timestamp")
+		}
+		b.Append(arrow.Timestamp(t.UnixMicro()))
+
+	case *array.BinaryBuilder:
+		if raw, ok := value.Raw.([]byte); ok {
+			b.Append(raw)
+		} else {
+			b.Append([]byte(value.Formatted))
+		}
+
+	case *array.StringBuilder:
+		b.Append(value.Formatted)
+
+	default:
+		return fmt.Errorf("unsupported Arrow builder %T", builder)
+	}
+
+	return nil
+}
+
+// timeFromValue reads a value as time.Time, parsing Formatted with layout
+// when Raw is not a time.
+func timeFromValue(value Value, layout string) (time.Time, bool) {
+	if t, ok := value.Raw.(time.Time); ok {
+		return t, true
+	}
+	t, err := time.Parse(layout, strings.TrimSpace(value.Formatted))
+	return t, err == nil
+}
+
+// convertError reports a value that does not fit its column's Arrow type.
+func convertError(value Value, target string) error {
+	return fmt.Errorf("%w: cannot convert %q to %s", ErrTypeMismatch, value.Formatted, target)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+)
+
+func TestToArrowTable(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	table, err := ToArrowTable(newEmployeeDataSource(), mem)
+	if err != nil {
+		t.Fatalf("ToArrowTable() error = %v", err)
+	}
+	defer table.Release()
+
+	if table.NumRows() != 5 || table.NumCols() != 4 {
+		t.Fatalf("table is %dx%d, want 5x4", table.NumRows(), table.NumCols())
+	}
+
+	wantTypes := []arrow.DataType{
+		arrow.BinaryTypes.String,
+		arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Float64,
+		arrow.BinaryTypes.String,
+	}
+	for i, want := range wantTypes {
+		field := table.Schema().Field(i)
+		if !arrow.TypeEqual(field.Type, want) {
+			t.Errorf("column %q type = %s, want %s", field.Name, field.Type, want)
+		}
+	}
+
+	salary := table.Column(2).Data().Chunk(0).(*array.Float64)
+	if !salary.IsNull(1) {
+		t.Error("Bob's salary is not null")
+	}
+	if salary.Value(2) != 85000.75 {
+		t.Errorf("salary[2] = %v, want 85000.75", salary.Value(2))
+	}
+	age := table.Column(1).Data().Chunk(0).(*array.Int64)
+	if age.Value(4) != 31 {
+		t.Errorf("age[4] = %d, want 31", age.Value(4))
+	}
+}
+
+func TestToArrowTable_Errors(t *testing.T) {
+	if _, err := ToArrowTable(nil, nil); !errors.Is(err, ErrNoDataSource) {
+		t.Errorf("ToArrowTable(nil) error = %v, want ErrNoDataSource", err)
+	}
+
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer mem.AssertSize(t, 0)
+
+	// The second column claims to be TypeInt but holds text
+	source := newMockDataSource(3, 2)
+	source.columnTypes[1] = TypeInt
+	if _, err := ToArrowTable(source, mem); !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("ToArrowTable() error = %v, want ErrTypeMismatch", err)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// concatDataSource presents several data sources with the same schema as
+// one read-only DataSource, appending their rows in order.
+type concatDataSource struct {
+	sources []DataSource
+	offsets []int // offsets[i] is the global index of sources[i]'s first row
+	rows    int
+
+	releaseOnce sync.Once
+}
+
+// ConcatSources returns a DataSource that yields the rows of each source in
+// turn. All sources must have the same column names and types; the first
+// source defines the schema. Row counts are captured when the sources are
+// combined, so the sources should not change afterwards.
+func ConcatSources(sources ...DataSource) (DataSource, error) {
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("%w: no sources to concatenate", ErrEmptyData)
+	}
+	for i, source := range sources {
+		if source == nil {
+			return nil, fmt.Errorf("%w: source %d", ErrNoDataSource, i)
+		}
+	}
+
+	first := sources[0]
+	for i, source := range sources[1:] {
+		if err := checkSameSchema(first, source); err != nil {
+			return nil, fmt.Errorf("source %d: %w", i+1, err)
+		}
+	}
+
+	ds := &concatDataSource{
+		sources: append([]DataSource(nil), sources...),
+		offsets: make([]int, len(sources)),
+	}
+	for i, source := range sources {
+		ds.offsets[i] = ds.rows
+		ds.rows += source.RowCount()
+	}
+
+	return ds, nil
+}
+
+// checkSameSchema verifies that other has the same columns as want.
+func checkSameSchema(want, other DataSource) error {
+	if want.ColumnCount() != other.ColumnCount() {
+		return fmt.Errorf("%w: expected %d columns, got %d",
+			ErrSchemaMismatch, want.ColumnCount(), other.ColumnCount())
+	}
+
+	for col := 0; col < want.ColumnCount(); col++ {
+		wantName, err := want.ColumnName(col)
+		if err != nil {
+			return err
+		}
+		name, err := other.ColumnName(col)
+		if err != nil {
+			return err
+		}
+		if name != wantName {
+			return fmt.Errorf("%w: column %d is %q, expected %q",
+				ErrSchemaMismatch, col, name, wantName)
+		}
+
+		wantType, err := want.ColumnType(col)
+		if err != nil {
+			return err
+		}
+		colType, err := other.ColumnType(col)
+		if err != nil {
+			return err
+		}
+		if colType != wantType {
+			return fmt.Errorf("%w: column %q is %s, expected %s",
+				ErrSchemaMismatch, name, colType, wantType)
+		}
+	}
+
+	return nil
+}
+
+// locate maps a global row index to a source and its local row index.
+func (ds *concatDataSource) locate(row int) (DataSource, int, error) {
+	if row < 0 || row >= ds.rows {
+		return nil, 0, fmt.Errorf("%w: %d", ErrInvalidRow, row)
+	}
+
+	// Last source whose first row is at or before row; empty sources share
+	// their offset with the next one, so step past them.
+	i := sort.SearchInts(ds.offsets, row+1) - 1
+	for ds.sources[i].RowCount() == 0 {
+		i++
+	}
+	return ds.sources[i], row - ds.offsets[i], nil
+}
+
+// Release releases every combined source that implements Releaser.
+// Sources are released at most once, however often Release is called.
+func (ds *concatDataSource) Release() {
+	ds.releaseOnce.Do(func() {
+		for _, source := range ds.sources {
+			ReleaseSource(source)
+		}
+	})
+}
+
+// RowCount returns the combined number of rows.
+func (ds *concatDataSource) RowCount() int {
+	return ds.rows
+}
+
+// ColumnCount returns the number of columns shared by all sources.
+func (ds *concatDataSource) ColumnCount() int {
+	return ds.sources[0].ColumnCount()
+}
+
+// ColumnName returns the name of the column at the given index.
+func (ds *concatDataSource) ColumnName(col int) (string, error) {
+	return ds.sources[0].ColumnName(col)
+}
+
+// ColumnType returns the data type of the column at the given index.
+func (ds *concatDataSource) ColumnType(col int) (DataType, error) {
+	return ds.sources[0].ColumnType(col)
+}
+
+// Cell returns the value at the specified global row and column.
+func (ds *concatDataSource) Cell(row, col int) (Value, error) {
+	source, local, err := ds.locate(row)
+	if err != nil {
+		return Value{}, err
+	}
+	return source.Cell(local, col)
+}
+
+// Row returns all values for the specified global row.
+func (ds *concatDataSource) Row(row int) ([]Value, error) {
+	source, local, err := ds.locate(row)
+	if err != nil {
+		return nil, err
+	}
+	return source.Row(local)
+}
+
+// Metadata reports how many sources were combined.
+func (ds *concatDataSource) Metadata() Metadata {
+	return Metadata{
+		"source_count": len(ds.sources),
+	}
+}
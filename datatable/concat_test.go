@@ -0,0 +1,142 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"errors"
+	"testing"
+)
+
+// newMonthSource builds an in-memory source with Month and Amount columns.
+func newMonthSource(month string, amounts ...int) *mockDataSource {
+	data := make([][]Value, len(amounts))
+	for i, amount := range amounts {
+		data[i] = []Value{
+			NewValue(month, TypeString),
+			NewValue(amount, TypeInt),
+		}
+	}
+	return &mockDataSource{
+		rows:        len(amounts),
+		cols:        2,
+		columnNames: []string{"Month", "Amount"},
+		columnTypes: []DataType{TypeString, TypeInt},
+		data:        data,
+	}
+}
+
+func TestConcatSources(t *testing.T) {
+	jan := newMonthSource("Jan", 10, 20)
+	feb := newMonthSource("Feb", 30, 40, 50)
+
+	combined, err := ConcatSources(jan, feb)
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+
+	if got := combined.RowCount(); got != 5 {
+		t.Errorf("RowCount() = %d, want 5", got)
+	}
+	if got := combined.ColumnCount(); got != 2 {
+		t.Errorf("ColumnCount() = %d, want 2", got)
+	}
+	if name, _ := combined.ColumnName(1); name != "Amount" {
+		t.Errorf("ColumnName(1) = %q, want Amount", name)
+	}
+	if colType, _ := combined.ColumnType(1); colType != TypeInt {
+		t.Errorf("ColumnType(1) = %v, want Int", colType)
+	}
+
+	// Rows on either side of the boundary come from the right source
+	tests := []struct {
+		row    int
+		month  string
+		amount string
+	}{
+		{0, "Jan", "10"},
+		{1, "Jan", "20"},
+		{2, "Feb", "30"},
+		{4, "Feb", "50"},
+	}
+	for _, tt := range tests {
+		cell, err := combined.Cell(tt.row, 0)
+		if err != nil {
+			t.Fatalf("Cell(%d, 0) error = %v", tt.row, err)
+		}
+		if cell.Formatted != tt.month {
+			t.Errorf("Cell(%d, 0) = %q, want %q", tt.row, cell.Formatted, tt.month)
+		}
+
+		row, err := combined.Row(tt.row)
+		if err != nil {
+			t.Fatalf("Row(%d) error = %v", tt.row, err)
+		}
+		if row[1].Formatted != tt.amount {
+			t.Errorf("Row(%d)[1] = %q, want %q", tt.row, row[1].Formatted, tt.amount)
+		}
+	}
+
+	for _, row := range []int{-1, 5} {
+		if _, err := combined.Cell(row, 0); !errors.Is(err, ErrInvalidRow) {
+			t.Errorf("Cell(%d, 0) error = %v, want ErrInvalidRow", row, err)
+		}
+		if _, err := combined.Row(row); !errors.Is(err, ErrInvalidRow) {
+			t.Errorf("Row(%d) error = %v, want ErrInvalidRow", row, err)
+		}
+	}
+}
+
+func TestConcatSources_EmptySource(t *testing.T) {
+	combined, err := ConcatSources(newMonthSource("Jan", 10), newMonthSource("Feb"), newMonthSource("Mar", 30))
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+
+	if got := combined.RowCount(); got != 2 {
+		t.Errorf("RowCount() = %d, want 2", got)
+	}
+	if cell, _ := combined.Cell(1, 0); cell.Formatted != "Mar" {
+		t.Errorf("Cell(1, 0) = %q, want Mar", cell.Formatted)
+	}
+}
+
+func TestConcatSources_SchemaMismatch(t *testing.T) {
+	renamed := newMonthSource("Feb", 30)
+	renamed.columnNames = []string{"Month", "Total"}
+
+	retyped := newMonthSource("Feb", 30)
+	retyped.columnTypes = []DataType{TypeString, TypeFloat}
+
+	tests := []struct {
+		name    string
+		sources []DataSource
+		wantErr error
+	}{
+		{"no sources", nil, ErrEmptyData},
+		{"nil source", []DataSource{newMonthSource("Jan", 10), nil}, ErrNoDataSource},
+		{"column count", []DataSource{newMonthSource("Jan", 10), newMockDataSource(1, 3)}, ErrSchemaMismatch},
+		{"column name", []DataSource{newMonthSource("Jan", 10), renamed}, ErrSchemaMismatch},
+		{"column type", []DataSource{newMonthSource("Jan", 10), retyped}, ErrSchemaMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ConcatSources(tt.sources...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ConcatSources() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@
 
 package datatable
 
+import "fmt"
+
 // DataSource provides read-only access to tabular data.
 // Implementations must be thread-safe for concurrent reads.
 // All methods should return errors rather than panic.
@@ -45,3 +47,81 @@ type DataSource interface {
 	// Returns an empty Metadata map if no metadata is available.
 	Metadata() Metadata
 }
+
+// Releaser is implemented by data sources that hold resources, such as Arrow
+// memory, that must be freed when the source is no longer needed.
+// Sources that wrap other sources implement it to pass the call on.
+type Releaser interface {
+	// Release frees the resources held by the source.
+	Release()
+}
+
+// ReleaseSource calls Release on src if it implements Releaser.
+// It is a no-op for nil sources and sources without resources.
+func ReleaseSource(src DataSource) {
+	if r, ok := src.(Releaser); ok {
+		r.Release()
+	}
+}
+
+// Int64ColumnSource is implemented by data sources that can expose an integer
+// column as a contiguous Go slice. Engines that scan a whole column, such as
+// sorting, use it to avoid building a Value for every cell.
+type Int64ColumnSource interface {
+	// Int64Values returns the values of column col, indexed by row. valid
+	// is nil when the column has no nulls; otherwise valid[row] is false
+	// for null rows. ok is false when the column cannot be represented as
+	// int64, in which case callers fall back to Cell.
+	// The returned slices must not be modified.
+	Int64Values(col int) (values []int64, valid []bool, ok bool)
+}
+
+// MutableDataSource is implemented by data sources whose cells can be
+// changed after construction. Implementations must remain safe for
+// concurrent reads while a write is in progress.
+type MutableDataSource interface {
+	DataSource
+
+	// SetCell replaces the value at the specified row and column.
+	// Returns ErrInvalidRow if row is out of range.
+	// Returns ErrInvalidColumn if col is out of range.
+	// Returns ErrTypeMismatch if a non-null value has a different type
+	// than the column.
+	SetCell(row, col int, value Value) error
+
+	// AppendRow adds a row after the last row.
+	// Returns ErrSchemaMismatch if len(values) differs from ColumnCount,
+	// or ErrTypeMismatch if a non-null value does not match its column.
+	AppendRow(values []Value) error
+}
+
+// ColumnSchema describes a single column of a DataSource.
+type ColumnSchema struct {
+	// Name is the column name.
+	Name string
+	// Type is the column data type.
+	Type DataType
+}
+
+// SchemaOf returns the name and type of every column in src, in column order.
+// Returns ErrNoDataSource if src is nil, or the first error reported by
+// ColumnName or ColumnType.
+func SchemaOf(src DataSource) ([]ColumnSchema, error) {
+	if src == nil {
+		return nil, ErrNoDataSource
+	}
+
+	schema := make([]ColumnSchema, src.ColumnCount())
+	for i := range schema {
+		name, err := src.ColumnName(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get column name %d: %w", i, err)
+		}
+		colType, err := src.ColumnType(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get column type %d: %w", i, err)
+		}
+		schema[i] = ColumnSchema{Name: name, Type: colType}
+	}
+	return schema, nil
+}
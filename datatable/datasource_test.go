@@ -0,0 +1,124 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"errors"
+	"testing"
+)
+
+// brokenTypeSource reports an error for every column type.
+type brokenTypeSource struct {
+	*mockDataSource
+}
+
+func (b brokenTypeSource) ColumnType(col int) (DataType, error) {
+	return TypeString, ErrInvalidColumn
+}
+
+func TestSchemaOf(t *testing.T) {
+	mixed := newMockDataSource(2, 3)
+	mixed.columnTypes = []DataType{TypeString, TypeInt, TypeFloat}
+
+	concat, err := ConcatSources(newMonthSource("Jan", 1), newMonthSource("Feb", 2))
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+
+	sources := map[string]DataSource{
+		"mock":   mixed,
+		"month":  newMonthSource("Jan", 10, 20),
+		"concat": concat,
+		"empty":  newMockDataSource(0, 0),
+	}
+
+	for name, src := range sources {
+		t.Run(name, func(t *testing.T) {
+			schema, err := SchemaOf(src)
+			if err != nil {
+				t.Fatalf("SchemaOf() error = %v", err)
+			}
+			if len(schema) != src.ColumnCount() {
+				t.Fatalf("SchemaOf() returned %d columns, want %d", len(schema), src.ColumnCount())
+			}
+			for i, col := range schema {
+				wantName, _ := src.ColumnName(i)
+				wantType, _ := src.ColumnType(i)
+				if col.Name != wantName || col.Type != wantType {
+					t.Errorf("SchemaOf()[%d] = %+v, want {%s %s}", i, col, wantName, wantType)
+				}
+			}
+		})
+	}
+}
+
+func TestSchemaOf_Errors(t *testing.T) {
+	if _, err := SchemaOf(nil); !errors.Is(err, ErrNoDataSource) {
+		t.Errorf("SchemaOf(nil) error = %v, want ErrNoDataSource", err)
+	}
+
+	broken := brokenTypeSource{newMockDataSource(1, 2)}
+	if _, err := SchemaOf(broken); !errors.Is(err, ErrInvalidColumn) {
+		t.Errorf("SchemaOf() error = %v, want ErrInvalidColumn", err)
+	}
+}
+
+// releaseRecorder counts Release calls on a wrapped mock source.
+type releaseRecorder struct {
+	*mockDataSource
+	released int
+}
+
+func (r *releaseRecorder) Release() {
+	r.released++
+}
+
+func TestReleaseSource(t *testing.T) {
+	rec := &releaseRecorder{mockDataSource: newMockDataSource(1, 1)}
+	ReleaseSource(rec)
+	if rec.released != 1 {
+		t.Errorf("Release called %d times, want 1", rec.released)
+	}
+
+	// Sources without Release and nil sources are ignored
+	ReleaseSource(newMockDataSource(1, 1))
+	ReleaseSource(nil)
+}
+
+func TestConcatSources_Release(t *testing.T) {
+	first := &releaseRecorder{mockDataSource: newMonthSource("Jan", 1)}
+	second := &releaseRecorder{mockDataSource: newMonthSource("Feb", 2)}
+	plain := newMonthSource("Mar", 3)
+
+	inner, err := ConcatSources(first, plain)
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+	outer, err := ConcatSources(inner, second)
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+
+	if _, ok := outer.(Releaser); !ok {
+		t.Fatal("concatenated source does not implement Releaser")
+	}
+
+	ReleaseSource(outer)
+	ReleaseSource(outer)
+
+	if first.released != 1 || second.released != 1 {
+		t.Errorf("Release calls = %d, %d; want 1, 1", first.released, second.released)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatabletest
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// conformanceSampleRows is the maximum number of rows a conformance check
+// reads from the source under test.
+const conformanceSampleRows = 50
+
+// conformanceReaders is the number of goroutines used by the concurrent
+// read check.
+const conformanceReaders = 8
+
+// RunConformance checks that the sources returned by newSource follow the
+// datatable.DataSource contract. Each check runs as a subtest against a
+// fresh source, which is released with datatable.ReleaseSource afterwards.
+//
+// Adapter packages call it from a test with a representative source:
+//
+//	func TestConformance(t *testing.T) {
+//		datatabletest.RunConformance(t, func() datatable.DataSource {
+//			src, err := NewDataSource(rows, names)
+//			if err != nil {
+//				t.Fatal(err)
+//			}
+//			return src
+//		})
+//	}
+//
+// The source should contain at least one row, and a null cell if the adapter
+// supports nulls, so that every check has something to inspect.
+func RunConformance(t *testing.T, newSource func() datatable.DataSource) {
+	t.Helper()
+
+	checks := []struct {
+		name  string
+		check func(t *testing.T, src datatable.DataSource)
+	}{
+		{"Validate", checkValidate},
+		{"Counts", checkCounts},
+		{"Schema", checkSchema},
+		{"ColumnBounds", checkColumnBounds},
+		{"CellBounds", checkCellBounds},
+		{"RowBounds", checkRowBounds},
+		{"RowCellAgreement", checkRowCellAgreement},
+		{"Nulls", checkNulls},
+		{"Metadata", checkMetadata},
+		{"ConcurrentReads", checkConcurrentReads},
+	}
+
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			src := newSource()
+			if src == nil {
+				t.Fatal("newSource returned nil")
+			}
+			t.Cleanup(func() { datatable.ReleaseSource(src) })
+			c.check(t, src)
+		})
+	}
+}
+
+func checkValidate(t *testing.T, src datatable.DataSource) {
+	if err := datatable.ValidateSource(src); err != nil {
+		t.Error(err)
+	}
+}
+
+func checkCounts(t *testing.T, src datatable.DataSource) {
+	rows, cols := src.RowCount(), src.ColumnCount()
+	if rows < 0 || cols < 0 {
+		t.Fatalf("size = %dx%d, want non-negative counts", rows, cols)
+	}
+	if src.RowCount() != rows || src.ColumnCount() != cols {
+		t.Errorf("counts changed between calls: %dx%d then %dx%d",
+			rows, cols, src.RowCount(), src.ColumnCount())
+	}
+}
+
+func checkSchema(t *testing.T, src datatable.DataSource) {
+	for col := 0; col < src.ColumnCount(); col++ {
+		name, err := src.ColumnName(col)
+		if err != nil {
+			t.Errorf("ColumnName(%d) error = %v", col, err)
+		}
+		if again, _ := src.ColumnName(col); again != name {
+			t.Errorf("ColumnName(%d) = %q then %q", col, name, again)
+		}
+
+		colType, err := src.ColumnType(col)
+		if err != nil {
+			t.Errorf("ColumnType(%d) error = %v", col, err)
+		}
+		if again, _ := src.ColumnType(col); again != colType {
+			t.Errorf("ColumnType(%d) = %v then %v", col, colType, again)
+		}
+	}
+}
+
+func checkColumnBounds(t *testing.T, src datatable.DataSource) {
+	for _, col := range []int{-1, src.ColumnCount()} {
+		if _, err := src.ColumnName(col); !errors.Is(err, datatable.ErrInvalidColumn) {
+			t.Errorf("ColumnName(%d) error = %v, want ErrInvalidColumn", col, err)
+		}
+		if _, err := src.ColumnType(col); !errors.Is(err, datatable.ErrInvalidColumn) {
+			t.Errorf("ColumnType(%d) error = %v, want ErrInvalidColumn", col, err)
+		}
+	}
+}
+
+func checkCellBounds(t *testing.T, src datatable.DataSource) {
+	rows, cols := src.RowCount(), src.ColumnCount()
+	if rows == 0 || cols == 0 {
+		t.Skip("source has no cells")
+	}
+
+	tests := []struct {
+		row, col int
+		want     error
+	}{
+		{-1, 0, datatable.ErrInvalidRow},
+		{rows, 0, datatable.ErrInvalidRow},
+		{0, -1, datatable.ErrInvalidColumn},
+		{0, cols, datatable.ErrInvalidColumn},
+		{rows - 1, cols, datatable.ErrInvalidColumn},
+	}
+	for _, tt := range tests {
+		if _, err := src.Cell(tt.row, tt.col); !errors.Is(err, tt.want) {
+			t.Errorf("Cell(%d, %d) error = %v, want %v", tt.row, tt.col, err, tt.want)
+		}
+	}
+}
+
+func checkRowBounds(t *testing.T, src datatable.DataSource) {
+	for _, row := range []int{-1, src.RowCount()} {
+		if _, err := src.Row(row); !errors.Is(err, datatable.ErrInvalidRow) {
+			t.Errorf("Row(%d) error = %v, want ErrInvalidRow", row, err)
+		}
+	}
+}
+
+func checkRowCellAgreement(t *testing.T, src datatable.DataSource) {
+	for _, row := range sampleIndices(src.RowCount(), conformanceSampleRows) {
+		values, err := src.Row(row)
+		if err != nil {
+			t.Fatalf("Row(%d) error = %v", row, err)
+		}
+		if len(values) != src.ColumnCount() {
+			t.Fatalf("Row(%d) has %d values, want %d", row, len(values), src.ColumnCount())
+		}
+
+		for col, fromRow := range values {
+			fromCell, err := src.Cell(row, col)
+			if err != nil {
+				t.Fatalf("Cell(%d, %d) error = %v", row, col, err)
+			}
+			fromRow.EnsureFormatted()
+			fromCell.EnsureFormatted()
+			if fromRow.IsNull != fromCell.IsNull || fromRow.Formatted != fromCell.Formatted {
+				t.Errorf("Row(%d)[%d] = %q (null %v), Cell = %q (null %v)", row, col,
+					fromRow.Formatted, fromRow.IsNull, fromCell.Formatted, fromCell.IsNull)
+			}
+		}
+	}
+}
+
+func checkNulls(t *testing.T, src datatable.DataSource) {
+	nulls := 0
+	for _, row := range sampleIndices(src.RowCount(), conformanceSampleRows) {
+		for col := 0; col < src.ColumnCount(); col++ {
+			v, err := src.Cell(row, col)
+			if err != nil {
+				t.Fatalf("Cell(%d, %d) error = %v", row, col, err)
+			}
+			if !v.IsNull {
+				continue
+			}
+			nulls++
+			v.EnsureFormatted()
+			if v.Raw != nil || v.Formatted != "" || v.IsError() {
+				t.Errorf("null Cell(%d, %d) = %+v, want nil Raw and empty Formatted", row, col, v)
+			}
+		}
+	}
+	if nulls == 0 {
+		t.Skip("no null cells in sampled rows")
+	}
+}
+
+func checkMetadata(t *testing.T, src datatable.DataSource) {
+	if src.Metadata() == nil {
+		t.Error("Metadata() = nil, want an empty map when there is no metadata")
+	}
+}
+
+func checkConcurrentReads(t *testing.T, src datatable.DataSource) {
+	rows := sampleIndices(src.RowCount(), conformanceSampleRows)
+	errs := make(chan error, conformanceReaders)
+
+	var wg sync.WaitGroup
+	for i := 0; i < conformanceReaders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, row := range rows {
+				if _, err := src.Row(row); err != nil {
+					errs <- fmt.Errorf("Row(%d): %w", row, err)
+					return
+				}
+				for col := 0; col < src.ColumnCount(); col++ {
+					if _, err := src.Cell(row, col); err != nil {
+						errs <- fmt.Errorf("Cell(%d, %d): %w", row, col, err)
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+// sampleIndices returns up to limit indices evenly spread over n items,
+// including the first and last.
+func sampleIndices(n, limit int) []int {
+	count := min(n, limit)
+	indices := make([]int, count)
+	for i := range indices {
+		if count == n {
+			indices[i] = i
+		} else {
+			indices[i] = i * (n - 1) / (limit - 1)
+		}
+	}
+	return indices
+}
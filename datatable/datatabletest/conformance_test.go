@@ -0,0 +1,57 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatabletest
+
+import (
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestRunConformance_MockSource(t *testing.T) {
+	RunConformance(t, func() datatable.DataSource {
+		return newTestSource()
+	})
+}
+
+func TestRunConformance_MutableMockSource(t *testing.T) {
+	RunConformance(t, func() datatable.DataSource {
+		return NewMutableMockSource(testColumns, [][]any{{"Alice", nil}})
+	})
+}
+
+func TestSampleIndices(t *testing.T) {
+	tests := []struct {
+		n, limit int
+		want     []int
+	}{
+		{0, 5, []int{}},
+		{3, 5, []int{0, 1, 2}},
+		{9, 5, []int{0, 2, 4, 6, 8}},
+	}
+	for _, tt := range tests {
+		got := sampleIndices(tt.n, tt.limit)
+		if len(got) != len(tt.want) {
+			t.Errorf("sampleIndices(%d, %d) = %v, want %v", tt.n, tt.limit, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("sampleIndices(%d, %d) = %v, want %v", tt.n, tt.limit, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package datatabletest provides utilities for testing code that works with
+// datatable.DataSource, such as engines, widgets and data source adapters.
+package datatabletest
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// MockSource is an in-memory datatable.DataSource built from Go values.
+// It is safe for concurrent use.
+type MockSource struct {
+	mu      sync.RWMutex
+	columns []datatable.ColumnSchema
+	rows    [][]datatable.Value
+}
+
+// NewMockSource returns a data source with the given columns and rows.
+//
+// Each row must have one entry per column. A nil entry becomes a null cell
+// of the column's type; any other entry is stored as the cell's raw value
+// with datatable.NewValue. NewMockSource panics if a row has the wrong
+// length, since that is a mistake in the test itself.
+func NewMockSource(columns []datatable.ColumnSchema, rows [][]any) *MockSource {
+	m := &MockSource{
+		columns: append([]datatable.ColumnSchema(nil), columns...),
+		rows:    make([][]datatable.Value, len(rows)),
+	}
+
+	for r, row := range rows {
+		if len(row) != len(columns) {
+			panic(fmt.Sprintf("datatabletest: row %d has %d values, want %d", r, len(row), len(columns)))
+		}
+		values := make([]datatable.Value, len(row))
+		for c, raw := range row {
+			if raw == nil {
+				values[c] = datatable.NewNullValue(columns[c].Type)
+			} else {
+				values[c] = datatable.NewValue(raw, columns[c].Type)
+			}
+		}
+		m.rows[r] = values
+	}
+
+	return m
+}
+
+// RowCount returns the number of rows.
+func (m *MockSource) RowCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.rows)
+}
+
+// ColumnCount returns the number of columns.
+func (m *MockSource) ColumnCount() int {
+	return len(m.columns)
+}
+
+// ColumnName returns the name of column col.
+func (m *MockSource) ColumnName(col int) (string, error) {
+	if col < 0 || col >= len(m.columns) {
+		return "", datatable.ErrInvalidColumn
+	}
+	return m.columns[col].Name, nil
+}
+
+// ColumnType returns the type of column col.
+func (m *MockSource) ColumnType(col int) (datatable.DataType, error) {
+	if col < 0 || col >= len(m.columns) {
+		return datatable.TypeString, datatable.ErrInvalidColumn
+	}
+	return m.columns[col].Type, nil
+}
+
+// Cell returns the value at row, col.
+func (m *MockSource) Cell(row, col int) (datatable.Value, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if row < 0 || row >= len(m.rows) {
+		return datatable.Value{}, datatable.ErrInvalidRow
+	}
+	if col < 0 || col >= len(m.columns) {
+		return datatable.Value{}, datatable.ErrInvalidColumn
+	}
+	return m.rows[row][col], nil
+}
+
+// Row returns a copy of all values in row.
+func (m *MockSource) Row(row int) ([]datatable.Value, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if row < 0 || row >= len(m.rows) {
+		return nil, datatable.ErrInvalidRow
+	}
+	return append([]datatable.Value(nil), m.rows[row]...), nil
+}
+
+// Metadata returns an empty metadata map.
+func (m *MockSource) Metadata() datatable.Metadata {
+	return datatable.Metadata{}
+}
+
+// MutableMockSource is a MockSource that implements
+// datatable.MutableDataSource.
+type MutableMockSource struct {
+	*MockSource
+}
+
+// NewMutableMockSource returns a mutable data source with the given columns
+// and rows. Rows are converted as in NewMockSource.
+func NewMutableMockSource(columns []datatable.ColumnSchema, rows [][]any) *MutableMockSource {
+	return &MutableMockSource{MockSource: NewMockSource(columns, rows)}
+}
+
+// SetCell replaces the value at row, col.
+func (m *MutableMockSource) SetCell(row, col int, value datatable.Value) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if row < 0 || row >= len(m.rows) {
+		return datatable.ErrInvalidRow
+	}
+	if col < 0 || col >= len(m.columns) {
+		return datatable.ErrInvalidColumn
+	}
+	if err := m.checkType(col, value); err != nil {
+		return err
+	}
+
+	m.rows[row][col] = value
+	return nil
+}
+
+// AppendRow adds a copy of values as the last row.
+func (m *MutableMockSource) AppendRow(values []datatable.Value) error {
+	if len(values) != len(m.columns) {
+		return fmt.Errorf("%w: row has %d values, want %d",
+			datatable.ErrSchemaMismatch, len(values), len(m.columns))
+	}
+	for col, value := range values {
+		if err := m.checkType(col, value); err != nil {
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rows = append(m.rows, append([]datatable.Value(nil), values...))
+	return nil
+}
+
+// checkType verifies that a non-null value matches the type of column col.
+func (m *MutableMockSource) checkType(col int, value datatable.Value) error {
+	if value.IsNull || value.Type == m.columns[col].Type {
+		return nil
+	}
+	return fmt.Errorf("%w: column %q is %s, value is %s",
+		datatable.ErrTypeMismatch, m.columns[col].Name, m.columns[col].Type, value.Type)
+}
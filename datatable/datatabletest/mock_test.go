@@ -0,0 +1,199 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatabletest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+var testColumns = []datatable.ColumnSchema{
+	{Name: "name", Type: datatable.TypeString},
+	{Name: "age", Type: datatable.TypeInt},
+}
+
+func newTestSource() *MockSource {
+	return NewMockSource(testColumns, [][]any{
+		{"Alice", int64(30)},
+		{nil, int64(25)},
+		{"Carol", nil},
+	})
+}
+
+func TestMockSource_Schema(t *testing.T) {
+	var src datatable.DataSource = newTestSource()
+
+	if src.RowCount() != 3 || src.ColumnCount() != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", src.RowCount(), src.ColumnCount())
+	}
+
+	schema, err := datatable.SchemaOf(src)
+	if err != nil {
+		t.Fatalf("SchemaOf() error = %v", err)
+	}
+	for i, col := range schema {
+		if col != testColumns[i] {
+			t.Errorf("column %d = %+v, want %+v", i, col, testColumns[i])
+		}
+	}
+
+	if src.Metadata() == nil {
+		t.Error("Metadata() = nil, want empty map")
+	}
+}
+
+func TestMockSource_Cells(t *testing.T) {
+	src := newTestSource()
+
+	v, err := src.Cell(0, 1)
+	if err != nil {
+		t.Fatalf("Cell(0, 1) error = %v", err)
+	}
+	if v.IsNull || v.Raw != int64(30) || v.Formatted != "30" || v.Type != datatable.TypeInt {
+		t.Errorf("Cell(0, 1) = %+v", v)
+	}
+
+	tests := []struct {
+		row, col int
+		wantType datatable.DataType
+	}{
+		{1, 0, datatable.TypeString},
+		{2, 1, datatable.TypeInt},
+	}
+	for _, tt := range tests {
+		v, err := src.Cell(tt.row, tt.col)
+		if err != nil {
+			t.Fatalf("Cell(%d, %d) error = %v", tt.row, tt.col, err)
+		}
+		if !v.IsNull || v.Raw != nil || v.Type != tt.wantType {
+			t.Errorf("Cell(%d, %d) = %+v, want null %v", tt.row, tt.col, v, tt.wantType)
+		}
+	}
+
+	row, err := src.Row(2)
+	if err != nil {
+		t.Fatalf("Row(2) error = %v", err)
+	}
+	if row[0].Formatted != "Carol" || !row[1].IsNull {
+		t.Errorf("Row(2) = %+v", row)
+	}
+
+	// Row returns a copy
+	row[0] = datatable.NewValue("changed", datatable.TypeString)
+	if v, _ := src.Cell(2, 0); v.Formatted != "Carol" {
+		t.Errorf("modifying Row() result changed the source: %q", v.Formatted)
+	}
+}
+
+func TestMockSource_Bounds(t *testing.T) {
+	src := newTestSource()
+
+	tests := []struct {
+		name     string
+		row, col int
+		want     error
+	}{
+		{"negative row", -1, 0, datatable.ErrInvalidRow},
+		{"row past end", 3, 0, datatable.ErrInvalidRow},
+		{"negative column", 0, -1, datatable.ErrInvalidColumn},
+		{"column past end", 0, 2, datatable.ErrInvalidColumn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := src.Cell(tt.row, tt.col); !errors.Is(err, tt.want) {
+				t.Errorf("Cell() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := src.Row(3); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("Row(3) error = %v, want ErrInvalidRow", err)
+	}
+	if _, err := src.ColumnName(2); !errors.Is(err, datatable.ErrInvalidColumn) {
+		t.Errorf("ColumnName(2) error = %v, want ErrInvalidColumn", err)
+	}
+	if _, err := src.ColumnType(-1); !errors.Is(err, datatable.ErrInvalidColumn) {
+		t.Errorf("ColumnType(-1) error = %v, want ErrInvalidColumn", err)
+	}
+}
+
+func TestNewMockSource_RaggedRowPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewMockSource() with a short row did not panic")
+		}
+	}()
+	NewMockSource(testColumns, [][]any{{"Alice"}})
+}
+
+func TestMutableMockSource(t *testing.T) {
+	var src datatable.MutableDataSource = NewMutableMockSource(testColumns, [][]any{
+		{"Alice", int64(30)},
+	})
+
+	if err := src.SetCell(0, 1, datatable.NewValue(int64(31), datatable.TypeInt)); err != nil {
+		t.Fatalf("SetCell() error = %v", err)
+	}
+	if v, _ := src.Cell(0, 1); v.Raw != int64(31) {
+		t.Errorf("Cell(0, 1) = %v after SetCell, want 31", v.Raw)
+	}
+
+	if err := src.SetCell(0, 0, datatable.NewNullValue(datatable.TypeString)); err != nil {
+		t.Fatalf("SetCell(null) error = %v", err)
+	}
+	if v, _ := src.Cell(0, 0); !v.IsNull {
+		t.Errorf("Cell(0, 0) = %+v after setting null", v)
+	}
+
+	err := src.AppendRow([]datatable.Value{
+		datatable.NewValue("Bob", datatable.TypeString),
+		datatable.NewNullValue(datatable.TypeInt),
+	})
+	if err != nil {
+		t.Fatalf("AppendRow() error = %v", err)
+	}
+	if src.RowCount() != 2 {
+		t.Fatalf("RowCount() = %d after AppendRow, want 2", src.RowCount())
+	}
+	if v, _ := src.Cell(1, 0); v.Formatted != "Bob" {
+		t.Errorf("Cell(1, 0) = %q, want Bob", v.Formatted)
+	}
+}
+
+func TestMutableMockSource_Errors(t *testing.T) {
+	src := NewMutableMockSource(testColumns, [][]any{{"Alice", int64(30)}})
+	age := datatable.NewValue(int64(1), datatable.TypeInt)
+
+	if err := src.SetCell(1, 1, age); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("SetCell(1, 1) error = %v, want ErrInvalidRow", err)
+	}
+	if err := src.SetCell(0, 2, age); !errors.Is(err, datatable.ErrInvalidColumn) {
+		t.Errorf("SetCell(0, 2) error = %v, want ErrInvalidColumn", err)
+	}
+	if err := src.SetCell(0, 0, age); !errors.Is(err, datatable.ErrTypeMismatch) {
+		t.Errorf("SetCell(int into string) error = %v, want ErrTypeMismatch", err)
+	}
+	if err := src.AppendRow([]datatable.Value{age}); !errors.Is(err, datatable.ErrSchemaMismatch) {
+		t.Errorf("AppendRow(short) error = %v, want ErrSchemaMismatch", err)
+	}
+	if err := src.AppendRow([]datatable.Value{age, age}); !errors.Is(err, datatable.ErrTypeMismatch) {
+		t.Errorf("AppendRow(wrong type) error = %v, want ErrTypeMismatch", err)
+	}
+	if src.RowCount() != 1 {
+		t.Errorf("RowCount() = %d after failed appends, want 1", src.RowCount())
+	}
+}
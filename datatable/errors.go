@@ -44,4 +44,11 @@ var (
 
 	// ErrExportFailed is returned when export operation fails.
 	ErrExportFailed = errors.New("export failed")
+
+	// ErrSchemaMismatch is returned when data sources have incompatible columns.
+	ErrSchemaMismatch = errors.New("schema mismatch")
+
+	// ErrInconsistentSource is returned by ValidateSource when a data source
+	// does not behave as the DataSource interface requires.
+	ErrInconsistentSource = errors.New("inconsistent data source")
 )
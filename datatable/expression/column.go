@@ -23,6 +23,7 @@ import (
 //   - A pass-through column (references a source column by index)
 //   - A computed column (has an expression)
 //   - A transformed column (both source and expression)
+//   - A window column (a running aggregate over another column)
 type ColumnDefinition struct {
 	// Name is the column name
 	Name string
@@ -37,6 +38,10 @@ type ColumnDefinition struct {
 	// Expression is the computation for this column (nil for pure pass-through columns)
 	Expression *Expression
 
+	// Window is a whole-column computation such as a running total
+	// (nil unless this is a window column)
+	Window *WindowFunction
+
 	// Materialized indicates if the column values are cached
 	Materialized bool
 
@@ -45,6 +50,10 @@ type ColumnDefinition struct {
 
 	// Metadata stores additional column information
 	Metadata map[string]any
+
+	// id is a unique identity assigned by ExpressionDataSource. Unlike the
+	// column's position it never changes, so cached data is keyed by it.
+	id int
 }
 
 // IsPassThrough returns true if this is a pass-through column (no expression).
@@ -52,14 +61,19 @@ func (cd *ColumnDefinition) IsPassThrough() bool {
 	return cd.Expression == nil && cd.SourceColumn != nil
 }
 
-// IsComputed returns true if this is a computed column (has expression).
+// IsComputed returns true if this is a computed column (has expression or window function).
 func (cd *ColumnDefinition) IsComputed() bool {
-	return cd.Expression != nil
+	return cd.Expression != nil || cd.Window != nil
+}
+
+// IsWindow returns true if this is a window column.
+func (cd *ColumnDefinition) IsWindow() bool {
+	return cd.Window != nil
 }
 
 // IsPure returns true if this is a pure computed column (no source reference).
 func (cd *ColumnDefinition) IsPure() bool {
-	return cd.Expression != nil && cd.SourceColumn == nil
+	return cd.IsComputed() && cd.SourceColumn == nil
 }
 
 // IsTransformed returns true if this transforms a source column with an expression.
@@ -69,6 +83,9 @@ func (cd *ColumnDefinition) IsTransformed() bool {
 
 // InputColumns returns the names of columns this definition depends on.
 func (cd *ColumnDefinition) InputColumns() []string {
+	if cd.Window != nil {
+		return cd.Window.InputColumns()
+	}
 	if cd.Expression == nil {
 		return []string{}
 	}
@@ -81,11 +98,18 @@ func (cd *ColumnDefinition) Validate() error {
 		return ErrInvalidColumn("column name cannot be empty")
 	}
 
-	// Must have either a source column or an expression (or both)
-	if cd.SourceColumn == nil && cd.Expression == nil {
+	// Must have either a source column or an expression (or both), or a window function
+	if cd.SourceColumn == nil && cd.Expression == nil && cd.Window == nil {
 		return ErrInvalidColumn("column must have either a source column or an expression")
 	}
 
+	// Validate window function if present
+	if cd.Window != nil {
+		if err := cd.Window.Validate(); err != nil {
+			return err
+		}
+	}
+
 	// Validate expression if present
 	if cd.Expression != nil {
 		if err := cd.Expression.Validate(); err != nil {
@@ -35,7 +35,9 @@ import (
 type ExpressionDataSource struct {
 	source              datatable.DataSource
 	columns             []ColumnDefinition
-	materializedColumns map[int]arrow.Array
+	materializedColumns map[int]arrow.Array // Keyed by ColumnDefinition.id
+	nextColumnID        int
+	sourceReleased      bool
 	dependencyGraph     *DependencyGraph
 	allocator           memory.Allocator
 	mu                  sync.RWMutex
@@ -63,6 +65,7 @@ func NewExpressionDataSource(source datatable.DataSource) *ExpressionDataSource
 			SourceColumn: &sourceIdx,
 			Expression:   nil,
 			Materialized: false,
+			id:           ds.newColumnIDLocked(),
 		})
 	}
 
@@ -120,6 +123,7 @@ func (ds *ExpressionDataSource) AddComputedColumnWithDescription(
 		Materialized: false,
 		Description:  description,
 		Metadata:     make(map[string]any),
+		id:           ds.newColumnIDLocked(),
 	}
 
 	// Add to columns
@@ -145,16 +149,24 @@ func (ds *ExpressionDataSource) SetColumnExpression(colName string, expr *Expres
 		return ErrColumnNotFound(colName)
 	}
 
-	// Clear materialization if changing expression
+	// Clear materialization if changing expression, including every column
+	// computed from this one, since their cached values used the old results
 	if ds.columns[colIdx].Materialized {
 		ds.unmaterializeColumnLocked(colIdx)
 	}
+	ds.unmaterializeDependentsLocked(colName)
 
+	prevExpr, prevWindow := ds.columns[colIdx].Expression, ds.columns[colIdx].Window
 	ds.columns[colIdx].Expression = expr
+	ds.columns[colIdx].Window = nil
 	ds.columns[colIdx].Materialized = false
 
-	// Rebuild dependency graph
+	// Rebuild dependency graph, restoring the previous computation if the
+	// new expression creates a cycle
 	if err := ds.rebuildDependencyGraph(); err != nil {
+		ds.columns[colIdx].Expression = prevExpr
+		ds.columns[colIdx].Window = prevWindow
+		ds.rebuildDependencyGraph()
 		return err
 	}
 
@@ -260,6 +272,20 @@ func (ds *ExpressionDataSource) IsMaterialized(colName string) bool {
 	return ds.columns[colIdx].Materialized
 }
 
+// GetExpression returns the expression of the given column, or nil for
+// source and window columns.
+func (ds *ExpressionDataSource) GetExpression(colName string) *Expression {
+	ds.mu.RLock()
+	defer ds.mu.RUnlock()
+
+	colIdx := ds.findColumnIndexLocked(colName)
+	if colIdx == -1 {
+		return nil
+	}
+
+	return ds.columns[colIdx].Expression
+}
+
 // GetDependencies returns the columns that the given column depends on.
 func (ds *ExpressionDataSource) GetDependencies(colName string) []string {
 	ds.mu.RLock()
@@ -304,6 +330,13 @@ func (ds *ExpressionDataSource) findColumnIndexLocked(name string) int {
 	return -1
 }
 
+// newColumnIDLocked returns an id not used by any column of this data source.
+func (ds *ExpressionDataSource) newColumnIDLocked() int {
+	id := ds.nextColumnID
+	ds.nextColumnID++
+	return id
+}
+
 func (ds *ExpressionDataSource) rebuildDependencyGraph() error {
 	graph, err := NewDependencyGraph(ds.columns)
 	if err != nil {
@@ -314,13 +347,27 @@ func (ds *ExpressionDataSource) rebuildDependencyGraph() error {
 }
 
 func (ds *ExpressionDataSource) unmaterializeColumnLocked(colIdx int) {
-	if arr, exists := ds.materializedColumns[colIdx]; exists {
+	id := ds.columns[colIdx].id
+	if arr, exists := ds.materializedColumns[id]; exists {
 		arr.Release()
-		delete(ds.materializedColumns, colIdx)
+		delete(ds.materializedColumns, id)
 	}
 	ds.columns[colIdx].Materialized = false
 }
 
+// unmaterializeDependentsLocked drops the cached data of every column that
+// depends on colName, directly or indirectly.
+func (ds *ExpressionDataSource) unmaterializeDependentsLocked(colName string) {
+	if ds.dependencyGraph == nil {
+		return
+	}
+	for i, col := range ds.columns {
+		if col.Materialized && ds.dependencyGraph.IsDependentOn(col.Name, colName) {
+			ds.unmaterializeColumnLocked(i)
+		}
+	}
+}
+
 // materializeColumnLocked computes and caches a column's values.
 // This is where the actual expression evaluation happens.
 func (ds *ExpressionDataSource) materializeColumnLocked(colIdx int) error {
@@ -330,6 +377,9 @@ func (ds *ExpressionDataSource) materializeColumnLocked(colIdx int) error {
 	}
 
 	colDef := ds.columns[colIdx]
+	if colDef.Window != nil {
+		return ds.materializeWindowColumnLocked(colIdx)
+	}
 	if colDef.Expression == nil {
 		return fmt.Errorf("cannot materialize column without expression")
 	}
@@ -353,7 +403,7 @@ func (ds *ExpressionDataSource) materializeColumnLocked(colIdx int) error {
 	}
 
 	// Cache result
-	ds.materializedColumns[colIdx] = result
+	ds.materializedColumns[colDef.id] = result
 	ds.columns[colIdx].Materialized = true
 
 	return nil
@@ -373,7 +423,7 @@ func (ds *ExpressionDataSource) getColumnAsArrowLocked(colName string) (arrow.Ar
 
 	// If materialized, return cached array
 	if colDef.Materialized {
-		if arr, exists := ds.materializedColumns[colIdx]; exists {
+		if arr, exists := ds.materializedColumns[colDef.id]; exists {
 			return arr, nil
 		}
 	}
@@ -388,7 +438,7 @@ func (ds *ExpressionDataSource) getColumnAsArrowLocked(colName string) (arrow.Ar
 		if err := ds.materializeColumnLocked(colIdx); err != nil {
 			return nil, err
 		}
-		return ds.materializedColumns[colIdx], nil
+		return ds.materializedColumns[colDef.id], nil
 	}
 
 	return nil, fmt.Errorf("cannot convert column %s to Arrow", colName)
@@ -438,7 +488,7 @@ func (ds *ExpressionDataSource) GetMaterializedArrowArray(colName string) (arrow
 		return nil, fmt.Errorf("column %s is not materialized", colName)
 	}
 
-	arr, exists := ds.materializedColumns[colIdx]
+	arr, exists := ds.materializedColumns[ds.columns[colIdx].id]
 	if !exists {
 		return nil, fmt.Errorf("materialized array not found for column %s", colName)
 	}
@@ -453,9 +503,9 @@ func (ds *ExpressionDataSource) GetAllMaterializedArrays() map[string]arrow.Arra
 	defer ds.mu.RUnlock()
 
 	result := make(map[string]arrow.Array)
-	for colIdx, arr := range ds.materializedColumns {
-		if colIdx < len(ds.columns) && ds.columns[colIdx].Materialized {
-			result[ds.columns[colIdx].Name] = arr
+	for _, col := range ds.columns {
+		if arr, exists := ds.materializedColumns[col.id]; exists && col.Materialized {
+			result[col.Name] = arr
 		}
 	}
 	return result
@@ -463,9 +513,10 @@ func (ds *ExpressionDataSource) GetAllMaterializedArrays() map[string]arrow.Arra
 
 // GetColumnAsArrow returns a specific column as an Arrow array.
 // This method handles both original and computed columns.
+// It takes the write lock because a computed column may be materialized.
 func (ds *ExpressionDataSource) GetColumnAsArrow(colIdx int) (arrow.Field, arrow.Column, error) {
-	ds.mu.RLock()
-	defer ds.mu.RUnlock()
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
 
 	if colIdx < 0 || colIdx >= len(ds.columns) {
 		return arrow.Field{}, arrow.Column{}, ErrColumnNotFound(fmt.Sprintf("column %d", colIdx))
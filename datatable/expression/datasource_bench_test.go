@@ -0,0 +1,58 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// Benchmark concurrent reads of a materialized computed column
+func BenchmarkCell_ComputedParallel(b *testing.B) {
+	ds := NewExpressionDataSource(newConcurrencySource())
+	defer ds.Release()
+
+	expr, _ := NewExpression("x * 2", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	ds.AddComputedColumn("doubled", expr, datatable.TypeInt)
+	ds.Materialize("doubled")
+	rows := ds.RowCount()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		row := 0
+		for pb.Next() {
+			ds.Cell(row, 1)
+			row = (row + 1) % rows
+		}
+	})
+}
+
+// Benchmark concurrent reads of a pass-through column
+func BenchmarkCell_PassThroughParallel(b *testing.B) {
+	ds := NewExpressionDataSource(newConcurrencySource())
+	defer ds.Release()
+	rows := ds.RowCount()
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		row := 0
+		for pb.Next() {
+			ds.Cell(row, 0)
+			row = (row + 1) % rows
+		}
+	})
+}
@@ -75,6 +75,8 @@ func (ds *ExpressionDataSource) IsComputedColumn(col int) bool {
 
 // Cell implements datatable.DataSource with lazy evaluation.
 // On first access to a computed column, it triggers materialization.
+// Materialization happens under the write lock, so concurrent first reads of
+// the same column compute it only once.
 func (ds *ExpressionDataSource) Cell(row, col int) (datatable.Value, error) {
 	if row < 0 || row >= ds.source.RowCount() {
 		return datatable.Value{}, datatable.ErrInvalidRow
@@ -87,6 +89,11 @@ func (ds *ExpressionDataSource) Cell(row, col int) (datatable.Value, error) {
 	}
 
 	colDef := ds.columns[col]
+	arr, materialized := ds.materializedColumns[colDef.id]
+	if materialized {
+		// Keep the array alive if another goroutine unmaterializes it
+		arr.Retain()
+	}
 	ds.mu.RUnlock()
 
 	// Case 1: Pass-through column (no expression)
@@ -95,26 +102,51 @@ func (ds *ExpressionDataSource) Cell(row, col int) (datatable.Value, error) {
 	}
 
 	// Case 2: Computed column
-	// Check if materialized
-	ds.mu.RLock()
-	materialized := colDef.Materialized
-	ds.mu.RUnlock()
-
 	if !materialized {
 		// Lazy evaluation: materialize on first access
-		ds.mu.Lock()
-		// Double-check after acquiring write lock
-		if !ds.columns[col].Materialized {
-			if err := ds.materializeColumnLocked(col); err != nil {
-				ds.mu.Unlock()
-				return datatable.Value{}, err
-			}
+		var err error
+		arr, err = ds.materializeByIDLocking(colDef.id)
+		if err != nil {
+			return datatable.Value{}, err
 		}
-		ds.mu.Unlock()
+	}
+	defer arr.Release()
+
+	if row >= arr.Len() {
+		return datatable.Value{}, datatable.ErrInvalidRow
 	}
 
-	// Get value from materialized column
-	return ds.getMaterializedValue(col, row)
+	// Convert Arrow value to datatable.Value
+	return arrowToValue(arr, row), nil
+}
+
+// materializeByIDLocking materializes the column with the given id under the
+// write lock and returns its array with an extra reference the caller must
+// release. The column is looked up again by id because it may have moved or
+// been removed while no lock was held; another goroutine may also have
+// materialized it in the meantime, in which case the cached array is reused.
+func (ds *ExpressionDataSource) materializeByIDLocking(id int) (arrow.Array, error) {
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	colIdx := -1
+	for i, col := range ds.columns {
+		if col.id == id {
+			colIdx = i
+			break
+		}
+	}
+	if colIdx == -1 {
+		return nil, datatable.ErrInvalidColumn
+	}
+
+	if err := ds.materializeColumnLocked(colIdx); err != nil {
+		return nil, err
+	}
+
+	arr := ds.materializedColumns[id]
+	arr.Retain()
+	return arr, nil
 }
 
 // Row implements datatable.DataSource.
@@ -169,24 +201,6 @@ func (ds *ExpressionDataSource) Metadata() datatable.Metadata {
 
 // Helper methods
 
-// getMaterializedValue extracts a value from a materialized Arrow array.
-func (ds *ExpressionDataSource) getMaterializedValue(col, row int) (datatable.Value, error) {
-	ds.mu.RLock()
-	arr, exists := ds.materializedColumns[col]
-	ds.mu.RUnlock()
-
-	if !exists {
-		return datatable.Value{}, fmt.Errorf("column %d not materialized", col)
-	}
-
-	if row < 0 || row >= arr.Len() {
-		return datatable.Value{}, datatable.ErrInvalidRow
-	}
-
-	// Convert Arrow value to datatable.Value
-	return arrowToValue(arr, row), nil
-}
-
 // arrowToValue converts an Arrow array value at the given index to a datatable.Value.
 func arrowToValue(arr arrow.Array, row int) datatable.Value {
 	if arr.IsNull(row) {
@@ -248,20 +262,22 @@ func arrowToValue(arr arrow.Array, row int) datatable.Value {
 		rawValue = strValue
 	case *array.Boolean:
 		rawValue = a.Value(row)
+	case *array.Date32:
+		rawValue = a.Value(row).ToTime()
 	case *array.Date64:
 		dateValue := a.Value(row)
 		// Check if this is an error marker (-1)
 		if dateValue == arrow.Date64(-1) {
 			return datatable.NewErrorValue("date parsing failed", dataType)
 		}
-		rawValue = int64(dateValue)
+		rawValue = dateValue.ToTime()
 	case *array.Timestamp:
 		timestampValue := a.Value(row)
 		// Check if this is an error marker (-1)
 		if timestampValue == arrow.Timestamp(-1) {
 			return datatable.NewErrorValue("timestamp parsing failed", dataType)
 		}
-		rawValue = int64(timestampValue)
+		rawValue = timestampValue.ToTime(a.DataType().(*arrow.TimestampType).Unit)
 	default:
 		rawValue = nil
 	}
@@ -310,6 +326,13 @@ func datatypeToArrow(dt datatable.DataType) arrow.DataType {
 	}
 }
 
+// ArrowType returns the Arrow type that stores a computed column of type t,
+// for use as the output type in ParseWithContext.
+// Types without a direct mapping fall back to Float64.
+func ArrowType(t datatable.DataType) arrow.DataType {
+	return datatypeToArrow(t)
+}
+
 // appendValueToBuilder appends a value to an Arrow builder based on type.
 func appendValueToBuilder(builder array.Builder, value any, targetType arrow.DataType) error {
 	switch targetType.ID() {
@@ -354,6 +377,26 @@ func appendValueToBuilder(builder array.Builder, value any, targetType arrow.Dat
 			return fmt.Errorf("cannot convert %T to bool", value)
 		}
 
+	case arrow.DATE64:
+		b := builder.(*array.Date64Builder)
+		v, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Date64FromTime(v))
+
+	case arrow.TIMESTAMP:
+		b := builder.(*array.TimestampBuilder)
+		v, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		ts, err := arrow.TimestampFromTime(v, targetType.(*arrow.TimestampType).Unit)
+		if err != nil {
+			return err
+		}
+		b.Append(ts)
+
 	default:
 		return fmt.Errorf("unsupported Arrow type: %v", targetType)
 	}
@@ -361,12 +404,19 @@ func appendValueToBuilder(builder array.Builder, value any, targetType arrow.Dat
 	return nil
 }
 
-// Release releases all materialized Arrow arrays.
+// Release releases all materialized Arrow arrays, and the wrapped source if
+// it implements datatable.Releaser.
 // Should be called when the data source is no longer needed.
+// The wrapped source is released at most once, however often Release is called.
 func (ds *ExpressionDataSource) Release() {
 	ds.mu.Lock()
 	defer ds.mu.Unlock()
 
+	if !ds.sourceReleased {
+		datatable.ReleaseSource(ds.source)
+		ds.sourceReleased = true
+	}
+
 	for _, arr := range ds.materializedColumns {
 		arr.Release()
 	}
@@ -15,84 +15,29 @@
 package expression
 
 import (
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
-// mockDataSource is a simple in-memory data source for testing.
-type mockDataSource struct {
-	columnNames []string
-	columnTypes []datatable.DataType
-	data        [][]any
-}
+// mockDataSource is the shared in-memory test source.
+type mockDataSource = datatabletest.MockSource
 
+// newMockDataSource builds a mock source from parallel name and type slices.
+// Nil entries in data become null cells.
 func newMockDataSource(columnNames []string, columnTypes []datatable.DataType, data [][]any) *mockDataSource {
-	return &mockDataSource{
-		columnNames: columnNames,
-		columnTypes: columnTypes,
-		data:        data,
-	}
-}
-
-func (m *mockDataSource) RowCount() int {
-	return len(m.data)
-}
-
-func (m *mockDataSource) ColumnCount() int {
-	return len(m.columnNames)
-}
-
-func (m *mockDataSource) ColumnName(col int) (string, error) {
-	if col < 0 || col >= len(m.columnNames) {
-		return "", datatable.ErrInvalidColumn
+	columns := make([]datatable.ColumnSchema, len(columnNames))
+	for i, name := range columnNames {
+		columns[i] = datatable.ColumnSchema{Name: name, Type: columnTypes[i]}
 	}
-	return m.columnNames[col], nil
-}
-
-func (m *mockDataSource) ColumnType(col int) (datatable.DataType, error) {
-	if col < 0 || col >= len(m.columnTypes) {
-		return datatable.TypeString, datatable.ErrInvalidColumn
-	}
-	return m.columnTypes[col], nil
-}
-
-func (m *mockDataSource) Cell(row, col int) (datatable.Value, error) {
-	if row < 0 || row >= len(m.data) {
-		return datatable.Value{}, datatable.ErrInvalidRow
-	}
-	if col < 0 || col >= len(m.columnNames) {
-		return datatable.Value{}, datatable.ErrInvalidColumn
-	}
-
-	value := m.data[row][col]
-	if value == nil {
-		return datatable.NewNullValue(m.columnTypes[col]), nil
-	}
-
-	return datatable.NewValue(value, m.columnTypes[col]), nil
-}
-
-func (m *mockDataSource) Row(row int) ([]datatable.Value, error) {
-	if row < 0 || row >= len(m.data) {
-		return nil, datatable.ErrInvalidRow
-	}
-
-	values := make([]datatable.Value, len(m.columnNames))
-	for col := range m.columnNames {
-		val, err := m.Cell(row, col)
-		if err != nil {
-			return nil, err
-		}
-		values[col] = val
-	}
-
-	return values, nil
-}
-
-func (m *mockDataSource) Metadata() datatable.Metadata {
-	return datatable.Metadata{}
+	return datatabletest.NewMockSource(columns, data)
 }
 
 // Tests
@@ -218,6 +163,286 @@ func TestLazyEvaluation(t *testing.T) {
 	}
 }
 
+func TestComputedColumn_ModByZeroIsNull(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x", "y"},
+		[]datatable.DataType{datatable.TypeInt, datatable.TypeInt},
+		[][]any{
+			{int64(7), int64(3)},
+			{int64(7), int64(0)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	modExpr, _ := NewExpression("mod(x, y)", []string{"x", "y"}, arrow.PrimitiveTypes.Float64)
+	if err := ds.AddComputedColumn("rem", modExpr, datatable.TypeFloat); err != nil {
+		t.Fatalf("AddComputedColumn(rem) error = %v", err)
+	}
+	divExpr, _ := NewExpression("idiv(x, y)", []string{"x", "y"}, arrow.PrimitiveTypes.Int64)
+	if err := ds.AddComputedColumn("quot", divExpr, datatable.TypeInt); err != nil {
+		t.Fatalf("AddComputedColumn(quot) error = %v", err)
+	}
+
+	rem, _ := ds.Cell(0, 2)
+	quot, _ := ds.Cell(0, 3)
+	if rem.Raw != 1.0 || quot.Raw != int64(2) {
+		t.Errorf("mod/idiv(7, 3) = %v, %v, want 1, 2", rem.Raw, quot.Raw)
+	}
+
+	for col := 2; col <= 3; col++ {
+		val, err := ds.Cell(1, col)
+		if err != nil {
+			t.Fatalf("Cell(1, %d) error = %v", col, err)
+		}
+		if !val.IsNull {
+			t.Errorf("Cell(1, %d) = %v, want null for division by zero", col, val.Raw)
+		}
+	}
+}
+
+func TestComputedColumn_Dates(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"text", "y", "m", "d"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt, datatable.TypeInt, datatable.TypeInt},
+		[][]any{
+			{"2024-01-15", int64(2024), int64(1), int64(15)},
+			{"not a date", int64(2023), int64(2), int64(29)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	parsed, err := NewExpression(`parse_date(text, "2006-01-02")`, []string{"text"}, arrow.FixedWidthTypes.Date64)
+	if err != nil {
+		t.Fatalf("NewExpression(parse_date) error = %v", err)
+	}
+	if err := ds.AddComputedColumn("parsed", parsed, datatable.TypeDate); err != nil {
+		t.Fatalf("AddComputedColumn(parsed) error = %v", err)
+	}
+	built, err := NewExpression("date(y, m, d)", []string{"y", "m", "d"}, arrow.FixedWidthTypes.Timestamp_us)
+	if err != nil {
+		t.Fatalf("NewExpression(date) error = %v", err)
+	}
+	if err := ds.AddComputedColumn("built", built, datatable.TypeTimestamp); err != nil {
+		t.Fatalf("AddComputedColumn(built) error = %v", err)
+	}
+
+	want := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		col      int
+		wantType datatable.DataType
+	}{
+		{4, datatable.TypeDate},
+		{5, datatable.TypeTimestamp},
+	}
+
+	for _, tt := range tests {
+		colType, _ := ds.ColumnType(tt.col)
+		if colType != tt.wantType {
+			t.Errorf("ColumnType(%d) = %v, want %v", tt.col, colType, tt.wantType)
+		}
+
+		val, err := ds.Cell(0, tt.col)
+		if err != nil {
+			t.Fatalf("Cell(0, %d) error = %v", tt.col, err)
+		}
+		if val.Type != tt.wantType {
+			t.Errorf("Cell(0, %d).Type = %v, want %v", tt.col, val.Type, tt.wantType)
+		}
+		got, ok := val.Raw.(time.Time)
+		if !ok || !got.Equal(want) {
+			t.Errorf("Cell(0, %d).Raw = %v, want %v", tt.col, val.Raw, want)
+		}
+
+		// Unparseable text and nonexistent dates are null
+		val, _ = ds.Cell(1, tt.col)
+		if !val.IsNull {
+			t.Errorf("Cell(1, %d) = %v, want null", tt.col, val.Raw)
+		}
+	}
+
+	val, _ := ds.Cell(0, 4)
+	if val.Formatted != "2024-01-15" {
+		t.Errorf("Cell(0, 4).Formatted = %q, want %q", val.Formatted, "2024-01-15")
+	}
+}
+
+func TestComputedColumn_ElapsedTime(t *testing.T) {
+	fixedNow := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	now = func() time.Time { return fixedNow }
+	t.Cleanup(func() { now = time.Now })
+
+	source := newMockDataSource(
+		[]string{"joined", "born"},
+		[]datatable.DataType{datatable.TypeDate, datatable.TypeDate},
+		[][]any{
+			{time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), time.Date(1990, 6, 15, 0, 0, 0, 0, time.UTC)},
+			{time.Date(2023, 6, 15, 0, 0, 0, 0, time.UTC), time.Date(1990, 6, 16, 0, 0, 0, 0, time.UTC)},
+			{nil, nil},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	days, err := NewExpression("days_since(joined)", []string{"joined"}, arrow.PrimitiveTypes.Int64)
+	if err != nil {
+		t.Fatalf("NewExpression(days_since) error = %v", err)
+	}
+	if err := ds.AddComputedColumn("days", days, datatable.TypeInt); err != nil {
+		t.Fatalf("AddComputedColumn(days) error = %v", err)
+	}
+	age, err := NewExpression("age_years(born)", []string{"born"}, arrow.PrimitiveTypes.Int64)
+	if err != nil {
+		t.Fatalf("NewExpression(age_years) error = %v", err)
+	}
+	if err := ds.AddComputedColumn("age", age, datatable.TypeInt); err != nil {
+		t.Fatalf("AddComputedColumn(age) error = %v", err)
+	}
+
+	tests := []struct {
+		row, col int
+		want     int64
+	}{
+		{0, 2, 5},   // June 10 to June 15
+		{1, 2, 366}, // A year spanning February 29
+		{0, 3, 34},  // Birthday today
+		{1, 3, 33},  // Birthday tomorrow, not yet reached
+	}
+	for _, tt := range tests {
+		val, err := ds.Cell(tt.row, tt.col)
+		if err != nil {
+			t.Fatalf("Cell(%d, %d) error = %v", tt.row, tt.col, err)
+		}
+		if val.Raw != tt.want {
+			t.Errorf("Cell(%d, %d) = %v, want %d", tt.row, tt.col, val.Raw, tt.want)
+		}
+	}
+
+	// Null dates give null results
+	for col := 2; col <= 3; col++ {
+		if val, _ := ds.Cell(2, col); !val.IsNull {
+			t.Errorf("Cell(2, %d) = %v, want null", col, val.Raw)
+		}
+	}
+}
+
+func TestElapsedTimeEdgeCases(t *testing.T) {
+	t.Cleanup(func() { now = time.Now })
+	born := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		today time.Time
+		want  int64
+	}{
+		{time.Date(2023, 2, 28, 23, 0, 0, 0, time.UTC), 22},
+		{time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC), 23},
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), 24},
+	}
+	for _, tt := range tests {
+		now = func() time.Time { return tt.today }
+		if got := ageYears(born); got != tt.want {
+			t.Errorf("ageYears on %s = %v, want %d", tt.today.Format(time.DateOnly), got, tt.want)
+		}
+	}
+
+	// Days count calendar dates in the value's zone
+	stockholm, err := time.LoadLocation("Europe/Stockholm")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	now = func() time.Time { return time.Date(2024, 6, 14, 23, 30, 0, 0, time.UTC) } // June 15 in Stockholm
+	if got := daysSince(time.Date(2024, 6, 14, 0, 0, 0, 0, stockholm)); got != int64(1) {
+		t.Errorf("daysSince across midnight in Stockholm = %v, want 1", got)
+	}
+	if got := daysSince("2024-06-14"); got != nil {
+		t.Errorf("daysSince(string) = %v, want nil", got)
+	}
+}
+
+func TestComputedColumn_Format(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"name", "age"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt},
+		[][]any{
+			{"Alice", int64(30)},
+			{"Bob", int64(25)},
+			{nil, int64(41)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	expr, err := NewExpression(`format("%s: %d", name, age)`, []string{"name", "age"}, arrow.BinaryTypes.String)
+	if err != nil {
+		t.Fatalf("NewExpression() error = %v", err)
+	}
+	if err := ds.AddComputedColumn("label", expr, datatable.TypeString); err != nil {
+		t.Fatalf("AddComputedColumn() error = %v", err)
+	}
+
+	want := []string{"Alice: 30", "Bob: 25", ": 41"}
+	for row, w := range want {
+		val, err := ds.Cell(row, 2)
+		if err != nil {
+			t.Fatalf("Cell(%d, 2) error = %v", row, err)
+		}
+		if val.Formatted != w {
+			t.Errorf("Cell(%d, 2) = %q, want %q", row, val.Formatted, w)
+		}
+	}
+}
+
+func TestComputedColumn_SplitIndex(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"email"},
+		[]datatable.DataType{datatable.TypeString},
+		[][]any{
+			{"user@example.com"},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+	}{
+		{"user", `splitIndex(email, "@", 0)`, "user"},
+		{"domain", `splitIndex(email, "@", 1)`, "example.com"},
+		{"tld", `splitIndex(email, ".", 1)`, "com"},
+		{"past end", `splitIndex(email, "@", 2)`, ""},
+		{"negative", `splitIndex(email, "@", -1)`, ""},
+		{"missing separator", `splitIndex(email, "#", 0)`, "user@example.com"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := NewExpression(tt.expression, []string{"email"}, arrow.BinaryTypes.String)
+			if err != nil {
+				t.Fatalf("NewExpression() error = %v", err)
+			}
+			if err := ds.AddComputedColumn(tt.name, expr, datatable.TypeString); err != nil {
+				t.Fatalf("AddComputedColumn() error = %v", err)
+			}
+
+			val, err := ds.Cell(0, i+1)
+			if err != nil {
+				t.Fatalf("Cell() error = %v", err)
+			}
+			if val.IsNull || val.Type != datatable.TypeString || val.Formatted != tt.want {
+				t.Errorf("Cell() = %+v, want string %q", val, tt.want)
+			}
+		})
+	}
+}
+
 func TestExplicitMaterialization(t *testing.T) {
 	source := newMockDataSource(
 		[]string{"value"},
@@ -349,6 +574,18 @@ func TestCircularDependency(t *testing.T) {
 	if err == nil {
 		t.Error("SetColumnExpression() should return error for circular dependency")
 	}
+
+	// The rejected expression is not kept
+	if got := ds.GetExpression("A"); got != exprA {
+		t.Errorf("GetExpression(A) = %v, want the original expression", got)
+	}
+	val, err := ds.Cell(0, 1)
+	if err != nil {
+		t.Fatalf("Cell(0, 1) error = %v", err)
+	}
+	if val.Raw != int64(2) {
+		t.Errorf("Cell(0, 1) = %v, want 2", val.Raw)
+	}
 }
 
 func TestGetDependencies(t *testing.T) {
@@ -423,6 +660,353 @@ func TestRemoveColumn(t *testing.T) {
 	}
 }
 
+func TestRemoveColumn_KeepsLaterMaterializedColumns(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(1)},
+			{int64(2)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	for _, c := range []struct{ name, expr string }{
+		{"A", "x + 10"},
+		{"B", "x + 20"},
+		{"C", "x + 30"},
+	} {
+		expr, _ := NewExpression(c.expr, []string{"x"}, arrow.PrimitiveTypes.Int64)
+		if err := ds.AddComputedColumn(c.name, expr, datatable.TypeInt); err != nil {
+			t.Fatalf("AddComputedColumn(%s) error = %v", c.name, err)
+		}
+	}
+
+	if err := ds.Materialize(""); err != nil {
+		t.Fatalf("Materialize() error = %v", err)
+	}
+
+	if err := ds.RemoveColumn("B"); err != nil {
+		t.Fatalf("RemoveColumn() error = %v", err)
+	}
+
+	// C moved from index 3 to 2 and must still read its own cached values
+	if name, _ := ds.ColumnName(2); name != "C" {
+		t.Fatalf("ColumnName(2) = %s, want C", name)
+	}
+	if !ds.IsMaterialized("C") {
+		t.Error("C should still be materialized after removing B")
+	}
+	for row, want := range []int64{31, 32} {
+		val, err := ds.Cell(row, 2)
+		if err != nil {
+			t.Fatalf("Cell(%d, 2) error = %v", row, err)
+		}
+		if val.Raw.(int64) != want {
+			t.Errorf("Cell(%d, 2) = %v, want %d", row, val.Raw, want)
+		}
+	}
+
+	arrays := ds.GetAllMaterializedArrays()
+	if len(arrays) != 2 {
+		t.Errorf("GetAllMaterializedArrays() has %d arrays, want 2 (A and C)", len(arrays))
+	}
+	if arr, ok := arrays["C"]; !ok || arr.ValueStr(0) != "31" {
+		t.Errorf("GetAllMaterializedArrays()[C] = %v, want array starting with 31", arr)
+	}
+}
+
+func TestColumnIdentity_AddRemoveAdd(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(1)},
+			{int64(2)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	add := func(name, exprStr string) {
+		t.Helper()
+		expr, err := NewExpression(exprStr, []string{"x"}, arrow.PrimitiveTypes.Int64)
+		if err != nil {
+			t.Fatalf("NewExpression(%s) error = %v", exprStr, err)
+		}
+		if err := ds.AddComputedColumn(name, expr, datatable.TypeInt); err != nil {
+			t.Fatalf("AddComputedColumn(%s) error = %v", name, err)
+		}
+	}
+
+	add("A", "x + 10")
+	add("B", "x + 20")
+	add("C", "x + 30")
+	add("D", "x + 40")
+	if err := ds.Materialize(""); err != nil {
+		t.Fatalf("Materialize() error = %v", err)
+	}
+
+	if err := ds.RemoveColumn("B"); err != nil {
+		t.Fatalf("RemoveColumn() error = %v", err)
+	}
+
+	// E takes the position D had before the removal; it must be computed
+	// fresh rather than reuse any cached array
+	add("E", "x + 50")
+	if ds.IsMaterialized("E") {
+		t.Error("E should not be materialized before first access")
+	}
+
+	want := map[string][]int64{
+		"x": {1, 2},
+		"A": {11, 12},
+		"C": {31, 32},
+		"D": {41, 42},
+		"E": {51, 52},
+	}
+	if ds.ColumnCount() != len(want) {
+		t.Fatalf("ColumnCount() = %d, want %d", ds.ColumnCount(), len(want))
+	}
+
+	for col := 0; col < ds.ColumnCount(); col++ {
+		name, _ := ds.ColumnName(col)
+		values, ok := want[name]
+		if !ok {
+			t.Fatalf("unexpected column %s at %d", name, col)
+		}
+		for row, wantVal := range values {
+			val, err := ds.Cell(row, col)
+			if err != nil {
+				t.Fatalf("Cell(%d, %d) error = %v", row, col, err)
+			}
+			if val.Raw.(int64) != wantVal {
+				t.Errorf("%s row %d = %v, want %d", name, row, val.Raw, wantVal)
+			}
+		}
+	}
+
+	arrays := ds.GetAllMaterializedArrays()
+	for _, name := range []string{"A", "C", "D", "E"} {
+		arr, ok := arrays[name]
+		if !ok {
+			t.Errorf("GetAllMaterializedArrays() missing %s", name)
+			continue
+		}
+		if got := arr.ValueStr(0); got != fmt.Sprint(want[name][0]) {
+			t.Errorf("materialized %s[0] = %s, want %d", name, got, want[name][0])
+		}
+	}
+}
+
+func TestSetColumnExpression_InvalidatesDependents(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{{int64(2)}},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	expr1, _ := NewExpression("x * 2", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	ds.AddComputedColumn("doubled", expr1, datatable.TypeInt)
+	expr2, _ := NewExpression("doubled + 1", []string{"doubled"}, arrow.PrimitiveTypes.Int64)
+	ds.AddComputedColumn("plusOne", expr2, datatable.TypeInt)
+
+	if val, _ := ds.Cell(0, 2); val.Raw.(int64) != 5 {
+		t.Fatalf("Cell(0, 2) = %v, want 5", val.Raw)
+	}
+
+	expr3, _ := NewExpression("x * 10", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	if err := ds.SetColumnExpression("doubled", expr3); err != nil {
+		t.Fatalf("SetColumnExpression() error = %v", err)
+	}
+
+	if ds.IsMaterialized("plusOne") {
+		t.Error("plusOne should be unmaterialized after its input changed")
+	}
+	if val, _ := ds.Cell(0, 2); val.Raw.(int64) != 21 {
+		t.Errorf("Cell(0, 2) = %v, want 21", val.Raw)
+	}
+}
+
+// countingAllocator counts allocations made through it.
+type countingAllocator struct {
+	memory.Allocator
+	allocations atomic.Int64
+}
+
+func (a *countingAllocator) Allocate(size int) []byte {
+	a.allocations.Add(1)
+	return a.Allocator.Allocate(size)
+}
+
+func newConcurrencySource() *mockDataSource {
+	data := make([][]any, 100)
+	for i := range data {
+		data[i] = []any{int64(i)}
+	}
+	return newMockDataSource([]string{"x"}, []datatable.DataType{datatable.TypeInt}, data)
+}
+
+// TestConcurrentCell_MaterializesOnce hits an unmaterialized computed column
+// from many goroutines at once. Run with -race to check the locking.
+func TestConcurrentCell_MaterializesOnce(t *testing.T) {
+	newDS := func() (*ExpressionDataSource, *countingAllocator) {
+		ds := NewExpressionDataSource(newConcurrencySource())
+		alloc := &countingAllocator{Allocator: memory.NewGoAllocator()}
+		ds.allocator = alloc
+		expr, _ := NewExpression("x * 2", []string{"x"}, arrow.PrimitiveTypes.Int64)
+		if err := ds.AddComputedColumn("doubled", expr, datatable.TypeInt); err != nil {
+			t.Fatalf("AddComputedColumn() error = %v", err)
+		}
+		return ds, alloc
+	}
+
+	// Allocations for a single materialization
+	single, singleAlloc := newDS()
+	defer single.Release()
+	if _, err := single.Cell(0, 1); err != nil {
+		t.Fatalf("Cell() error = %v", err)
+	}
+	want := singleAlloc.allocations.Load()
+
+	ds, alloc := newDS()
+	defer ds.Release()
+
+	const goroutines = 32
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	errs := make(chan error, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			<-start
+			for row := 0; row < ds.RowCount(); row++ {
+				r := (row + g) % ds.RowCount()
+				val, err := ds.Cell(r, 1)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if val.Raw.(int64) != int64(r*2) {
+					errs <- fmt.Errorf("Cell(%d, 1) = %v, want %d", r, val.Raw, r*2)
+					return
+				}
+			}
+		}(g)
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	if got := alloc.allocations.Load(); got != want {
+		t.Errorf("concurrent access made %d allocations, want %d (column materialized more than once)", got, want)
+	}
+}
+
+// TestConcurrentCell_WithUnmaterialize reads a computed column while another
+// goroutine keeps dropping its cache. Run with -race to check the locking.
+func TestConcurrentCell_WithUnmaterialize(t *testing.T) {
+	ds := NewExpressionDataSource(newConcurrencySource())
+	defer ds.Release()
+
+	expr, _ := NewExpression("x + 1", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	ds.AddComputedColumn("next", expr, datatable.TypeInt)
+
+	done := make(chan struct{})
+	unmaterialized := make(chan struct{})
+	go func() {
+		defer close(unmaterialized)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				ds.Unmaterialize("next")
+			}
+		}
+	}()
+
+	var readers sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for row := 0; row < ds.RowCount(); row++ {
+				val, err := ds.Cell(row, 1)
+				if err != nil {
+					t.Errorf("Cell(%d, 1) error = %v", row, err)
+					return
+				}
+				if val.Raw.(int64) != int64(row+1) {
+					t.Errorf("Cell(%d, 1) = %v, want %d", row, val.Raw, row+1)
+					return
+				}
+			}
+		}()
+	}
+
+	readers.Wait()
+	close(done)
+	<-unmaterialized
+}
+
+// releaseRecorder counts Release calls on a wrapped mock source.
+type releaseRecorder struct {
+	*mockDataSource
+	released int
+}
+
+func (r *releaseRecorder) Release() {
+	r.released++
+}
+
+func TestRelease_PropagatesToSource(t *testing.T) {
+	rec := &releaseRecorder{mockDataSource: newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{{int64(1)}},
+	)}
+
+	// Expression -> Concat -> Expression -> recorder
+	inner := NewExpressionDataSource(rec)
+	concat, err := datatable.ConcatSources(inner)
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+	outer := NewExpressionDataSource(concat)
+
+	expr, _ := NewExpression("x * 2", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	outer.AddComputedColumn("doubled", expr, datatable.TypeInt)
+	if err := outer.Materialize(""); err != nil {
+		t.Fatalf("Materialize() error = %v", err)
+	}
+
+	outer.Release()
+	if rec.released != 1 {
+		t.Errorf("Release reached the innermost source %d times, want 1", rec.released)
+	}
+	if outer.IsMaterialized("doubled") {
+		t.Error("doubled should not be materialized after Release")
+	}
+
+	// Releasing again does not release the source twice
+	outer.Release()
+	if rec.released != 1 {
+		t.Errorf("Release reached the innermost source %d times after second Release, want 1", rec.released)
+	}
+}
+
 func TestMaterializeAll(t *testing.T) {
 	source := newMockDataSource(
 		[]string{"x"},
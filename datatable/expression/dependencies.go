@@ -36,8 +36,8 @@ func NewDependencyGraph(columns []ColumnDefinition) (*DependencyGraph, error) {
 
 	// Build dependency map
 	for _, col := range columns {
-		if col.Expression != nil {
-			graph.dependencies[col.Name] = col.Expression.InputColumns()
+		if col.IsComputed() {
+			graph.dependencies[col.Name] = col.InputColumns()
 		} else {
 			// Non-computed columns have no dependencies
 			graph.dependencies[col.Name] = []string{}
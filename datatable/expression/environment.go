@@ -17,13 +17,17 @@ package expression
 import (
 	"fmt"
 	"math"
+	"math/bits"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
 	"github.com/apache/arrow-go/v18/arrow/memory"
 	"github.com/magpierre/fyne-datatable/compute"
 	"github.com/magpierre/fyne-datatable/compute/functions"
+	"golang.org/x/text/language"
 )
 
 // buildSafeEnvironment creates an environment with registered functions.
@@ -50,6 +54,7 @@ func buildSafeEnvironment() map[string]any {
 	env["min"] = math.Min
 	env["pow"] = math.Pow
 	env["sqrt"] = math.Sqrt
+	env["hypot"] = math.Hypot // sqrt(x*x + y*y) without overflow or underflow
 	env["exp"] = math.Exp
 	env["log"] = math.Log
 	env["log10"] = math.Log10
@@ -60,6 +65,14 @@ func buildSafeEnvironment() map[string]any {
 	env["acos"] = math.Acos
 	env["atan"] = math.Atan
 
+	// Floored division; division by zero yields null
+	env["mod"] = floorMod
+	env["idiv"] = floorDiv
+
+	// Combinatorics over non-negative integers
+	env["factorial"] = factorial
+	env["comb"] = comb
+
 	// String functions (from Go's strings package)
 	env["upper"] = strings.ToUpper
 	env["lower"] = strings.ToLower
@@ -80,6 +93,11 @@ func buildSafeEnvironment() map[string]any {
 		return len(s)
 	}
 
+	// String length in Unicode characters; len counts bytes
+	env["charLen"] = func(s string) int {
+		return utf8.RuneCountInString(s)
+	}
+
 	// Substring function
 	env["substr"] = func(s string, start, length int) string {
 		if start < 0 || start >= len(s) {
@@ -92,6 +110,26 @@ func buildSafeEnvironment() map[string]any {
 		return s[start:end]
 	}
 
+	// Date construction and parsing; invalid input yields null
+	env["parse_date"] = parseDate
+	env["date"] = makeDate
+
+	// Elapsed time up to now; null input yields null
+	env["days_since"] = daysSince
+	env["age_years"] = ageYears
+
+	// Field i (0-based) of s split on sep, or "" when out of range
+	env["splitIndex"] = func(s, sep string, i int) string {
+		fields := strings.Split(s, sep)
+		if i < 0 || i >= len(fields) {
+			return ""
+		}
+		return fields[i]
+	}
+
+	// String formatting (a safe subset of fmt.Sprintf)
+	env["format"] = formatString
+
 	// Type conversions
 	env["int"] = exprToInt64
 	env["float"] = exprToFloat64
@@ -136,6 +174,315 @@ func buildTypeEnvironment(inputColumns []string) map[string]any {
 	return env
 }
 
+// floorMod returns the remainder of a / b with the sign of b, so that
+// a == idiv(a, b)*b + mod(a, b). Returns nil (a null cell) when b is zero or
+// either argument is not numeric.
+func floorMod(a, b any) any {
+	x, y, ok := divisionOperands(a, b)
+	if !ok {
+		return nil
+	}
+	r := math.Mod(x, y)
+	if r != 0 && (r < 0) != (y < 0) {
+		r += y
+	}
+	return r
+}
+
+// floorDiv returns a / b rounded down to an integer. Returns nil (a null
+// cell) when b is zero, either argument is not numeric, or the quotient does
+// not fit in int64.
+func floorDiv(a, b any) any {
+	x, y, ok := divisionOperands(a, b)
+	if !ok {
+		return nil
+	}
+	q := math.Floor(x / y)
+	if math.IsNaN(q) || q >= math.MaxInt64 || q < math.MinInt64 {
+		return nil
+	}
+	return int64(q)
+}
+
+// divisionOperands converts the arguments of mod and idiv to float64,
+// reporting false for non-numeric arguments and a zero divisor.
+func divisionOperands(a, b any) (float64, float64, bool) {
+	if !isNumeric(a) || !isNumeric(b) {
+		return 0, 0, false
+	}
+	x, y := exprToFloat64(a), exprToFloat64(b)
+	if y == 0 {
+		return 0, 0, false
+	}
+	return x, y, true
+}
+
+// isNumeric reports whether v is a Go integer or float.
+func isNumeric(v any) bool {
+	switch v.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	}
+	return false
+}
+
+// formatVerbs are the fmt verbs accepted by format.
+const formatVerbs = "sdfgevqtxX"
+
+// formatString formats args like fmt.Sprintf, restricted to the verbs in
+// formatVerbs with optional flags, width and precision. Explicit argument
+// indexes and '*' widths are rejected, and the number of verbs must match
+// the number of args. Nil args format as empty strings whatever their verb,
+// and whole-number floats are accepted for %d since numeric columns often
+// arrive as float64.
+func formatString(format string, args ...any) (string, error) {
+	verbs, err := parseFormatVerbs(format)
+	if err != nil {
+		return "", err
+	}
+	if len(verbs) != len(args) {
+		return "", fmt.Errorf("format: %d verbs but %d arguments", len(verbs), len(args))
+	}
+
+	layout := []byte(format)
+	converted := make([]any, len(args))
+	for i, arg := range args {
+		verb := layout[verbs[i]]
+		switch {
+		case isNullArg(arg):
+			layout[verbs[i]] = 's'
+			converted[i] = ""
+		case verb == 'd' && isNumeric(arg):
+			f := exprToFloat64(arg)
+			if f != math.Trunc(f) {
+				return "", fmt.Errorf("format: argument %d is not an integer for %%d: %v", i+1, arg)
+			}
+			converted[i] = exprToInt64(arg)
+		default:
+			converted[i] = arg
+		}
+	}
+
+	return fmt.Sprintf(string(layout), converted...), nil
+}
+
+// isNullArg reports whether a variadic argument is null. expr-lang passes a
+// nil argument to a variadic function as a nil []any.
+func isNullArg(arg any) bool {
+	if arg == nil {
+		return true
+	}
+	s, ok := arg.([]any)
+	return ok && s == nil
+}
+
+// parseFormatVerbs returns the byte offsets of the verbs in format, in
+// order, excluding "%%".
+func parseFormatVerbs(format string) ([]int, error) {
+	var verbs []int
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		// Flags, width and precision
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) >= 0 {
+			i++
+		}
+		if i >= len(format) {
+			return nil, fmt.Errorf("format: incomplete verb at end of %q", format)
+		}
+		if format[i] == '%' {
+			continue
+		}
+		if strings.IndexByte(formatVerbs, format[i]) < 0 {
+			return nil, fmt.Errorf("format: unsupported verb %%%c", format[i])
+		}
+		verbs = append(verbs, i)
+	}
+	return verbs, nil
+}
+
+// parseDate parses s with a Go time layout such as "2006-01-02" or
+// "02/01/2006". Returns nil (a null cell) when s or layout is not a string or
+// s does not match the layout.
+func parseDate(s, layout any) any {
+	str, ok := s.(string)
+	if !ok {
+		return nil
+	}
+	lay, ok := layout.(string)
+	if !ok {
+		return nil
+	}
+	t, err := time.Parse(lay, strings.TrimSpace(str))
+	if err != nil {
+		return nil
+	}
+	return t
+}
+
+// makeDate returns midnight UTC on the given day. Returns nil (a null cell)
+// for non-integer arguments and for dates that do not exist, such as
+// date(2023, 2, 29).
+func makeDate(year, month, day any) any {
+	y, okY := exprToWhole(year)
+	m, okM := exprToWhole(month)
+	d, okD := exprToWhole(day)
+	if !okY || !okM || !okD || m < 1 || m > 12 {
+		return nil
+	}
+
+	t := time.Date(int(y), time.Month(m), int(d), 0, 0, 0, 0, time.UTC)
+	if t.Year() != int(y) || t.Month() != time.Month(m) || t.Day() != int(d) {
+		return nil // Out-of-range day normalized into another month
+	}
+	return t
+}
+
+// now returns the current time for days_since and age_years. Tests replace
+// it with a fixed clock.
+var now = time.Now
+
+// daysSince returns the number of calendar days from the date of t to
+// today, in t's time zone. Dates in the future give negative counts.
+// Returns nil (a null cell) unless t is a time.
+func daysSince(t any) any {
+	from, ok := t.(time.Time)
+	if !ok {
+		return nil
+	}
+	to := now().In(from.Location())
+
+	// Count whole days between the two calendar dates
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int64(toDay.Sub(fromDay).Hours() / 24)
+}
+
+// ageYears returns the number of whole years from birthdate to today, in
+// birthdate's time zone; the year only counts once the birthday is reached.
+// A February 29 birthday is reached on March 1 in other years.
+// Returns nil (a null cell) unless birthdate is a time.
+func ageYears(birthdate any) any {
+	born, ok := birthdate.(time.Time)
+	if !ok {
+		return nil
+	}
+	today := now().In(born.Location())
+
+	years := int64(today.Year() - born.Year())
+	if today.Month() < born.Month() || (today.Month() == born.Month() && today.Day() < born.Day()) {
+		years--
+	}
+	return years
+}
+
+// exprToWhole converts a numeric argument holding a whole number to int64.
+func exprToWhole(v any) (int64, bool) {
+	if !isNumeric(v) {
+		return 0, false
+	}
+	f := exprToFloat64(v)
+	if f != math.Trunc(f) || math.Abs(f) > math.MaxInt32 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+// Combinatorics helpers. They return an error, which expr-lang reports as
+// an evaluation error for the row, for negative or fractional arguments and
+// for results that do not fit in int64.
+
+// factorial returns n!. Results above 20! overflow int64 and are rejected.
+func factorial(n any) (int64, error) {
+	num, err := exprToCount("factorial", n)
+	if err != nil {
+		return 0, err
+	}
+	if num > 20 {
+		return 0, fmt.Errorf("factorial(%d) overflows int64", num)
+	}
+
+	result := int64(1)
+	for i := int64(2); i <= num; i++ {
+		result *= i
+	}
+	return result, nil
+}
+
+// comb returns the number of ways to choose k items from n (n choose k).
+// It is 0 when k > n.
+func comb(n, k any) (int64, error) {
+	num, err := exprToCount("comb", n)
+	if err != nil {
+		return 0, err
+	}
+	choose, err := exprToCount("comb", k)
+	if err != nil {
+		return 0, err
+	}
+	if choose > num {
+		return 0, nil
+	}
+	if choose > num-choose {
+		choose = num - choose
+	}
+
+	// result * (num-i) is always divisible by i+1, and each intermediate
+	// value is itself a binomial coefficient, so overflow is detected exactly
+	result := uint64(1)
+	for i := int64(0); i < choose; i++ {
+		hi, lo := bits.Mul64(result, uint64(num-i))
+		if hi >= uint64(i+1) {
+			return 0, fmt.Errorf("comb(%d, %d) overflows int64", num, choose)
+		}
+		result, _ = bits.Div64(hi, lo, uint64(i+1))
+		if result > math.MaxInt64 {
+			return 0, fmt.Errorf("comb(%d, %d) overflows int64", num, choose)
+		}
+	}
+	return int64(result), nil
+}
+
+// exprToCount converts a combinatorics argument to a non-negative integer.
+// Floats are accepted when they hold a whole number, since numeric columns
+// often arrive as float64.
+func exprToCount(fn string, v any) (int64, error) {
+	var n int64
+	switch val := v.(type) {
+	case int:
+		n = int64(val)
+	case int8:
+		n = int64(val)
+	case int16:
+		n = int64(val)
+	case int32:
+		n = int64(val)
+	case int64:
+		n = val
+	case uint8:
+		n = int64(val)
+	case uint16:
+		n = int64(val)
+	case uint32:
+		n = int64(val)
+	case float32, float64:
+		f := exprToFloat64(val)
+		if f != math.Trunc(f) || f > math.MaxInt64 || f < math.MinInt64 {
+			return 0, fmt.Errorf("%s: argument must be an integer, got %v", fn, val)
+		}
+		n = int64(f)
+	default:
+		return 0, fmt.Errorf("%s: argument must be an integer, got %T", fn, v)
+	}
+
+	if n < 0 {
+		return 0, fmt.Errorf("%s: argument must not be negative, got %d", fn, n)
+	}
+	return n, nil
+}
+
 // Helper functions for type conversions in expressions
 
 func exprToInt64(v any) int64 {
@@ -212,10 +559,16 @@ func exprToFloat64(v any) float64 {
 	return 0.0
 }
 
-func exprToString(v any) string {
+// exprToString converts v to a string. Passing true as the optional second
+// argument groups the digits of numbers with English thousands separators,
+// so string(1234567, true) is "1,234,567".
+func exprToString(v any, grouping ...bool) string {
 	if v == nil {
 		return ""
 	}
+	if len(grouping) > 0 && grouping[0] {
+		return functions.FormatGrouped(v, language.English)
+	}
 	return fmt.Sprintf("%v", v)
 }
 
@@ -293,6 +646,8 @@ func createScalarWrapper(fn compute.VectorFunction) any {
 		return createStringScalarWrapper(fn)
 	case compute.CategoryCast:
 		return createCastScalarWrapper(fn)
+	case compute.CategoryTemporal:
+		return createTemporalScalarWrapper(fn)
 	case compute.CategoryAggregate:
 		// Aggregate functions don't make sense as scalar wrappers
 		// They're designed to operate on entire arrays
@@ -322,6 +677,10 @@ func createMathScalarWrapper(fn compute.VectorFunction) any {
 		return func(x float64) any {
 			return executeScalarMath(fn, x)
 		}
+	case "negate", "reciprocal", "degrees", "radians", "trunc":
+		return func(x float64) any {
+			return executeScalarMath(fn, x)
+		}
 	default:
 		return nil
 	}
@@ -342,7 +701,7 @@ func createStringScalarWrapper(fn compute.VectorFunction) any {
 		return func(s string) any {
 			return executeScalarString(fn, s)
 		}
-	case "length":
+	case "length", "char_count":
 		return func(s string) any {
 			return executeScalarStringLength(fn, s)
 		}
@@ -411,6 +770,24 @@ func createCastScalarWrapper(fn compute.VectorFunction) any {
 	}
 }
 
+// createTemporalScalarWrapper creates a scalar wrapper for temporal functions.
+func createTemporalScalarWrapper(fn compute.VectorFunction) any {
+	switch fn.Name() {
+	case "to_epoch":
+		// The unit is optional and defaults to seconds: to_epoch(ts, "ms")
+		return func(ts time.Time, unit ...string) any {
+			return executeScalarToEpoch(ts, unit...)
+		}
+	case "from_epoch":
+		// The unit is optional and defaults to seconds: from_epoch(n, "ms")
+		return func(n any, unit ...string) any {
+			return executeScalarFromEpoch(n, unit...)
+		}
+	default:
+		return nil
+	}
+}
+
 // createGenericScalarWrapper creates a generic scalar wrapper for unknown function types.
 func createGenericScalarWrapper(fn compute.VectorFunction) any {
 	// For now, return nil - we can extend this later if needed
@@ -434,8 +811,11 @@ func executeScalarMath(fn compute.VectorFunction, x float64) any {
 	}
 	defer result.Release()
 
-	// Extract the result
-	if result.Len() > 0 && !result.IsNull(0) {
+	// Extract the result; null results (e.g. reciprocal(0)) stay null
+	if result.Len() > 0 && result.IsNull(0) {
+		return nil
+	}
+	if result.Len() > 0 {
 		if floatArr, ok := result.(*array.Float64); ok {
 			return floatArr.Value(0)
 		}
@@ -526,6 +906,95 @@ func executeScalarSubstring(fn compute.VectorFunction, s string, start, stop int
 	return "Error: failed to extract substring result"
 }
 
+// executeScalarToEpoch converts a single time to epoch values in unit
+// (seconds if omitted). Each call uses its own function, so the unit of the
+// registered to_epoch is never changed.
+func executeScalarToEpoch(ts time.Time, unit ...string) any {
+	fn := functions.NewToEpochFunction()
+	if len(unit) > 0 {
+		if err := fn.SetUnit(unit[0]); err != nil {
+			return fmt.Sprintf("Error: %s", err.Error())
+		}
+	}
+
+	// Create a single-element Arrow array
+	mem := memory.NewGoAllocator()
+	tsType := &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+	builder := array.NewTimestampBuilder(mem, tsType)
+	defer builder.Release()
+	value, err := arrow.TimestampFromTime(ts, arrow.Microsecond)
+	if err != nil {
+		return fmt.Sprintf("Error: %s", err.Error())
+	}
+	builder.Append(value)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	// Execute the function
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		return fmt.Sprintf("Error: %s", err.Error())
+	}
+	defer result.Release()
+
+	// Extract the result
+	if result.Len() > 0 && !result.IsNull(0) {
+		if intArr, ok := result.(*array.Int64); ok {
+			return intArr.Value(0)
+		}
+	}
+	return "Error: failed to extract epoch result"
+}
+
+// executeScalarFromEpoch converts a single epoch value in unit (seconds if
+// omitted) to a UTC time. Each call uses its own function, so the unit of
+// the registered from_epoch is never changed.
+func executeScalarFromEpoch(n any, unit ...string) any {
+	fn := functions.NewFromEpochFunction()
+	if len(unit) > 0 {
+		if err := fn.SetUnit(unit[0]); err != nil {
+			return fmt.Sprintf("Error: %s", err.Error())
+		}
+	}
+
+	var value int64
+	switch v := n.(type) {
+	case int:
+		value = int64(v)
+	case int32:
+		value = int64(v)
+	case int64:
+		value = v
+	case float64:
+		value = int64(v)
+	default:
+		return fmt.Sprintf("Error: cannot convert %T to epoch value", n)
+	}
+
+	// Create a single-element Arrow array
+	mem := memory.NewGoAllocator()
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.Append(value)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	// Execute the function
+	result, err := fn.Execute(arr, mem, false)
+	if err != nil {
+		return fmt.Sprintf("Error: %s", err.Error())
+	}
+	defer result.Release()
+
+	// Extract the result
+	if result.Len() > 0 && !result.IsNull(0) {
+		if tsArr, ok := result.(*array.Timestamp); ok {
+			return tsArr.Value(0).ToTime(tsArr.DataType().(*arrow.TimestampType).Unit)
+		}
+	}
+	return "Error: failed to extract timestamp result"
+}
+
 // executeScalarCast executes a cast function on a single scalar value.
 func executeScalarCast(fn compute.VectorFunction, x any, targetType arrow.DataType) any {
 	// Create a single-element Arrow array from the input
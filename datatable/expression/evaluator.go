@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
@@ -195,6 +196,34 @@ func appendToBuilder(builder array.Builder, value any, targetType arrow.DataType
 		}
 		b.Append(v)
 
+	case arrow.DATE32:
+		b := builder.(*array.Date32Builder)
+		v, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Date32FromTime(v))
+
+	case arrow.DATE64:
+		b := builder.(*array.Date64Builder)
+		v, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Date64FromTime(v))
+
+	case arrow.TIMESTAMP:
+		b := builder.(*array.TimestampBuilder)
+		v, err := toTime(value)
+		if err != nil {
+			return err
+		}
+		ts, err := arrow.TimestampFromTime(v, targetType.(*arrow.TimestampType).Unit)
+		if err != nil {
+			return err
+		}
+		b.Append(ts)
+
 	default:
 		return fmt.Errorf("unsupported output type: %v", targetType)
 	}
@@ -348,6 +377,13 @@ func toFloat64(value any) (float64, error) {
 	}
 }
 
+func toTime(value any) (time.Time, error) {
+	if v, ok := value.(time.Time); ok {
+		return v, nil
+	}
+	return time.Time{}, fmt.Errorf("cannot convert %T to time", value)
+}
+
 func toString(value any) string {
 	return fmt.Sprintf("%v", value)
 }
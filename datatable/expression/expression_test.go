@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"math"
 	"testing"
 
 	"github.com/apache/arrow-go/v18/arrow"
@@ -500,3 +501,225 @@ func TestExpression_Validate(t *testing.T) {
 		t.Error("Validate() on invalid expression should return error")
 	}
 }
+
+func TestCombinatoricsFunctions(t *testing.T) {
+	tests := []struct {
+		name    string
+		call    func() (int64, error)
+		want    int64
+		wantErr bool
+	}{
+		{"factorial(5)", func() (int64, error) { return factorial(5) }, 120, false},
+		{"factorial(0)", func() (int64, error) { return factorial(int64(0)) }, 1, false},
+		{"factorial(20)", func() (int64, error) { return factorial(20.0) }, 2432902008176640000, false},
+		{"factorial(21) overflows", func() (int64, error) { return factorial(21) }, 0, true},
+		{"factorial(-1)", func() (int64, error) { return factorial(-1) }, 0, true},
+		{"factorial(2.5)", func() (int64, error) { return factorial(2.5) }, 0, true},
+		{"factorial(string)", func() (int64, error) { return factorial("5") }, 0, true},
+		{"comb(5, 2)", func() (int64, error) { return comb(5, 2) }, 10, false},
+		{"comb(5.0, 5)", func() (int64, error) { return comb(5.0, int64(5)) }, 1, false},
+		{"comb(3, 4)", func() (int64, error) { return comb(3, 4) }, 0, false},
+		{"comb(62, 31)", func() (int64, error) { return comb(62, 31) }, 465428353255261088, false},
+		{"comb(68, 34) overflows", func() (int64, error) { return comb(68, 34) }, 0, true},
+		{"comb(1e9, 2)", func() (int64, error) { return comb(1000000000, 2) }, 499999999500000000, false},
+		{"comb(-5, 2)", func() (int64, error) { return comb(-5, 2) }, 0, true},
+		{"comb(5, -2)", func() (int64, error) { return comb(5, -2) }, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpression_Evaluate_Combinatorics(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	expr, err := NewExpression("string(factorial(n) + comb(n, 2))", []string{"n"}, arrow.BinaryTypes.String)
+	if err != nil {
+		t.Fatalf("NewExpression() error = %v", err)
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{5, -1}, nil)
+	input := builder.NewArray()
+	defer input.Release()
+
+	result, err := expr.Evaluate([]arrow.Array{input}, mem)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	defer result.Release()
+
+	out := result.(*array.String)
+	if got := out.Value(0); got != "130" {
+		t.Errorf("result[0] = %q, want %q", got, "130")
+	}
+	// Negative input becomes an error value for the row
+	if got := out.Value(1); len(got) < 7 || got[:7] != "Error: " {
+		t.Errorf("result[1] = %q, want an error value", got)
+	}
+}
+
+func TestExpression_Evaluate_Hypot(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	build := func(values []float64) arrow.Array {
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+		builder.AppendValues(values, nil)
+		return builder.NewArray()
+	}
+	x := build([]float64{3, 1e200, 1e-200})
+	defer x.Release()
+	y := build([]float64{4, 1e200, 1e-200})
+	defer y.Release()
+
+	evaluate := func(source string) *array.Float64 {
+		t.Helper()
+		expr, err := NewExpression(source, []string{"x", "y"}, arrow.PrimitiveTypes.Float64)
+		if err != nil {
+			t.Fatalf("NewExpression(%q) error = %v", source, err)
+		}
+		result, err := expr.Evaluate([]arrow.Array{x, y}, mem)
+		if err != nil {
+			t.Fatalf("Evaluate(%q) error = %v", source, err)
+		}
+		t.Cleanup(result.Release)
+		return result.(*array.Float64)
+	}
+
+	hypot := evaluate("hypot(x, y)")
+	naive := evaluate("sqrt(x * x + y * y)")
+
+	if got := hypot.Value(0); got != 5 {
+		t.Errorf("hypot(3, 4) = %v, want 5", got)
+	}
+
+	// x*x overflows to +Inf and underflows to 0; hypot stays exact
+	for i, want := range []float64{math.Sqrt2 * 1e200, math.Sqrt2 * 1e-200} {
+		if got := hypot.Value(i + 1); math.Abs(got-want)/want > 1e-12 {
+			t.Errorf("hypot row %d = %v, want %v", i+1, got, want)
+		}
+	}
+	if got := naive.Value(1); !math.IsInf(got, 1) {
+		t.Errorf("naive row 1 = %v, want +Inf from overflow", got)
+	}
+	if got := naive.Value(2); got != 0 {
+		t.Errorf("naive row 2 = %v, want 0 from underflow", got)
+	}
+}
+
+func TestFloorDivisionFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"mod(7, 3)", floorMod(7, 3), 1.0},
+		{"mod(-7, 3)", floorMod(-7, 3), 2.0},
+		{"mod(7, -3)", floorMod(int64(7), int64(-3)), -2.0},
+		{"mod(7.5, 2)", floorMod(7.5, 2), 1.5},
+		{"mod(7, 0)", floorMod(7, 0), nil},
+		{"mod(string)", floorMod("7", 3), nil},
+		{"idiv(7, 3)", floorDiv(7, 3), int64(2)},
+		{"idiv(-7, 3)", floorDiv(-7, 3), int64(-3)},
+		{"idiv(7.5, 2.5)", floorDiv(7.5, 2.5), int64(3)},
+		{"idiv(7, 0)", floorDiv(7, 0.0), nil},
+		{"idiv(nil, 3)", floorDiv(nil, 3), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v (%T), want %v (%T)", tt.got, tt.got, tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpression_Evaluate_GroupedString(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{1234567, 42}, nil)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	tests := []struct {
+		expression string
+		want       []string
+	}{
+		{"string(x)", []string{"1234567", "42"}},
+		{"string(x, false)", []string{"1234567", "42"}},
+		{"string(x, true)", []string{"1,234,567", "42"}},
+		{"string(x * 1.5, true)", []string{"1,851,850.5", "63"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expr, err := NewExpression(tt.expression, []string{"x"}, arrow.BinaryTypes.String)
+			if err != nil {
+				t.Fatalf("NewExpression() error = %v", err)
+			}
+			result, err := expr.Evaluate([]arrow.Array{arr}, mem)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			defer result.Release()
+
+			strArr := result.(*array.String)
+			for i, want := range tt.want {
+				if got := strArr.Value(i); got != want {
+					t.Errorf("row %d = %q, want %q", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFormatString(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		args    []any
+		want    string
+		wantErr bool
+	}{
+		{"string and int", "%s: %d", []any{"Alice", int64(30)}, "Alice: 30", false},
+		{"whole float as %d", "Order #%d", []any{123.0}, "Order #123", false},
+		{"width and precision", "[%5.1f|%-4s]", []any{3.14159, "ab"}, "[  3.1|ab  ]", false},
+		{"percent literal", "%d%%", []any{50}, "50%", false},
+		{"nil as empty", "%s-%d-%v", []any{nil, nil, nil}, "--", false},
+		{"no verbs", "plain", nil, "plain", false},
+		{"fraction as %d", "%d", []any{1.5}, "", true},
+		{"unsupported verb", "%p", []any{"x"}, "", true},
+		{"type verb", "%T", []any{"x"}, "", true},
+		{"argument index", "%[1]s", []any{"x"}, "", true},
+		{"star width", "%*d", []any{3, 4}, "", true},
+		{"too few args", "%s %s", []any{"x"}, "", true},
+		{"too many args", "%s", []any{"x", "y"}, "", true},
+		{"trailing percent", "100%", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatString(tt.format, tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("formatString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
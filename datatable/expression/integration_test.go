@@ -17,6 +17,7 @@ package expression
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow-go/v18/arrow"
 	"github.com/apache/arrow-go/v18/arrow/array"
@@ -57,6 +58,12 @@ func TestIntegration_MathFunctions(t *testing.T) {
 			input:      []float64{1.4, 2.5, 3.6},
 			expected:   []float64{1, 3, 4}, // Go's round uses "round half away from zero"
 		},
+		{
+			name:       "trunc",
+			expression: "trunc(x)",
+			input:      []float64{2.7, -2.7, -0.5},
+			expected:   []float64{2, -2, 0},
+		},
 		{
 			name:       "sqrt",
 			expression: "sqrt(x)",
@@ -69,6 +76,36 @@ func TestIntegration_MathFunctions(t *testing.T) {
 			input:      []float64{0, 1, 2, 3, 4},
 			expected:   []float64{0, 1, 4, 9, 16},
 		},
+		{
+			name:       "negate",
+			expression: "negate(x)",
+			input:      []float64{-1, 2, -3},
+			expected:   []float64{1, -2, 3},
+		},
+		{
+			name:       "reciprocal",
+			expression: "reciprocal(x)",
+			input:      []float64{2, 4, -0.5},
+			expected:   []float64{0.5, 0.25, -2},
+		},
+		{
+			name:       "radians",
+			expression: "radians(x)",
+			input:      []float64{180, 90, 0},
+			expected:   []float64{math.Pi, math.Pi / 2, 0},
+		},
+		{
+			name:       "degrees",
+			expression: "degrees(x)",
+			input:      []float64{math.Pi, math.Pi / 2},
+			expected:   []float64{180, 90},
+		},
+		{
+			name:       "trig with degrees",
+			expression: "sin(radians(x))",
+			input:      []float64{90, 30},
+			expected:   []float64{1, 0.5},
+		},
 	}
 
 	for _, tt := range tests {
@@ -141,6 +178,24 @@ func TestIntegration_StringFunctions(t *testing.T) {
 			input:      []string{"a", "ab", "abc"},
 			expected:   []string{"1", "2", "3"},
 		},
+		{
+			name:       "len counts bytes",
+			expression: "string(len(s))",
+			input:      []string{"café", "日本語"},
+			expected:   []string{"5", "9"},
+		},
+		{
+			name:       "charLen",
+			expression: "string(charLen(s))",
+			input:      []string{"abc", "café", "日本語", ""},
+			expected:   []string{"3", "4", "3", "0"},
+		},
+		{
+			name:       "char_count",
+			expression: "string(char_count(s))",
+			input:      []string{"café", "naïve"},
+			expected:   []string{"4", "5"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -179,6 +234,99 @@ func TestIntegration_StringFunctions(t *testing.T) {
 	}
 }
 
+// TestIntegration_TemporalFunctions tests temporal functions from the compute registry.
+func TestIntegration_TemporalFunctions(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	known := time.Date(2024, 1, 15, 10, 30, 0, 250_000_000, time.UTC)
+	builder := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"})
+	defer builder.Release()
+	builder.Append(arrow.Timestamp(known.UnixMicro()))
+	builder.Append(0)
+	inputArray := builder.NewArray()
+	defer inputArray.Release()
+
+	tests := []struct {
+		name       string
+		expression string
+		expected   []int64
+	}{
+		{"seconds by default", "to_epoch(ts)", []int64{1705314600, 0}},
+		{"seconds", `to_epoch(ts, "s")`, []int64{1705314600, 0}},
+		{"milliseconds", `to_epoch(ts, "ms")`, []int64{1705314600250, 0}},
+		{"microseconds", `to_epoch(ts, "us")`, []int64{1705314600250000, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := NewExpression(tt.expression, []string{"ts"}, arrow.PrimitiveTypes.Int64)
+			if err != nil {
+				t.Fatalf("NewExpression() error = %v", err)
+			}
+
+			result, err := expr.Evaluate([]arrow.Array{inputArray}, mem)
+			if err != nil {
+				t.Fatalf("Evaluate() error = %v", err)
+			}
+			defer result.Release()
+
+			resultArray := result.(*array.Int64)
+			for i, want := range tt.expected {
+				if got := resultArray.Value(i); got != want {
+					t.Errorf("result[%d] = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestIntegration_FromEpoch tests building timestamps from epoch values.
+func TestIntegration_FromEpoch(t *testing.T) {
+	mem := memory.NewGoAllocator()
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	builder.AppendValues([]int64{1705314600250, -500}, nil)
+	inputArray := builder.NewArray()
+	defer inputArray.Release()
+
+	tsType := &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "UTC"}
+	expr, err := NewExpression(`from_epoch(n, "ms")`, []string{"n"}, tsType)
+	if err != nil {
+		t.Fatalf("NewExpression() error = %v", err)
+	}
+	result, err := expr.Evaluate([]arrow.Array{inputArray}, mem)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	defer result.Release()
+
+	want := []time.Time{
+		time.Date(2024, 1, 15, 10, 30, 0, 250_000_000, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 500_000_000, time.UTC),
+	}
+	resultArray := result.(*array.Timestamp)
+	for i, w := range want {
+		if got := resultArray.Value(i).ToTime(arrow.Millisecond); !got.Equal(w) {
+			t.Errorf("result[%d] = %v, want %v", i, got, w)
+		}
+	}
+
+	// Epoch values round-trip through a timestamp
+	roundTrip, err := NewExpression(`to_epoch(from_epoch(n, "ms"), "ms")`, []string{"n"}, arrow.PrimitiveTypes.Int64)
+	if err != nil {
+		t.Fatalf("NewExpression() error = %v", err)
+	}
+	epochs, err := roundTrip.Evaluate([]arrow.Array{inputArray}, mem)
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	defer epochs.Release()
+	if !array.Equal(epochs, inputArray) {
+		t.Errorf("round trip = %v, want %v", epochs, inputArray)
+	}
+}
+
 // TestIntegration_NestedFunctions tests nested function calls.
 func TestIntegration_NestedFunctions(t *testing.T) {
 	mem := memory.NewGoAllocator()
@@ -391,6 +539,33 @@ func TestIntegration_NullHandling(t *testing.T) {
 			}
 		}
 	})
+
+	t.Run("reciprocal of zero", func(t *testing.T) {
+		expr, err := NewExpression("reciprocal(x)", []string{"x"}, arrow.PrimitiveTypes.Float64)
+		if err != nil {
+			t.Fatalf("NewExpression() error = %v", err)
+		}
+
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+		builder.AppendValues([]float64{2, 0}, nil)
+		inputArray := builder.NewArray()
+		defer inputArray.Release()
+
+		result, err := expr.Evaluate([]arrow.Array{inputArray}, mem)
+		if err != nil {
+			t.Fatalf("Evaluate() error = %v", err)
+		}
+		defer result.Release()
+
+		resultArray := result.(*array.Float64)
+		if resultArray.IsNull(0) || resultArray.Value(0) != 0.5 {
+			t.Errorf("result[0] = %v, want 0.5", resultArray.Value(0))
+		}
+		if !resultArray.IsNull(1) {
+			t.Errorf("result[1] = %v, want null", resultArray.Value(1))
+		}
+	})
 }
 
 // TestIntegration_Performance benchmarks expression evaluation performance.
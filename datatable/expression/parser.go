@@ -180,7 +180,7 @@ func inferOutputType(exprStr string) arrow.DataType {
 	}
 
 	// Check for math functions
-	if containsAny(exprStr, []string{"abs", "ceil", "floor", "round", "sqrt", "pow", "exp", "log"}) {
+	if containsAny(exprStr, []string{"abs", "ceil", "floor", "round", "sqrt", "hypot", "pow", "exp", "log"}) {
 		return arrow.PrimitiveTypes.Float64
 	}
 
@@ -224,14 +224,19 @@ func isFunctionName(token string) bool {
 	// List of known function names
 	functions := []string{
 		// Math
-		"abs", "ceil", "floor", "round", "max", "min", "pow", "sqrt",
+		"abs", "ceil", "floor", "round", "max", "min", "pow", "sqrt", "hypot",
 		"exp", "log", "log10", "sin", "cos", "tan", "asin", "acos", "atan",
+		"mod", "idiv", "factorial", "comb", "negate", "reciprocal",
+		"degrees", "radians", "trunc",
 		// String
-		"upper", "lower", "trim", "trimLeft", "trimRight", "len", "substr",
+		"upper", "lower", "trim", "trimLeft", "trimRight", "len", "charLen", "substr",
 		"contains", "hasPrefix", "hasSuffix", "replace", "replaceAll",
-		"split", "join", "repeat",
+		"split", "splitIndex", "join", "repeat", "format",
 		// Type conversion
 		"int", "float", "string", "bool",
+		// Dates
+		"parse_date", "date", "to_epoch", "from_epoch",
+		"days_since", "age_years",
 		// Null handling
 		"coalesce", "ifNull", "isNull", "if",
 	}
@@ -0,0 +1,523 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/magpierre/fyne-datatable/compute"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// Supported window operations.
+const (
+	// WindowCumSum is the running total of the input column.
+	WindowCumSum = "cumsum"
+	// WindowCumMax is the running maximum of the input column.
+	WindowCumMax = "cummax"
+	// WindowRank ranks rows by the input column; ties share a rank and
+	// consume the ranks after it (1, 1, 3).
+	WindowRank = "rank"
+	// WindowDenseRank ranks rows by the input column; ties share a rank
+	// without gaps (1, 1, 2).
+	WindowDenseRank = "dense_rank"
+	// WindowLag shifts the input column down by Offset rows (up when
+	// negative, i.e. lead), filling vacated positions with null.
+	WindowLag = "lag"
+	// WindowNormalize scales the input column to [0, 1] by its min and max.
+	WindowNormalize = "normalize"
+)
+
+// NullRank controls how rank functions treat null inputs.
+type NullRank int
+
+const (
+	// NullRankLast gives nulls the highest rank, after all values.
+	NullRankLast NullRank = iota
+	// NullRankNull leaves the rank of null inputs null.
+	NullRankNull
+)
+
+// RankOptions configures a rank column.
+type RankOptions struct {
+	// Dense selects dense_rank: ties do not consume ranks.
+	Dense bool
+	// Descending ranks the largest value first.
+	Descending bool
+	// Nulls controls the rank of null inputs.
+	Nulls NullRank
+}
+
+// WindowFunction computes a column from the whole of another column rather
+// than row by row, e.g. a running total or a rank. Values are computed in source row
+// order, independent of any sorting or filtering applied by a TableModel.
+type WindowFunction struct {
+	// Op is the window operation (WindowCumSum, WindowCumMax, WindowRank,
+	// WindowDenseRank, WindowLag, WindowNormalize).
+	Op string
+
+	// InputColumn is the name of the column the operation runs over.
+	InputColumn string
+
+	// Descending orders rank operations largest first.
+	Descending bool
+
+	// Nulls controls the rank of null inputs for rank operations.
+	Nulls NullRank
+
+	// Offset is the row shift for WindowLag; negative values lead.
+	Offset int
+}
+
+// InputColumns returns the columns this window function depends on.
+func (w *WindowFunction) InputColumns() []string {
+	return []string{w.InputColumn}
+}
+
+// Validate checks that the operation is supported and an input column is set.
+func (w *WindowFunction) Validate() error {
+	if w.InputColumn == "" {
+		return ErrInvalidColumn("window function requires an input column")
+	}
+	switch w.Op {
+	case WindowCumSum, WindowCumMax, WindowRank, WindowDenseRank, WindowLag, WindowNormalize:
+		return nil
+	default:
+		return ErrInvalidColumn(fmt.Sprintf("unsupported window operation %q", w.Op))
+	}
+}
+
+// Evaluate computes the window operation over input.
+// Null inputs produce null outputs and do not affect the running value.
+func (w *WindowFunction) Evaluate(input arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	switch w.Op {
+	case WindowRank, WindowDenseRank:
+		return w.rank(input, mem)
+	case WindowLag:
+		return w.lag(input, mem)
+	case WindowNormalize:
+		return w.normalize(input, mem)
+	}
+
+	switch arr := input.(type) {
+	case *array.Int64:
+		builder := array.NewInt64Builder(mem)
+		defer builder.Release()
+		cumulateInt64(w.Op, arr, builder)
+		return builder.NewArray(), nil
+
+	case *array.Float64:
+		builder := array.NewFloat64Builder(mem)
+		defer builder.Release()
+		cumulateFloat64(w.Op, arr, builder)
+		return builder.NewArray(), nil
+
+	default:
+		return nil, ErrEvaluationFailed(fmt.Sprintf("%s requires a numeric column, got %v", w.Op, input.DataType()))
+	}
+}
+
+// cumulateInt64 appends the running aggregate of arr to builder.
+func cumulateInt64(op string, arr *array.Int64, builder *array.Int64Builder) {
+	var running int64
+	hasValue := false
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		val := arr.Value(i)
+		switch op {
+		case WindowCumSum:
+			running += val
+		case WindowCumMax:
+			if !hasValue || val > running {
+				running = val
+			}
+		}
+		hasValue = true
+		builder.Append(running)
+	}
+}
+
+// cumulateFloat64 appends the running aggregate of arr to builder.
+func cumulateFloat64(op string, arr *array.Float64, builder *array.Float64Builder) {
+	var running float64
+	hasValue := false
+
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			builder.AppendNull()
+			continue
+		}
+
+		val := arr.Value(i)
+		switch op {
+		case WindowCumSum:
+			running += val
+		case WindowCumMax:
+			if !hasValue || val > running {
+				running = val
+			}
+		}
+		hasValue = true
+		builder.Append(running)
+	}
+}
+
+// rank computes the Int64 rank of every row of input.
+func (w *WindowFunction) rank(input arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	var less func(i, j int) bool
+	switch arr := input.(type) {
+	case *array.Int64:
+		less = func(i, j int) bool { return arr.Value(i) < arr.Value(j) }
+	case *array.Float64:
+		less = func(i, j int) bool { return arr.Value(i) < arr.Value(j) }
+	case *array.String:
+		less = func(i, j int) bool { return arr.Value(i) < arr.Value(j) }
+	default:
+		return nil, ErrEvaluationFailed(fmt.Sprintf("%s does not support %v columns", w.Op, input.DataType()))
+	}
+	if w.Descending {
+		ascending := less
+		less = func(i, j int) bool { return ascending(j, i) }
+	}
+
+	// Order the non-null rows; stable so ties keep source order
+	order := make([]int, 0, input.Len())
+	for i := 0; i < input.Len(); i++ {
+		if !input.IsNull(i) {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool { return less(order[a], order[b]) })
+
+	dense := w.Op == WindowDenseRank
+	ranks := make([]int64, input.Len())
+	var current int64
+	for pos, row := range order {
+		if pos == 0 || less(order[pos-1], row) {
+			if dense {
+				current++
+			} else {
+				current = int64(pos + 1)
+			}
+		}
+		ranks[row] = current
+	}
+
+	// Nulls rank after every value
+	nullRank := int64(len(order) + 1)
+	if dense {
+		nullRank = current + 1
+	}
+
+	builder := array.NewInt64Builder(mem)
+	defer builder.Release()
+	for i := 0; i < input.Len(); i++ {
+		switch {
+		case !input.IsNull(i):
+			builder.Append(ranks[i])
+		case w.Nulls == NullRankLast:
+			builder.Append(nullRank)
+		default:
+			builder.AppendNull()
+		}
+	}
+	return builder.NewArray(), nil
+}
+
+// lag returns input shifted by Offset rows, with null where the source row
+// falls outside the array.
+func (w *WindowFunction) lag(input arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	builder := array.NewBuilder(mem, input.DataType())
+	defer builder.Release()
+
+	for i := 0; i < input.Len(); i++ {
+		src := i - w.Offset
+		if src < 0 || src >= input.Len() || input.IsNull(src) {
+			builder.AppendNull()
+			continue
+		}
+
+		switch arr := input.(type) {
+		case *array.Int64:
+			builder.(*array.Int64Builder).Append(arr.Value(src))
+		case *array.Float64:
+			builder.(*array.Float64Builder).Append(arr.Value(src))
+		case *array.String:
+			builder.(*array.StringBuilder).Append(arr.Value(src))
+		case *array.Boolean:
+			builder.(*array.BooleanBuilder).Append(arr.Value(src))
+		case *array.Date64:
+			builder.(*array.Date64Builder).Append(arr.Value(src))
+		case *array.Timestamp:
+			builder.(*array.TimestampBuilder).Append(arr.Value(src))
+		default:
+			return nil, ErrEvaluationFailed(fmt.Sprintf("%s does not support %v columns", w.Op, input.DataType()))
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// normalize returns (x - min) / (max - min) for every value of input as
+// Float64. A column whose values are all equal maps to zeros.
+func (w *WindowFunction) normalize(input arrow.Array, mem memory.Allocator) (arrow.Array, error) {
+	var value func(i int) float64
+	switch arr := input.(type) {
+	case *array.Int64:
+		value = func(i int) float64 { return float64(arr.Value(i)) }
+	case *array.Float64:
+		value = func(i int) float64 { return arr.Value(i) }
+	default:
+		return nil, ErrEvaluationFailed(fmt.Sprintf("%s requires a numeric column, got %v", w.Op, input.DataType()))
+	}
+
+	minVal, err := aggregateFloat("min", input)
+	if err != nil {
+		return nil, err
+	}
+	maxVal, err := aggregateFloat("max", input)
+	if err != nil {
+		return nil, err
+	}
+	span := maxVal - minVal
+
+	builder := array.NewFloat64Builder(mem)
+	defer builder.Release()
+
+	for i := 0; i < input.Len(); i++ {
+		switch {
+		case input.IsNull(i):
+			builder.AppendNull()
+		case span == 0:
+			builder.Append(0)
+		default:
+			builder.Append((value(i) - minVal) / span)
+		}
+	}
+
+	return builder.NewArray(), nil
+}
+
+// aggregateFloat runs a registered compute aggregate over input and returns
+// the result as float64. An all-null input yields 0.
+func aggregateFloat(name string, input arrow.Array) (float64, error) {
+	fn, err := compute.Get(name)
+	if err != nil {
+		return 0, err
+	}
+	aggregate, ok := fn.(compute.AggregateFunction)
+	if !ok {
+		return 0, fmt.Errorf("function %s is not an aggregate", name)
+	}
+
+	result, err := aggregate.Aggregate(input)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := result.(type) {
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case nil:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("unexpected %s result type %T", name, result)
+	}
+}
+
+// AddCumulativeColumn adds a running-aggregate column over inputCol.
+//
+// Supported operations are "cumsum" (running total) and "cummax" (running
+// maximum). The input column must be numeric (TypeInt or TypeFloat); the
+// result has the same type. Values are computed in source order on first
+// access and cached like other computed columns.
+func (ds *ExpressionDataSource) AddCumulativeColumn(name, inputCol string, op string) error {
+	window := &WindowFunction{Op: op, InputColumn: inputCol}
+	if op != WindowCumSum && op != WindowCumMax {
+		return ErrInvalidColumn(fmt.Sprintf("unsupported cumulative operation %q", op))
+	}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	inputIdx := ds.findColumnIndexLocked(inputCol)
+	if inputIdx == -1 {
+		return ErrColumnNotFound(inputCol)
+	}
+
+	outputType := ds.columns[inputIdx].Type
+	if outputType != datatable.TypeInt && outputType != datatable.TypeFloat {
+		return ErrInvalidColumn(fmt.Sprintf("%s requires a numeric input column, %s is %s", op, inputCol, outputType))
+	}
+
+	return ds.addWindowColumnLocked(name, window, outputType)
+}
+
+// AddLagColumn adds a copy of inputCol shifted down by offset rows, so row i
+// holds the value of row i-offset. A negative offset leads (looks ahead).
+// Positions with no source row are null. The result has the input's type,
+// so an expression such as "value - prev_value" can compute deltas.
+func (ds *ExpressionDataSource) AddLagColumn(name, inputCol string, offset int) error {
+	window := &WindowFunction{Op: WindowLag, InputColumn: inputCol, Offset: offset}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	inputIdx := ds.findColumnIndexLocked(inputCol)
+	if inputIdx == -1 {
+		return ErrColumnNotFound(inputCol)
+	}
+
+	return ds.addWindowColumnLocked(name, window, ds.columns[inputIdx].Type)
+}
+
+// AddNormalizedColumn adds a Float64 column scaling inputCol to [0, 1] with
+// min-max normalization: (x - min) / (max - min). If every value is equal
+// the result is all zeros. Nulls stay null. The input must be numeric.
+func (ds *ExpressionDataSource) AddNormalizedColumn(name, inputCol string) error {
+	window := &WindowFunction{Op: WindowNormalize, InputColumn: inputCol}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	inputIdx := ds.findColumnIndexLocked(inputCol)
+	if inputIdx == -1 {
+		return ErrColumnNotFound(inputCol)
+	}
+
+	inputType := ds.columns[inputIdx].Type
+	if inputType != datatable.TypeInt && inputType != datatable.TypeFloat {
+		return ErrInvalidColumn(fmt.Sprintf("%s requires a numeric input column, %s is %s", WindowNormalize, inputCol, inputType))
+	}
+
+	return ds.addWindowColumnLocked(name, window, datatable.TypeFloat)
+}
+
+// AddRankColumn adds an Int64 column holding each row's rank by inputCol.
+//
+// With dense set, tied values do not consume ranks ({30,30,25} descending
+// ranks {1,1,2} rather than {1,1,3}). Null inputs rank after all values;
+// use AddRankColumnWithOptions to leave them null instead. Int, float and
+// string columns can be ranked.
+func (ds *ExpressionDataSource) AddRankColumn(name, inputCol string, dense bool, descending bool) error {
+	return ds.AddRankColumnWithOptions(name, inputCol, RankOptions{
+		Dense:      dense,
+		Descending: descending,
+		Nulls:      NullRankLast,
+	})
+}
+
+// AddRankColumnWithOptions adds a rank column configured by opts.
+func (ds *ExpressionDataSource) AddRankColumnWithOptions(name, inputCol string, opts RankOptions) error {
+	op := WindowRank
+	if opts.Dense {
+		op = WindowDenseRank
+	}
+	window := &WindowFunction{
+		Op:          op,
+		InputColumn: inputCol,
+		Descending:  opts.Descending,
+		Nulls:       opts.Nulls,
+	}
+
+	ds.mu.Lock()
+	defer ds.mu.Unlock()
+
+	inputIdx := ds.findColumnIndexLocked(inputCol)
+	if inputIdx == -1 {
+		return ErrColumnNotFound(inputCol)
+	}
+
+	switch ds.columns[inputIdx].Type {
+	case datatable.TypeInt, datatable.TypeFloat, datatable.TypeString:
+	default:
+		return ErrInvalidColumn(fmt.Sprintf("cannot rank %s column %s", ds.columns[inputIdx].Type, inputCol))
+	}
+
+	return ds.addWindowColumnLocked(name, window, datatable.TypeInt)
+}
+
+// addWindowColumnLocked appends a window column after validating its name
+// and dependencies.
+func (ds *ExpressionDataSource) addWindowColumnLocked(name string, window *WindowFunction, outputType datatable.DataType) error {
+	if err := window.Validate(); err != nil {
+		return err
+	}
+
+	// Check for duplicate name
+	if ds.hasColumnLocked(name) {
+		return fmt.Errorf("column %s already exists", name)
+	}
+
+	description := fmt.Sprintf("%s(%s)", window.Op, window.InputColumn)
+	if window.Op == WindowLag {
+		description = fmt.Sprintf("%s(%s, %d)", window.Op, window.InputColumn, window.Offset)
+	}
+	if window.Descending {
+		description += " desc"
+	}
+
+	ds.columns = append(ds.columns, ColumnDefinition{
+		Name:         name,
+		Type:         outputType,
+		SourceColumn: nil,
+		Window:       window,
+		Materialized: false,
+		Description:  description,
+		Metadata:     make(map[string]any),
+		id:           ds.newColumnIDLocked(),
+	})
+
+	// Rebuild dependency graph to check for cycles
+	if err := ds.rebuildDependencyGraph(); err != nil {
+		// Roll back the addition
+		ds.columns = ds.columns[:len(ds.columns)-1]
+		return fmt.Errorf("cannot add column: %w", err)
+	}
+
+	return nil
+}
+
+// materializeWindowColumnLocked computes and caches a window column's values.
+func (ds *ExpressionDataSource) materializeWindowColumnLocked(colIdx int) error {
+	colDef := ds.columns[colIdx]
+
+	input, err := ds.getColumnAsArrowLocked(colDef.Window.InputColumn)
+	if err != nil {
+		return fmt.Errorf("failed to get input column %s: %w", colDef.Window.InputColumn, err)
+	}
+
+	result, err := colDef.Window.Evaluate(input, ds.allocator)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate window function: %w", err)
+	}
+
+	// Cache result
+	ds.materializedColumns[colDef.id] = result
+	ds.columns[colIdx].Materialized = true
+
+	return nil
+}
@@ -0,0 +1,466 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestAddCumulativeColumn_CumSum(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(1)},
+			{int64(2)},
+			{int64(3)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddCumulativeColumn("running", "x", "cumsum"); err != nil {
+		t.Fatalf("AddCumulativeColumn() error = %v", err)
+	}
+
+	// Column should not be materialized yet
+	if ds.IsMaterialized("running") {
+		t.Error("Column should not be materialized before access")
+	}
+	if !ds.IsComputedColumn(1) {
+		t.Error("Cumulative column should report as computed")
+	}
+
+	expected := []int64{1, 3, 6}
+	for i, want := range expected {
+		val, err := ds.Cell(i, 1)
+		if err != nil {
+			t.Fatalf("Cell(%d, 1) error = %v", i, err)
+		}
+		if got := val.Raw.(int64); got != want {
+			t.Errorf("Cell(%d, 1) = %v, want %v", i, got, want)
+		}
+	}
+
+	// Column should now be materialized and cached
+	if !ds.IsMaterialized("running") {
+		t.Error("Column should be materialized after access")
+	}
+	arr, err := ds.GetMaterializedArrowArray("running")
+	if err != nil {
+		t.Fatalf("GetMaterializedArrowArray() error = %v", err)
+	}
+	if arr.Len() != 3 {
+		t.Errorf("Materialized array length = %d, want 3", arr.Len())
+	}
+}
+
+func TestAddCumulativeColumn_CumMax(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"value"},
+		[]datatable.DataType{datatable.TypeFloat},
+		[][]any{
+			{2.0},
+			{1.0},
+			{nil},
+			{5.0},
+			{3.0},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddCumulativeColumn("peak", "value", "cummax"); err != nil {
+		t.Fatalf("AddCumulativeColumn() error = %v", err)
+	}
+	if err := ds.Materialize("peak"); err != nil {
+		t.Fatalf("Materialize() error = %v", err)
+	}
+
+	expected := []any{2.0, 2.0, nil, 5.0, 5.0}
+	for i, want := range expected {
+		val, _ := ds.Cell(i, 1)
+		if want == nil {
+			if !val.IsNull {
+				t.Errorf("Cell(%d, 1) = %v, want null", i, val.Raw)
+			}
+			continue
+		}
+		if val.Raw.(float64) != want.(float64) {
+			t.Errorf("Cell(%d, 1) = %v, want %v", i, val.Raw, want)
+		}
+	}
+}
+
+func TestAddCumulativeColumn_OverComputedColumn(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(1)},
+			{int64(2)},
+			{int64(3)},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	expr, _ := NewExpression("x * 2", []string{"x"}, arrow.PrimitiveTypes.Int64)
+	ds.AddComputedColumn("doubled", expr, datatable.TypeInt)
+
+	if err := ds.AddCumulativeColumn("running", "doubled", "cumsum"); err != nil {
+		t.Fatalf("AddCumulativeColumn() error = %v", err)
+	}
+
+	deps := ds.GetDependencies("running")
+	if len(deps) != 1 || deps[0] != "doubled" {
+		t.Errorf("GetDependencies() = %v, want [doubled]", deps)
+	}
+
+	val, _ := ds.Cell(2, 2)
+	if val.Raw.(int64) != 12 {
+		t.Errorf("Cell(2, 2) = %v, want 12", val.Raw)
+	}
+
+	// The input column cannot be removed while the window column uses it
+	if err := ds.RemoveColumn("doubled"); err == nil {
+		t.Error("Expected error removing a column a window column depends on")
+	}
+}
+
+func TestAddCumulativeColumn_Errors(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"x", "name"},
+		[]datatable.DataType{datatable.TypeInt, datatable.TypeString},
+		[][]any{{int64(1), "a"}},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	tests := []struct {
+		name     string
+		colName  string
+		inputCol string
+		op       string
+	}{
+		{"unsupported op", "c", "x", "cumavg"},
+		{"unknown input", "c", "missing", "cumsum"},
+		{"non-numeric input", "c", "name", "cumsum"},
+		{"duplicate name", "x", "x", "cumsum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ds.AddCumulativeColumn(tt.colName, tt.inputCol, tt.op); err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+
+	if ds.ColumnCount() != 2 {
+		t.Errorf("ColumnCount() = %d, want 2", ds.ColumnCount())
+	}
+}
+
+func TestAddRankColumn(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"score"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(30)},
+			{int64(30)},
+			{int64(25)},
+		},
+	)
+
+	tests := []struct {
+		name       string
+		dense      bool
+		descending bool
+		expected   []int64
+	}{
+		{"rank descending", false, true, []int64{1, 1, 3}},
+		{"dense rank descending", true, true, []int64{1, 1, 2}},
+		{"rank ascending", false, false, []int64{2, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := NewExpressionDataSource(source)
+			defer ds.Release()
+
+			if err := ds.AddRankColumn("rank", "score", tt.dense, tt.descending); err != nil {
+				t.Fatalf("AddRankColumn() error = %v", err)
+			}
+
+			colType, _ := ds.ColumnType(1)
+			if colType != datatable.TypeInt {
+				t.Errorf("ColumnType(1) = %v, want Int", colType)
+			}
+
+			for i, want := range tt.expected {
+				val, err := ds.Cell(i, 1)
+				if err != nil {
+					t.Fatalf("Cell(%d, 1) error = %v", i, err)
+				}
+				if got := val.Raw.(int64); got != want {
+					t.Errorf("Cell(%d, 1) = %v, want %v", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAddRankColumn_Nulls(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"score"},
+		[]datatable.DataType{datatable.TypeFloat},
+		[][]any{
+			{nil},
+			{2.5},
+			{9.0},
+			{2.5},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddRankColumn("last", "score", false, true); err != nil {
+		t.Fatalf("AddRankColumn() error = %v", err)
+	}
+	if err := ds.AddRankColumnWithOptions("dense_last", "score", RankOptions{Dense: true, Descending: true}); err != nil {
+		t.Fatalf("AddRankColumnWithOptions() error = %v", err)
+	}
+	if err := ds.AddRankColumnWithOptions("null", "score", RankOptions{Descending: true, Nulls: NullRankNull}); err != nil {
+		t.Fatalf("AddRankColumnWithOptions() error = %v", err)
+	}
+
+	// Nulls rank after all values
+	expectedLast := []int64{4, 2, 1, 2}
+	expectedDense := []int64{3, 2, 1, 2}
+	for i := range expectedLast {
+		val, _ := ds.Cell(i, 1)
+		if val.Raw.(int64) != expectedLast[i] {
+			t.Errorf("last: Cell(%d) = %v, want %v", i, val.Raw, expectedLast[i])
+		}
+		val, _ = ds.Cell(i, 2)
+		if val.Raw.(int64) != expectedDense[i] {
+			t.Errorf("dense_last: Cell(%d) = %v, want %v", i, val.Raw, expectedDense[i])
+		}
+	}
+
+	// Nulls stay null
+	val, _ := ds.Cell(0, 3)
+	if !val.IsNull {
+		t.Errorf("null: Cell(0) = %v, want null", val.Raw)
+	}
+	val, _ = ds.Cell(2, 3)
+	if val.Raw.(int64) != 1 {
+		t.Errorf("null: Cell(2) = %v, want 1", val.Raw)
+	}
+}
+
+func TestAddRankColumn_Errors(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"flag"},
+		[]datatable.DataType{datatable.TypeBool},
+		[][]any{{true}},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddRankColumn("rank", "flag", false, false); err == nil {
+		t.Error("Expected error ranking a bool column")
+	}
+	if err := ds.AddRankColumn("rank", "missing", false, false); err == nil {
+		t.Error("Expected error ranking an unknown column")
+	}
+}
+
+func TestAddLagColumn(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"value"},
+		[]datatable.DataType{datatable.TypeInt},
+		[][]any{
+			{int64(10)},
+			{int64(20)},
+			{int64(30)},
+		},
+	)
+
+	tests := []struct {
+		name     string
+		offset   int
+		expected []any
+	}{
+		{"lag 1", 1, []any{nil, int64(10), int64(20)}},
+		{"lead 1", -1, []any{int64(20), int64(30), nil}},
+		{"offset beyond length", 5, []any{nil, nil, nil}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := NewExpressionDataSource(source)
+			defer ds.Release()
+
+			if err := ds.AddLagColumn("shifted", "value", tt.offset); err != nil {
+				t.Fatalf("AddLagColumn() error = %v", err)
+			}
+
+			for i, want := range tt.expected {
+				val, err := ds.Cell(i, 1)
+				if err != nil {
+					t.Fatalf("Cell(%d, 1) error = %v", i, err)
+				}
+				if want == nil {
+					if !val.IsNull {
+						t.Errorf("Cell(%d, 1) = %v, want null", i, val.Raw)
+					}
+					continue
+				}
+				if val.IsNull || val.Raw.(int64) != want.(int64) {
+					t.Errorf("Cell(%d, 1) = %v, want %v", i, val.Raw, want)
+				}
+			}
+
+			if !ds.IsMaterialized("shifted") {
+				t.Error("Column should be materialized after access")
+			}
+		})
+	}
+}
+
+func TestAddLagColumn_Delta(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"value"},
+		[]datatable.DataType{datatable.TypeFloat},
+		[][]any{
+			{10.0},
+			{15.0},
+			{12.0},
+		},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddLagColumn("prev_value", "value", 1); err != nil {
+		t.Fatalf("AddLagColumn() error = %v", err)
+	}
+
+	expr, err := NewExpression("value - prev_value", []string{"value", "prev_value"}, arrow.PrimitiveTypes.Float64)
+	if err != nil {
+		t.Fatalf("NewExpression() error = %v", err)
+	}
+	if err := ds.AddComputedColumn("delta", expr, datatable.TypeFloat); err != nil {
+		t.Fatalf("AddComputedColumn() error = %v", err)
+	}
+
+	expected := []float64{5.0, -3.0}
+	for i, want := range expected {
+		val, err := ds.Cell(i+1, 2)
+		if err != nil {
+			t.Fatalf("Cell(%d, 2) error = %v", i+1, err)
+		}
+		if val.Raw.(float64) != want {
+			t.Errorf("Cell(%d, 2) = %v, want %v", i+1, val.Raw, want)
+		}
+	}
+}
+
+func TestAddNormalizedColumn(t *testing.T) {
+	tests := []struct {
+		name     string
+		colType  datatable.DataType
+		data     [][]any
+		expected []any
+	}{
+		{
+			"int column",
+			datatable.TypeInt,
+			[][]any{{int64(10)}, {int64(20)}, {int64(30)}},
+			[]any{0.0, 0.5, 1.0},
+		},
+		{
+			"constant column",
+			datatable.TypeFloat,
+			[][]any{{4.0}, {4.0}, {4.0}},
+			[]any{0.0, 0.0, 0.0},
+		},
+		{
+			"nulls pass through",
+			datatable.TypeFloat,
+			[][]any{{2.0}, {nil}, {6.0}, {4.0}},
+			[]any{0.0, nil, 1.0, 0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newMockDataSource([]string{"x"}, []datatable.DataType{tt.colType}, tt.data)
+			ds := NewExpressionDataSource(source)
+			defer ds.Release()
+
+			if err := ds.AddNormalizedColumn("x_norm", "x"); err != nil {
+				t.Fatalf("AddNormalizedColumn() error = %v", err)
+			}
+
+			colType, _ := ds.ColumnType(1)
+			if colType != datatable.TypeFloat {
+				t.Errorf("ColumnType(1) = %v, want Float", colType)
+			}
+
+			for i, want := range tt.expected {
+				val, err := ds.Cell(i, 1)
+				if err != nil {
+					t.Fatalf("Cell(%d, 1) error = %v", i, err)
+				}
+				if want == nil {
+					if !val.IsNull {
+						t.Errorf("Cell(%d, 1) = %v, want null", i, val.Raw)
+					}
+					continue
+				}
+				if val.Raw.(float64) != want.(float64) {
+					t.Errorf("Cell(%d, 1) = %v, want %v", i, val.Raw, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAddNormalizedColumn_NonNumeric(t *testing.T) {
+	source := newMockDataSource(
+		[]string{"name"},
+		[]datatable.DataType{datatable.TypeString},
+		[][]any{{"a"}},
+	)
+
+	ds := NewExpressionDataSource(source)
+	defer ds.Release()
+
+	if err := ds.AddNormalizedColumn("n", "name"); err == nil {
+		t.Error("Expected error normalizing a string column")
+	}
+}
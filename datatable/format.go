@@ -0,0 +1,80 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import "sync"
+
+// Formatter turns a cell value into the text shown for it.
+type Formatter interface {
+	// Format returns the display text of a non-null, non-error value.
+	Format(v Value) string
+}
+
+// FormatterFunc adapts a function to the Formatter interface.
+type FormatterFunc func(v Value) string
+
+// Format calls f(v).
+func (f FormatterFunc) Format(v Value) string {
+	return f(v)
+}
+
+var (
+	formattersMu sync.RWMutex
+	formatters   = make(map[DataType]Formatter)
+)
+
+// RegisterFormatter makes FormatValue use f for values of type t across the
+// application, replacing any formatter registered earlier. Passing nil
+// restores the default formatting for t.
+func RegisterFormatter(t DataType, f Formatter) {
+	formattersMu.Lock()
+	defer formattersMu.Unlock()
+
+	if f == nil {
+		delete(formatters, t)
+		return
+	}
+	formatters[t] = f
+}
+
+// DefaultFormatter returns the formatter FormatValue uses for types without
+// a registered one: it shows a value's Formatted text, deriving it from Raw
+// as NewValue does when it is empty.
+func DefaultFormatter() Formatter {
+	return FormatterFunc(func(v Value) string {
+		v.EnsureFormatted()
+		return v.Formatted
+	})
+}
+
+// FormatValue returns the display text of v using the formatter registered
+// for v.Type, or DefaultFormatter when there is none. Null and error values
+// keep their Formatted text, whatever the formatter.
+//
+// Formatted itself always keeps the default form, since sorting and
+// filtering parse it; FormatValue only affects what is displayed.
+func FormatValue(v Value) string {
+	if v.IsNull || v.IsError() {
+		return v.Formatted
+	}
+
+	formattersMu.RLock()
+	f, ok := formatters[v.Type]
+	formattersMu.RUnlock()
+	if !ok {
+		f = DefaultFormatter()
+	}
+	return f.Format(v)
+}
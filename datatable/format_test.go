@@ -0,0 +1,62 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestFormatValue_RegisteredFormatter(t *testing.T) {
+	RegisterFormatter(TypeFloat, FormatterFunc(func(v Value) string {
+		return fmt.Sprintf("%.2f", v.Raw)
+	}))
+	defer RegisterFormatter(TypeFloat, nil)
+
+	ts := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value Value
+		want  string
+	}{
+		{"custom float", NewValue(1234.5, TypeFloat), "1234.50"},
+		{"float literal", Value{Raw: 2.0, Type: TypeFloat}, "2.00"},
+		{"default int", NewValue(int64(42), TypeInt), "42"},
+		{"default date", NewValue(ts, TypeDate), "2025-03-14"},
+		{"default keeps source text", Value{Raw: "12.50", Type: TypeDecimal, Formatted: "12.50"}, "12.50"},
+		{"null float", NewNullValue(TypeFloat), ""},
+		{"error float", NewErrorValue("bad", TypeFloat), "Error: bad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatValue(tt.value); got != tt.want {
+				t.Errorf("FormatValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	// Formatted keeps the default form
+	if got := NewValue(1234.5, TypeFloat).Formatted; got != "1234.5" {
+		t.Errorf("NewValue().Formatted = %q, want 1234.5", got)
+	}
+
+	// Removing the formatter restores the default
+	RegisterFormatter(TypeFloat, nil)
+	if got := FormatValue(NewValue(1234.5, TypeFloat)); got != "1234.5" {
+		t.Errorf("FormatValue() after reset = %q, want 1234.5", got)
+	}
+}
@@ -15,10 +15,21 @@
 package datatable
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
 )
 
+// filterCancelCheckInterval is how many rows are evaluated between checks
+// for context cancellation in SetFilterContext.
+const filterCancelCheckInterval = 256
+
+// countProgressInterval is how many rows CountVisibleRows scans between
+// progress reports.
+const countProgressInterval = 4096
+
 // TableModel manages the state of table data and coordinates transformations.
 // It provides view management (filtering, sorting, column visibility) without
 // modifying the underlying data source.
@@ -29,7 +40,7 @@ type TableModel struct {
 	// Thread safety
 	mu sync.RWMutex
 
-	// Original data dimensions (cached for performance)
+	// Original data dimensions (cached for performance, updated by Reload)
 	originalRows int
 	originalCols int
 
@@ -37,12 +48,29 @@ type TableModel struct {
 	visibleRows []int // Indices of visible rows in original data
 	visibleCols []int // Indices of visible columns
 
-	// Sort state
-	sortState SortState
+	// Sort keys in priority order (empty when unsorted)
+	sortStates []SortState
+
+	// Column widths by original column index (set by the UI, persisted in ViewState)
+	columnWidths map[int]float32
 
 	// Filter state
 	activeFilters []Filter
 	filterMask    []bool // Quick lookup: is row i visible after filtering?
+
+	// Filter evaluation limit (0 = no limit) and whether the last pass hit it
+	filterMaxRows   int
+	filterTruncated bool
+
+	// Bumped by every SetFilterContext call so a pass that finishes late
+	// cannot replace the result of a newer one
+	filterGeneration uint64
+
+	// In-flight filter pass progress (read without mu)
+	filterPassActive    atomic.Bool
+	filterPassLimit     atomic.Int64
+	filterPassEvaluated atomic.Int64
+	filterPassMatched   atomic.Int64
 }
 
 // NewTableModel creates a new TableModel from a DataSource.
@@ -78,7 +106,6 @@ func NewTableModel(source DataSource) (*TableModel, error) {
 		originalCols:  colCount,
 		visibleRows:   visibleRows,
 		visibleCols:   visibleCols,
-		sortState:     SortState{Column: -1, Direction: SortNone},
 		activeFilters: make([]Filter, 0),
 		filterMask:    filterMask,
 	}, nil
@@ -102,11 +129,15 @@ func (m *TableModel) VisibleColumnCount() int {
 
 // OriginalRowCount returns the total number of rows in the data source.
 func (m *TableModel) OriginalRowCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.originalRows
 }
 
 // OriginalColumnCount returns the total number of columns in the data source.
 func (m *TableModel) OriginalColumnCount() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.originalCols
 }
 
@@ -188,20 +219,161 @@ func (m *TableModel) VisibleColumnType(col int) (DataType, error) {
 	return m.source.ColumnType(originalCol)
 }
 
+// EstimatedVisibleRowCount returns the number of visible rows and whether the
+// count is exact. While a filter pass is running the count is extrapolated
+// from the rows evaluated so far over the rows the pass will evaluate, and
+// exact is false.
+func (m *TableModel) EstimatedVisibleRowCount() (count int, exact bool) {
+	if m.filterPassActive.Load() {
+		evaluated := m.filterPassEvaluated.Load()
+		if evaluated > 0 {
+			matched := m.filterPassMatched.Load()
+			return int(matched * m.filterPassLimit.Load() / evaluated), false
+		}
+	}
+
+	return m.VisibleRowCount(), true
+}
+
+// CountVisibleRows counts the rows that pass the active filter by evaluating
+// it over the source rows, calling progress with the running count as the
+// scan advances. Like a filter pass, it stops at the SetFilterMaxRows limit.
+// The reported values never decrease and the last call reports the final
+// total. Without a filter every row is counted at once.
+// Returns ctx.Err() if ctx is cancelled before the count completes.
+func (m *TableModel) CountVisibleRows(ctx context.Context, progress func(int)) (int, error) {
+	m.mu.RLock()
+	filters := make([]Filter, len(m.activeFilters))
+	copy(filters, m.activeFilters)
+	rowCount := m.originalRows
+	limit := m.filterLimit(rowCount)
+	m.mu.RUnlock()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if len(filters) == 0 {
+		if progress != nil {
+			progress(rowCount)
+		}
+		return rowCount, nil
+	}
+
+	passes, err := m.rowPredicate(filters)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := 0; i < limit; i++ {
+		if i > 0 && i%countProgressInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return count, err
+			}
+			if progress != nil {
+				progress(count)
+			}
+		}
+
+		row, err := m.source.Row(i)
+		if err != nil {
+			return count, fmt.Errorf("failed to get row %d: %w", i, err)
+		}
+		ok, err := passes(row)
+		if err != nil {
+			return count, fmt.Errorf("filter evaluation failed for row %d: %w", i, err)
+		}
+		if ok {
+			count++
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return count, err
+	}
+	if progress != nil {
+		progress(count)
+	}
+
+	return count, nil
+}
+
+// DistinctValues returns the unique values of a visible column over the
+// currently visible rows, in the order they first appear. Values are
+// compared by their formatted text; all nulls count as one value. Cells that
+// cannot be read are skipped.
+//
+// At most limit values are returned (limit <= 0 means no limit), and
+// truncated reports whether the column has more. An out-of-range col
+// returns nil and false.
+func (m *TableModel) DistinctValues(col int, limit int) (values []Value, truncated bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if col < 0 || col >= len(m.visibleCols) {
+		return nil, false
+	}
+	originalCol := m.visibleCols[col]
+
+	seen := make(map[string]bool)
+	seenNull := false
+	for _, row := range m.visibleRows {
+		value, err := m.source.Cell(row, originalCol)
+		if err != nil {
+			continue
+		}
+
+		if value.IsNull {
+			if seenNull {
+				continue
+			}
+			seenNull = true
+		} else {
+			value.EnsureFormatted()
+			if seen[value.Formatted] {
+				continue
+			}
+			seen[value.Formatted] = true
+		}
+
+		if limit > 0 && len(values) == limit {
+			return values, true
+		}
+		values = append(values, value)
+	}
+
+	return values, false
+}
+
 // --- State Queries ---
 
-// GetSortState returns the current sort state.
+// GetSortState returns the primary sort key, or an unsorted state
+// (Column -1, SortNone) if the table is not sorted.
 func (m *TableModel) GetSortState() SortState {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.sortState
+	if len(m.sortStates) == 0 {
+		return SortState{Column: -1, Direction: SortNone}
+	}
+	return m.sortStates[0]
+}
+
+// GetSortStates returns a copy of all sort keys in priority order.
+// Returns an empty slice if the table is not sorted.
+func (m *TableModel) GetSortStates() []SortState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	result := make([]SortState, len(m.sortStates))
+	copy(result, m.sortStates)
+	return result
 }
 
 // IsSorted returns true if the table is currently sorted.
 func (m *TableModel) IsSorted() bool {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
-	return m.sortState.IsSorted()
+	return len(m.sortStates) > 0
 }
 
 // IsFiltered returns true if any filters are active or columns are hidden.
@@ -211,10 +383,18 @@ func (m *TableModel) IsFiltered() bool {
 	return len(m.activeFilters) > 0 || len(m.visibleCols) != m.originalCols
 }
 
+// FilterTruncated reports whether the active filter stopped at the limit set
+// with SetFilterMaxRows, leaving later rows unevaluated and hidden.
+func (m *TableModel) FilterTruncated() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.filterTruncated
+}
+
 // --- State Mutations (validated, return errors) ---
 
-// SetVisibleColumns sets which columns are visible.
-// Columns are specified by their original indices.
+// SetVisibleColumns sets which columns are visible and their display order.
+// Columns are specified by their original indices, in the order they should appear.
 // Returns ErrInvalidColumn if any column index is out of range.
 func (m *TableModel) SetVisibleColumns(cols []int) error {
 	m.mu.Lock()
@@ -236,44 +416,94 @@ func (m *TableModel) SetVisibleColumns(cols []int) error {
 		seen[col] = true
 	}
 
-	// If we're currently sorted by a column, check if it will still be visible
+	// If we're currently sorted, check which sorted columns will still be visible
 	// We need to do this BEFORE updating m.visibleCols
-	sortedOriginalCol := -1
-	if m.sortState.IsSorted() && m.sortState.Column >= 0 && m.sortState.Column < len(m.visibleCols) {
-		sortedOriginalCol = m.visibleCols[m.sortState.Column]
-	}
+	sortedOriginalCols := m.sortedOriginalColumns()
 
 	// Update visible columns
 	m.visibleCols = make([]int, len(cols))
 	copy(m.visibleCols, cols)
 
-	// Check if the sorted column is still visible
-	if sortedOriginalCol >= 0 {
-		colStillVisible := false
-		for _, col := range cols {
-			if col == sortedOriginalCol {
-				colStillVisible = true
-				break
-			}
-		}
-		if !colStillVisible {
-			m.sortState = SortState{Column: -1, Direction: SortNone}
+	// Remap the sort keys to their columns' new positions, dropping hidden ones
+	m.remapSortColumns(sortedOriginalCols)
+
+	return nil
+}
+
+// MoveColumn moves the visible column at index from to index to, shifting
+// the columns in between. Both indices are visible column indices.
+// The sort keys follow their columns to their new positions.
+// Returns ErrInvalidColumn if either index is out of visible range.
+func (m *TableModel) MoveColumn(from, to int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, col := range []int{from, to} {
+		if col < 0 || col >= len(m.visibleCols) {
+			return fmt.Errorf("%w: %d (visible range: 0-%d)", ErrInvalidColumn, col, len(m.visibleCols)-1)
 		}
 	}
+	if from == to {
+		return nil
+	}
+
+	sortedOriginalCols := m.sortedOriginalColumns()
+
+	moved := m.visibleCols[from]
+	newCols := make([]int, 0, len(m.visibleCols))
+	newCols = append(newCols, m.visibleCols[:from]...)
+	newCols = append(newCols, m.visibleCols[from+1:]...)
+	newCols = append(newCols[:to], append([]int{moved}, newCols[to:]...)...)
+	m.visibleCols = newCols
+
+	m.remapSortColumns(sortedOriginalCols)
 
 	return nil
 }
 
+// sortedOriginalColumns returns the original column index of each sort key,
+// in priority order. Must be called with lock held, before visibleCols changes.
+func (m *TableModel) sortedOriginalColumns() []int {
+	cols := make([]int, len(m.sortStates))
+	for i, state := range m.sortStates {
+		cols[i] = m.visibleCols[state.Column]
+	}
+	return cols
+}
+
+// remapSortColumns points each sort key at the visible position of its
+// original column (as captured by sortedOriginalColumns), dropping keys whose
+// column is no longer visible. Remaining keys keep their priority order.
+// Must be called with lock held.
+func (m *TableModel) remapSortColumns(sortedOriginalCols []int) {
+	position := make(map[int]int, len(m.visibleCols))
+	for i, col := range m.visibleCols {
+		position[col] = i
+	}
+
+	remapped := make([]SortState, 0, len(m.sortStates))
+	for i, originalCol := range sortedOriginalCols {
+		if visibleIndex, ok := position[originalCol]; ok {
+			remapped = append(remapped, SortState{Column: visibleIndex, Direction: m.sortStates[i].Direction})
+		}
+	}
+	m.sortStates = remapped
+}
+
 // ResetVisibleColumns makes all columns visible.
 func (m *TableModel) ResetVisibleColumns() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	sortedOriginalCols := m.sortedOriginalColumns()
+
 	m.visibleCols = make([]int, m.originalCols)
 	for i := range m.visibleCols {
 		m.visibleCols[i] = i
 	}
 
+	m.remapSortColumns(sortedOriginalCols)
+
 	return nil
 }
 
@@ -282,7 +512,7 @@ func (m *TableModel) ClearSort() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.sortState = SortState{Column: -1, Direction: SortNone}
+	m.sortStates = nil
 
 	// Reset visible rows to filtered order
 	m.rebuildVisibleRows()
@@ -290,6 +520,22 @@ func (m *TableModel) ClearSort() error {
 	return nil
 }
 
+// RestoreSourceOrder puts the currently visible rows back in the order they
+// have in the data source and clears the sort state. Unlike ClearSort it does
+// not rebuild the rows from the filter mask: exactly the rows that are
+// visible now stay visible, so any applied filter is kept.
+func (m *TableModel) RestoreSourceOrder() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sortStates = nil
+
+	restored := make([]int, len(m.visibleRows))
+	copy(restored, m.visibleRows)
+	sort.Ints(restored)
+	m.visibleRows = restored
+}
+
 // rebuildVisibleRows updates visibleRows based on filterMask.
 // Must be called with lock held.
 func (m *TableModel) rebuildVisibleRows() {
@@ -317,17 +563,43 @@ func (m *TableModel) GetVisibleColumnIndices() []int {
 	return result
 }
 
+// SetFilterMaxRows limits how many rows SetFilter and SetFilterContext
+// evaluate. Rows past the limit are not evaluated and are hidden, and
+// FilterTruncated reports true. This keeps filtering responsive on very large
+// sources. A limit of 0 or less removes it. The limit applies to the next
+// filter pass; the current view is not re-filtered.
+func (m *TableModel) SetFilterMaxRows(n int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if n < 0 {
+		n = 0
+	}
+	m.filterMaxRows = n
+}
+
 // SetFilter applies a filter to the table, updating visible rows.
 // The filter is evaluated against the original data source.
 // Previous filters are replaced by the new filter.
 // Pass nil to clear all filters.
 func (m *TableModel) SetFilter(filter Filter) error {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	return m.SetFilterContext(context.Background(), filter)
+}
 
+// SetFilterContext applies a filter like SetFilter, but stops evaluating as
+// soon as ctx is cancelled. The rows are evaluated without holding the model
+// lock, so readers are not blocked while a long filter pass is in progress.
+// If ctx is cancelled the view state is left unchanged and ctx.Err() is returned.
+// A pass superseded by a later call also leaves the view state unchanged and
+// returns context.Canceled.
+func (m *TableModel) SetFilterContext(ctx context.Context, filter Filter) error {
 	if filter == nil {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
 		// Clear filter
+		m.filterGeneration++
 		m.activeFilters = make([]Filter, 0)
+		m.filterTruncated = false
 		for i := range m.filterMask {
 			m.filterMask[i] = true
 		}
@@ -335,31 +607,33 @@ func (m *TableModel) SetFilter(filter Filter) error {
 		return nil
 	}
 
-	// Apply filter to all rows
-	columnNames := make([]string, m.originalCols)
-	for i := 0; i < m.originalCols; i++ {
-		name, err := m.source.ColumnName(i)
-		if err != nil {
-			return fmt.Errorf("failed to get column name %d: %w", i, err)
-		}
-		columnNames[i] = name
-	}
+	// Stop at the row limit, if any
+	m.mu.Lock()
+	m.filterGeneration++
+	generation := m.filterGeneration
+	rowCount := m.originalRows
+	limit := m.filterLimit(rowCount)
+	m.mu.Unlock()
 
-	// Evaluate filter for each row
-	for i := 0; i < m.originalRows; i++ {
-		row, err := m.source.Row(i)
-		if err != nil {
-			return fmt.Errorf("failed to get row %d: %w", i, err)
-		}
+	newMask, err := m.evaluateFilterMask(ctx, []Filter{filter}, rowCount, limit)
+	if err != nil {
+		return err
+	}
 
-		passes, err := filter.Evaluate(row, columnNames)
-		if err != nil {
-			return fmt.Errorf("filter evaluation failed for row %d: %w", i, err)
-		}
+	m.mu.Lock()
+	defer m.mu.Unlock()
 
-		m.filterMask[i] = passes
+	// The pass may have been cancelled or superseded while it ran
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if generation != m.filterGeneration {
+		return context.Canceled
 	}
 
+	m.filterMask = newMask
+	m.filterTruncated = limit < rowCount
+
 	// Update active filters
 	m.activeFilters = []Filter{filter}
 
@@ -367,18 +641,184 @@ func (m *TableModel) SetFilter(filter Filter) error {
 	m.rebuildVisibleRows()
 
 	// If we have a sort state, we need to re-sort the filtered rows
-	if m.sortState.IsSorted() {
+	if len(m.sortStates) > 0 {
 		// Note: This requires access to sort engine, which we'll handle
 		// by having the caller re-apply sort after filter
 		// For now, clear sort state to maintain consistency
-		m.sortState = SortState{Column: -1, Direction: SortNone}
+		m.sortStates = nil
+	}
+
+	return nil
+}
+
+// filterLimit returns how many of rowCount rows a filter pass evaluates.
+// Must be called with lock held.
+func (m *TableModel) filterLimit(rowCount int) int {
+	if m.filterMaxRows > 0 && m.filterMaxRows < rowCount {
+		return m.filterMaxRows
+	}
+	return rowCount
+}
+
+// evaluateFilterMask evaluates the filters against the first limit of
+// rowCount source rows and returns a mask of the rows passing all of them.
+// Rows past limit are hidden. It runs without holding the model lock and
+// publishes progress for EstimatedVisibleRowCount.
+// Returns ctx.Err() if ctx is cancelled.
+func (m *TableModel) evaluateFilterMask(ctx context.Context, filters []Filter, rowCount, limit int) ([]bool, error) {
+	passesAll, err := m.rowPredicate(filters)
+	if err != nil {
+		return nil, err
+	}
+
+	// Publish progress for EstimatedVisibleRowCount
+	m.filterPassLimit.Store(int64(limit))
+	m.filterPassEvaluated.Store(0)
+	m.filterPassMatched.Store(0)
+	m.filterPassActive.Store(true)
+	defer m.filterPassActive.Store(false)
+
+	// Evaluate into a fresh mask so a cancelled pass never leaves the
+	// model half-filtered
+	mask := make([]bool, rowCount)
+	for i := 0; i < limit; i++ {
+		if i%filterCancelCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		row, err := m.source.Row(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get row %d: %w", i, err)
+		}
+
+		passes, err := passesAll(row)
+		if err != nil {
+			return nil, fmt.Errorf("filter evaluation failed for row %d: %w", i, err)
+		}
+
+		mask[i] = passes
+		if passes {
+			m.filterPassMatched.Add(1)
+		}
+		m.filterPassEvaluated.Add(1)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return mask, nil
+}
+
+// rowPredicate returns a function reporting whether a source row passes all
+// of filters, with the column names and types of the source resolved once.
+func (m *TableModel) rowPredicate(filters []Filter) (func(row []Value) (bool, error), error) {
+	schema, err := SchemaOf(m.source)
+	if err != nil {
+		return nil, err
+	}
+	columnNames := make([]string, len(schema))
+	columnTypes := make([]DataType, len(schema))
+	for i, col := range schema {
+		columnNames[i] = col.Name
+		columnTypes[i] = col.Type
+	}
+
+	return func(row []Value) (bool, error) {
+		for _, filter := range filters {
+			passes, err := EvaluateFilter(filter, row, columnNames, columnTypes)
+			if err != nil || !passes {
+				return false, err
+			}
+		}
+		return true, nil
+	}, nil
+}
+
+// Reload re-reads the dimensions of the data source after it has been
+// changed, for example through MutableDataSource, and rebuilds the view.
+//
+// The active filter is evaluated again over all rows, so new rows appear if
+// they pass it. Rows that were visible keep their current order and newly
+// visible rows follow in source order; the sort keys are kept, and callers
+// that sort should re-apply the sort as they do after SetFilter. Visible
+// columns that no longer exist are dropped along with their sort keys, and
+// if every column was visible, new columns become visible too.
+//
+// If the filter cannot be evaluated the view is left unchanged.
+func (m *TableModel) Reload() error {
+	rowCount := m.source.RowCount()
+	colCount := m.source.ColumnCount()
+
+	m.mu.RLock()
+	filters := make([]Filter, len(m.activeFilters))
+	copy(filters, m.activeFilters)
+	limit := m.filterLimit(rowCount)
+	m.mu.RUnlock()
+
+	var newMask []bool
+	if len(filters) > 0 {
+		var err error
+		newMask, err = m.evaluateFilterMask(context.Background(), filters, rowCount, limit)
+		if err != nil {
+			return err
+		}
+	} else {
+		newMask = make([]bool, rowCount)
+		for i := range newMask {
+			newMask[i] = true
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Columns: drop removed ones, and show added ones if all were shown
+	sortedOriginalCols := m.sortedOriginalColumns()
+	allVisible := len(m.visibleCols) == m.originalCols
+	newCols := make([]int, 0, colCount)
+	for _, col := range m.visibleCols {
+		if col < colCount {
+			newCols = append(newCols, col)
+		}
+	}
+	if allVisible {
+		for col := m.originalCols; col < colCount; col++ {
+			newCols = append(newCols, col)
+		}
+	}
+	m.visibleCols = newCols
+	m.remapSortColumns(sortedOriginalCols)
+
+	// Rows: keep the current order of rows that are still visible
+	kept := make([]bool, rowCount)
+	newRows := make([]int, 0, rowCount)
+	for _, row := range m.visibleRows {
+		if row < rowCount && newMask[row] {
+			kept[row] = true
+			newRows = append(newRows, row)
+		}
+	}
+	for row, visible := range newMask {
+		if visible && !kept[row] {
+			newRows = append(newRows, row)
+		}
 	}
 
+	m.originalRows = rowCount
+	m.originalCols = colCount
+	m.filterMask = newMask
+	m.filterTruncated = len(filters) > 0 && limit < rowCount
+	m.visibleRows = newRows
+
 	return nil
 }
 
 // SetSort applies sorting to the currently visible (filtered) rows.
 // The column parameter is the visible column index (not original).
+// It replaces any existing sort keys with a single key; see SetSortStates
+// for multi-column sorts.
 // Returns ErrInvalidColumn if column is out of visible range.
 func (m *TableModel) SetSort(column int, direction SortDirection) error {
 	m.mu.Lock()
@@ -390,16 +830,16 @@ func (m *TableModel) SetSort(column int, direction SortDirection) error {
 
 	if direction == SortNone {
 		// Clear sort
-		m.sortState = SortState{Column: -1, Direction: SortNone}
+		m.sortStates = nil
 		m.rebuildVisibleRows()
 		return nil
 	}
 
 	// Update sort state
-	m.sortState = SortState{
+	m.sortStates = []SortState{{
 		Column:    column,
 		Direction: direction,
-	}
+	}}
 
 	// Note: The actual sorting is deferred to the sort engine
 	// This method just updates the state
@@ -409,6 +849,42 @@ func (m *TableModel) SetSort(column int, direction SortDirection) error {
 	return nil
 }
 
+// SetSortStates sets an ordered list of sort keys, the first being the
+// primary key. Columns are visible column indices.
+// Passing an empty list clears the sort and returns rows to filtered order.
+// Like SetSort, this only updates the state; the caller sorts the rows and
+// applies them with ApplySortedIndices.
+// Returns ErrInvalidColumn if a column is out of visible range or repeated.
+func (m *TableModel) SetSortStates(states []SortState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seen := make(map[int]bool, len(states))
+	for _, state := range states {
+		if state.Column < 0 || state.Column >= len(m.visibleCols) {
+			return fmt.Errorf("%w: %d (visible range: 0-%d)", ErrInvalidColumn, state.Column, len(m.visibleCols)-1)
+		}
+		if seen[state.Column] {
+			return fmt.Errorf("%w: duplicate sort column %d", ErrInvalidColumn, state.Column)
+		}
+		seen[state.Column] = true
+		if state.Direction != SortAscending && state.Direction != SortDescending {
+			return fmt.Errorf("invalid sort direction for column %d: %s", state.Column, state.Direction)
+		}
+	}
+
+	if len(states) == 0 {
+		m.sortStates = nil
+		m.rebuildVisibleRows()
+		return nil
+	}
+
+	m.sortStates = make([]SortState, len(states))
+	copy(m.sortStates, states)
+
+	return nil
+}
+
 // ApplySortedIndices updates the visible rows with pre-sorted indices.
 // This is called by the sort engine integration layer.
 // The indices must be valid row indices from the current visibleRows.
@@ -458,6 +934,38 @@ func (m *TableModel) GetVisibleRowIndices() []int {
 	return result
 }
 
+// OriginalRowIndex returns the data source row shown at visible row
+// visibleRow, e.g. to map a selection in the UI back to the source.
+// Returns ErrInvalidRow if visibleRow is out of visible range.
+func (m *TableModel) OriginalRowIndex(visibleRow int) (int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if visibleRow < 0 || visibleRow >= len(m.visibleRows) {
+		return -1, fmt.Errorf("%w: %d (visible range: 0-%d)", ErrInvalidRow, visibleRow, len(m.visibleRows)-1)
+	}
+	return m.visibleRows[visibleRow], nil
+}
+
+// VisibleRowIndex returns the visible row showing data source row
+// originalRow, the inverse of OriginalRowIndex. ok is false if the row is
+// out of range or hidden by the filter.
+// The lookup scans the visible rows.
+func (m *TableModel) VisibleRowIndex(originalRow int) (visibleRow int, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if originalRow < 0 || originalRow >= m.originalRows {
+		return -1, false
+	}
+	for i, row := range m.visibleRows {
+		if row == originalRow {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
 // Filter interface for extensibility (to be implemented in filter package)
 type Filter interface {
 	// Evaluate returns true if the row passes the filter
@@ -466,3 +974,23 @@ type Filter interface {
 	// Description returns a human-readable description of the filter
 	Description() string
 }
+
+// TypedFilter is implemented by filters that can use the declared column
+// types of the source. Sources such as Arrow do not set Value.Type on each
+// cell, so a filter that compares by type needs it from the schema.
+type TypedFilter interface {
+	Filter
+
+	// EvaluateTyped is like Evaluate, with columnTypes holding the type of
+	// each column in columnNames.
+	EvaluateTyped(row []Value, columnNames []string, columnTypes []DataType) (bool, error)
+}
+
+// EvaluateFilter evaluates filter on row, through EvaluateTyped when filter
+// implements TypedFilter.
+func EvaluateFilter(filter Filter, row []Value, columnNames []string, columnTypes []DataType) (bool, error) {
+	if typed, ok := filter.(TypedFilter); ok {
+		return typed.EvaluateTyped(row, columnNames, columnTypes)
+	}
+	return filter.Evaluate(row, columnNames)
+}
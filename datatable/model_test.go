@@ -15,7 +15,9 @@
 package datatable
 
 import (
+	"context"
 	"errors"
+	"slices"
 	"sync"
 	"testing"
 )
@@ -270,6 +272,229 @@ func TestTableModel_SetVisibleColumns(t *testing.T) {
 	}
 }
 
+func TestTableModel_SetVisibleColumns_Order(t *testing.T) {
+	source := newMockDataSource(5, 4)
+	model, _ := NewTableModel(source)
+
+	// Sort by original column 1 (visible index 1)
+	if err := model.SetSort(1, SortAscending); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+
+	if err := model.SetVisibleColumns([]int{3, 1, 0}); err != nil {
+		t.Fatalf("SetVisibleColumns() error = %v", err)
+	}
+
+	for i, want := range []string{"D", "B", "A"} {
+		if name, _ := model.VisibleColumnName(i); name != want {
+			t.Errorf("VisibleColumnName(%d) = %s, want %s", i, name, want)
+		}
+	}
+
+	// Sort still points at original column 1, now at visible index 1
+	if sortState := model.GetSortState(); sortState.Column != 1 {
+		t.Errorf("Sort column = %d, want 1", sortState.Column)
+	}
+}
+
+func TestTableModel_MoveColumn(t *testing.T) {
+	source := newMockDataSource(5, 4)
+	model, _ := NewTableModel(source)
+
+	// Sort by column C (visible index 2)
+	if err := model.SetSort(2, SortDescending); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+
+	// Move A to the end: B C D A
+	if err := model.MoveColumn(0, 3); err != nil {
+		t.Fatalf("MoveColumn() error = %v", err)
+	}
+
+	for i, want := range []string{"B", "C", "D", "A"} {
+		if name, _ := model.VisibleColumnName(i); name != want {
+			t.Errorf("VisibleColumnName(%d) = %s, want %s", i, name, want)
+		}
+	}
+
+	cell, err := model.VisibleCell(0, 3)
+	if err != nil {
+		t.Fatalf("VisibleCell() error = %v", err)
+	}
+	if cell.Formatted != "A0" {
+		t.Errorf("VisibleCell(0, 3) = %s, want A0", cell.Formatted)
+	}
+
+	// Sorted column C moved from visible index 2 to 1
+	sortState := model.GetSortState()
+	if sortState.Column != 1 || sortState.Direction != SortDescending {
+		t.Errorf("Sort state = %+v, want column 1 descending", sortState)
+	}
+	if name, _ := model.VisibleColumnName(sortState.Column); name != "C" {
+		t.Errorf("Sorted column name = %s, want C", name)
+	}
+
+	// Move D to the front: D B C A
+	if err := model.MoveColumn(2, 0); err != nil {
+		t.Fatalf("MoveColumn() error = %v", err)
+	}
+	if name, _ := model.VisibleColumnName(model.GetSortState().Column); name != "C" {
+		t.Errorf("Sorted column name after second move = %s, want C", name)
+	}
+
+	// Invalid indices
+	if err := model.MoveColumn(0, 4); !errors.Is(err, ErrInvalidColumn) {
+		t.Errorf("MoveColumn(0, 4) error = %v, want ErrInvalidColumn", err)
+	}
+	if err := model.MoveColumn(-1, 0); !errors.Is(err, ErrInvalidColumn) {
+		t.Errorf("MoveColumn(-1, 0) error = %v, want ErrInvalidColumn", err)
+	}
+}
+
+func TestTableModel_SortStates(t *testing.T) {
+	source := newMockDataSource(5, 4)
+	model, _ := NewTableModel(source)
+
+	if states := model.GetSortStates(); len(states) != 0 {
+		t.Errorf("Initial GetSortStates() = %v, want empty", states)
+	}
+
+	// Sort by C descending, then A ascending
+	want := []SortState{
+		{Column: 2, Direction: SortDescending},
+		{Column: 0, Direction: SortAscending},
+	}
+	if err := model.SetSortStates(want); err != nil {
+		t.Fatalf("SetSortStates() error = %v", err)
+	}
+
+	got := model.GetSortStates()
+	if len(got) != len(want) {
+		t.Fatalf("GetSortStates() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("GetSortStates()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// Single-column accessors report the primary key
+	if state := model.GetSortState(); state != want[0] {
+		t.Errorf("GetSortState() = %+v, want %+v", state, want[0])
+	}
+	if !model.IsSorted() {
+		t.Error("IsSorted() = false, want true")
+	}
+
+	// Returned slice is a copy
+	got[0].Column = 3
+	if state := model.GetSortState(); state.Column != 2 {
+		t.Errorf("GetSortState().Column = %d after mutating copy, want 2", state.Column)
+	}
+
+	// Keys follow their columns: B C D A
+	if err := model.MoveColumn(0, 3); err != nil {
+		t.Fatalf("MoveColumn() error = %v", err)
+	}
+	got = model.GetSortStates()
+	if len(got) != 2 || got[0].Column != 1 || got[1].Column != 3 {
+		t.Errorf("GetSortStates() after move = %v, want columns [1 3]", got)
+	}
+
+	// Hiding the primary column drops its key and promotes the next one
+	if err := model.SetVisibleColumns([]int{0, 1}); err != nil {
+		t.Fatalf("SetVisibleColumns() error = %v", err)
+	}
+	got = model.GetSortStates()
+	if len(got) != 1 || got[0] != (SortState{Column: 0, Direction: SortAscending}) {
+		t.Errorf("GetSortStates() after hiding C = %v, want [{0 Ascending}]", got)
+	}
+
+	// SetSort replaces all keys with one
+	if err := model.SetSort(1, SortDescending); err != nil {
+		t.Fatalf("SetSort() error = %v", err)
+	}
+	if got = model.GetSortStates(); len(got) != 1 || got[0].Column != 1 {
+		t.Errorf("GetSortStates() after SetSort = %v, want one key on column 1", got)
+	}
+
+	// Empty list clears the sort
+	if err := model.SetSortStates(nil); err != nil {
+		t.Fatalf("SetSortStates(nil) error = %v", err)
+	}
+	if model.IsSorted() {
+		t.Error("IsSorted() = true after clearing, want false")
+	}
+	if state := model.GetSortState(); state.Column != -1 || state.Direction != SortNone {
+		t.Errorf("GetSortState() after clearing = %+v, want unsorted", state)
+	}
+}
+
+func TestTableModel_SetSortStates_Invalid(t *testing.T) {
+	source := newMockDataSource(5, 3)
+	model, _ := NewTableModel(source)
+
+	tests := []struct {
+		name   string
+		states []SortState
+	}{
+		{"out of range", []SortState{{Column: 3, Direction: SortAscending}}},
+		{"negative", []SortState{{Column: -1, Direction: SortAscending}}},
+		{"duplicate", []SortState{{Column: 1, Direction: SortAscending}, {Column: 1, Direction: SortDescending}}},
+		{"no direction", []SortState{{Column: 0, Direction: SortNone}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := model.SetSortStates(tt.states); err == nil {
+				t.Error("SetSortStates() error = nil, want error")
+			}
+			if model.IsSorted() {
+				t.Error("IsSorted() = true after rejected SetSortStates")
+			}
+		})
+	}
+}
+
+func TestTableModel_RestoreSourceOrder(t *testing.T) {
+	source := newMockDataSource(10, 3)
+	model, _ := NewTableModel(source)
+
+	// Keep rows 1, 3, 5, 7, 9
+	if err := model.SetFilter(&alternatingFilter{}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	// Sort descending
+	if err := model.SetSort(0, SortDescending); err != nil {
+		t.Fatalf("SetSort failed: %v", err)
+	}
+	if err := model.ApplySortedIndices([]int{9, 7, 5, 3, 1}); err != nil {
+		t.Fatalf("ApplySortedIndices failed: %v", err)
+	}
+
+	model.RestoreSourceOrder()
+
+	want := []int{1, 3, 5, 7, 9}
+	got := model.GetVisibleRowIndices()
+	if len(got) != len(want) {
+		t.Fatalf("GetVisibleRowIndices() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("GetVisibleRowIndices() = %v, want %v", got, want)
+			break
+		}
+	}
+
+	if model.IsSorted() {
+		t.Error("IsSorted() = true after RestoreSourceOrder")
+	}
+	if !model.IsFiltered() || len(model.GetActiveFilters()) != 1 {
+		t.Error("RestoreSourceOrder removed the active filter")
+	}
+}
+
 func TestTableModel_ResetVisibleColumns(t *testing.T) {
 	source := newMockDataSource(5, 4)
 	model, _ := NewTableModel(source)
@@ -408,3 +633,484 @@ func TestTableModel_GetVisibleColumnIndices(t *testing.T) {
 		t.Error("GetVisibleColumnIndices() should return a copy, not original slice")
 	}
 }
+
+// alternatingFilter accepts every second row and counts evaluations.
+type alternatingFilter struct {
+	calls int
+	onRow func(calls int)
+}
+
+func (f *alternatingFilter) Evaluate(row []Value, columnNames []string) (bool, error) {
+	f.calls++
+	if f.onRow != nil {
+		f.onRow(f.calls)
+	}
+	return f.calls%2 == 0, nil
+}
+
+func (f *alternatingFilter) Description() string {
+	return "every second row"
+}
+
+func TestTableModel_SetFilterContext(t *testing.T) {
+	source := newMockDataSource(10, 3)
+	model, _ := NewTableModel(source)
+
+	f := &alternatingFilter{}
+	if err := model.SetFilterContext(context.Background(), f); err != nil {
+		t.Fatalf("SetFilterContext failed: %v", err)
+	}
+
+	if model.VisibleRowCount() != 5 {
+		t.Errorf("Expected 5 visible rows, got %d", model.VisibleRowCount())
+	}
+	if len(model.GetActiveFilters()) != 1 {
+		t.Errorf("Expected 1 active filter, got %d", len(model.GetActiveFilters()))
+	}
+}
+
+func TestTableModel_RowIndexMapping(t *testing.T) {
+	model, _ := NewTableModel(newMockDataSource(10, 3))
+
+	// Keep the odd rows, then reorder them
+	if err := model.SetFilter(&alternatingFilter{}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	order := []int{9, 5, 1, 7, 3}
+	if err := model.ApplySortedIndices(order); err != nil {
+		t.Fatalf("ApplySortedIndices failed: %v", err)
+	}
+
+	for visible, original := range order {
+		got, err := model.OriginalRowIndex(visible)
+		if err != nil || got != original {
+			t.Errorf("OriginalRowIndex(%d) = %d, %v, want %d", visible, got, err, original)
+		}
+		back, ok := model.VisibleRowIndex(original)
+		if !ok || back != visible {
+			t.Errorf("VisibleRowIndex(%d) = %d, %v, want %d", original, back, ok, visible)
+		}
+	}
+
+	for _, visible := range []int{-1, 5} {
+		if _, err := model.OriginalRowIndex(visible); !errors.Is(err, ErrInvalidRow) {
+			t.Errorf("OriginalRowIndex(%d) error = %v, want ErrInvalidRow", visible, err)
+		}
+	}
+
+	// Filtered-out and out-of-range rows are not visible
+	for _, original := range []int{0, 4, -1, 10} {
+		if row, ok := model.VisibleRowIndex(original); ok {
+			t.Errorf("VisibleRowIndex(%d) = %d, want not visible", original, row)
+		}
+	}
+}
+
+func TestTableModel_SetFilterMaxRows(t *testing.T) {
+	source := newMockDataSource(1000, 2)
+	model, _ := NewTableModel(source)
+	model.SetFilterMaxRows(100)
+
+	f := &alternatingFilter{}
+	if err := model.SetFilter(f); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	if f.calls != 100 {
+		t.Errorf("Filter evaluated %d rows, want 100", f.calls)
+	}
+	if !model.FilterTruncated() {
+		t.Error("FilterTruncated() = false, want true")
+	}
+	if model.VisibleRowCount() != 50 {
+		t.Errorf("Expected 50 visible rows, got %d", model.VisibleRowCount())
+	}
+	for _, row := range model.GetVisibleRowIndices() {
+		if row >= 100 {
+			t.Fatalf("Row %d past the limit is visible", row)
+		}
+	}
+
+	// Clearing the filter clears the flag
+	if err := model.SetFilter(nil); err != nil {
+		t.Fatalf("SetFilter(nil) failed: %v", err)
+	}
+	if model.FilterTruncated() {
+		t.Error("FilterTruncated() = true after clearing the filter")
+	}
+
+	// A limit above the row count does not truncate
+	model.SetFilterMaxRows(5000)
+	if err := model.SetFilter(&alternatingFilter{}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	if model.FilterTruncated() {
+		t.Error("FilterTruncated() = true with a limit above the row count")
+	}
+}
+
+func TestTableModel_SetFilterContext_Cancelled(t *testing.T) {
+	source := newMockDataSource(1000, 2)
+	model, _ := NewTableModel(source)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &alternatingFilter{
+		onRow: func(calls int) {
+			if calls == 10 {
+				cancel()
+			}
+		},
+	}
+
+	err := model.SetFilterContext(ctx, f)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	// Cancelled pass must leave the view untouched
+	if model.VisibleRowCount() != 1000 {
+		t.Errorf("Expected 1000 visible rows after cancel, got %d", model.VisibleRowCount())
+	}
+	if len(model.GetActiveFilters()) != 0 {
+		t.Errorf("Expected no active filters after cancel, got %d", len(model.GetActiveFilters()))
+	}
+	if f.calls >= 1000 {
+		t.Errorf("Expected evaluation to stop early, evaluated %d rows", f.calls)
+	}
+}
+
+func TestTableModel_SetFilterContext_Superseded(t *testing.T) {
+	source := newMockDataSource(100, 2)
+	model, _ := NewTableModel(source)
+
+	// A newer filter is applied while the first pass is still running
+	newer := &alternatingFilter{}
+	var newerErr error
+	stale := &alternatingFilter{
+		onRow: func(calls int) {
+			if calls == 10 {
+				newerErr = model.SetFilter(newer)
+			}
+		},
+	}
+
+	err := model.SetFilterContext(context.Background(), stale)
+	if newerErr != nil {
+		t.Fatalf("SetFilter failed: %v", newerErr)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled for the superseded pass, got %v", err)
+	}
+
+	// The newer filter's result stays in place
+	if filters := model.GetActiveFilters(); len(filters) != 1 || filters[0] != Filter(newer) {
+		t.Errorf("Expected only the newer filter to be active, got %v", filters)
+	}
+	if model.VisibleRowCount() != 50 {
+		t.Errorf("Expected the newer filter's 50 rows, got %d", model.VisibleRowCount())
+	}
+}
+
+func TestTableModel_CountVisibleRows(t *testing.T) {
+	source := newMockDataSource(10000, 2)
+	model, _ := NewTableModel(source)
+
+	if err := model.SetFilter(&alternatingFilter{}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	var reports []int
+	count, err := model.CountVisibleRows(context.Background(), func(n int) {
+		reports = append(reports, n)
+	})
+	if err != nil {
+		t.Fatalf("CountVisibleRows failed: %v", err)
+	}
+
+	if count != 5000 {
+		t.Errorf("Expected count 5000, got %d", count)
+	}
+	if count != model.VisibleRowCount() {
+		t.Errorf("Count %d does not match VisibleRowCount %d", count, model.VisibleRowCount())
+	}
+
+	if len(reports) < 2 {
+		t.Fatalf("Expected multiple progress reports, got %v", reports)
+	}
+	for i := 1; i < len(reports); i++ {
+		if reports[i] < reports[i-1] {
+			t.Errorf("Progress decreased at report %d: %v", i, reports)
+		}
+	}
+	if reports[len(reports)-1] != count {
+		t.Errorf("Expected final report %d, got %d", count, reports[len(reports)-1])
+	}
+}
+
+func TestTableModel_CountVisibleRows_Cancelled(t *testing.T) {
+	source := newMockDataSource(10000, 1)
+	model, _ := NewTableModel(source)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := model.CountVisibleRows(ctx, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestTableModel_CountVisibleRows_EvaluatesFilter(t *testing.T) {
+	source := newMockDataSource(10000, 1)
+	model, _ := NewTableModel(source)
+	model.SetFilterMaxRows(1000)
+
+	f := &alternatingFilter{}
+	if err := model.SetFilter(f); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	passCalls := f.calls
+
+	count, err := model.CountVisibleRows(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("CountVisibleRows failed: %v", err)
+	}
+
+	// The count comes from evaluating the filter again, up to the limit
+	if f.calls-passCalls != 1000 {
+		t.Errorf("Expected 1000 rows evaluated, got %d", f.calls-passCalls)
+	}
+	if count != 500 {
+		t.Errorf("Expected count 500, got %d", count)
+	}
+}
+
+func TestTableModel_EstimatedVisibleRowCount(t *testing.T) {
+	source := newMockDataSource(1000, 1)
+	model, _ := NewTableModel(source)
+
+	count, exact := model.EstimatedVisibleRowCount()
+	if !exact || count != 1000 {
+		t.Errorf("Expected exact 1000 before filtering, got %d (exact=%v)", count, exact)
+	}
+
+	// Sample the estimate midway through a filter pass
+	var midCount int
+	var midExact bool
+	f := &alternatingFilter{
+		onRow: func(calls int) {
+			if calls == 501 {
+				midCount, midExact = model.EstimatedVisibleRowCount()
+			}
+		},
+	}
+	if err := model.SetFilter(f); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	if midExact {
+		t.Error("Expected inexact estimate during filter pass")
+	}
+	if midCount != 500 {
+		t.Errorf("Expected mid-pass estimate of 500, got %d", midCount)
+	}
+
+	count, exact = model.EstimatedVisibleRowCount()
+	if !exact || count != 500 {
+		t.Errorf("Expected exact 500 after filtering, got %d (exact=%v)", count, exact)
+	}
+}
+
+func TestTableModel_EstimatedVisibleRowCount_MaxRows(t *testing.T) {
+	source := newMockDataSource(10000, 1)
+	model, _ := NewTableModel(source)
+	model.SetFilterMaxRows(1000)
+
+	// The estimate extrapolates over the rows the pass evaluates
+	var midCount int
+	f := &alternatingFilter{
+		onRow: func(calls int) {
+			if calls == 501 {
+				midCount, _ = model.EstimatedVisibleRowCount()
+			}
+		},
+	}
+	if err := model.SetFilter(f); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+
+	if midCount != 500 {
+		t.Errorf("Expected mid-pass estimate of 500, got %d", midCount)
+	}
+}
+
+// formattedValues returns the formatted text of values, with "<null>" for
+// null values.
+func formattedValues(values []Value) []string {
+	texts := make([]string, len(values))
+	for i, v := range values {
+		if v.IsNull {
+			texts[i] = "<null>"
+		} else {
+			texts[i] = v.Formatted
+		}
+	}
+	return texts
+}
+
+func TestTableModel_DistinctValues(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+
+	tests := []struct {
+		name          string
+		col, limit    int
+		want          []string
+		wantTruncated bool
+	}{
+		{"all departments", 3, 0, []string{"Engineering", "Design", "Product"}, false},
+		{"limit equals count", 3, 3, []string{"Engineering", "Design", "Product"}, false},
+		{"truncated", 3, 2, []string{"Engineering", "Design"}, true},
+		{"null counted once", 2, 0, []string{"75000.5", "<null>", "85000.75", "76000", "72000"}, false},
+		{"out of range", 4, 0, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, truncated := model.DistinctValues(tt.col, tt.limit)
+			got := formattedValues(values)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DistinctValues() = %v, want %v", got, tt.want)
+			}
+			if truncated != tt.wantTruncated {
+				t.Errorf("DistinctValues() truncated = %v, want %v", truncated, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestTableModel_DistinctValues_VisibleView(t *testing.T) {
+	model, _ := NewTableModel(newEmployeeDataSource())
+
+	// Visible column 0 maps to Department
+	if err := model.SetVisibleColumns([]int{3, 0}); err != nil {
+		t.Fatalf("SetVisibleColumns failed: %v", err)
+	}
+	values, _ := model.DistinctValues(0, 0)
+	if got := formattedValues(values); len(got) != 3 || got[0] != "Engineering" {
+		t.Errorf("DistinctValues(0) = %v, want the three departments", got)
+	}
+
+	// Only visible rows are considered
+	if err := model.SetFilter(&departmentFilter{department: "Engineering"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	values, truncated := model.DistinctValues(0, 1)
+	if got := formattedValues(values); len(got) != 1 || got[0] != "Engineering" || truncated {
+		t.Errorf("DistinctValues(0, 1) = %v, %v, want [Engineering], false", got, truncated)
+	}
+}
+
+// appendEmployee adds a row to a source built by newEmployeeDataSource, as a
+// mutable source would.
+func (m *mockDataSource) appendEmployee(name string, age int64, salary float64, department string) {
+	m.data = append(m.data, []Value{
+		NewValue(name, TypeString),
+		NewValue(age, TypeInt),
+		NewValue(salary, TypeFloat),
+		NewValue(department, TypeString),
+	})
+	m.rows++
+}
+
+func TestTableModel_Reload(t *testing.T) {
+	source := newEmployeeDataSource()
+	model, _ := NewTableModel(source)
+
+	if err := model.SetFilter(&departmentFilter{department: "Engineering"}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	// Sort by name descending: Grace, Eve, Alice
+	if err := model.SetSort(0, SortDescending); err != nil {
+		t.Fatalf("SetSort failed: %v", err)
+	}
+	if err := model.ApplySortedIndices([]int{4, 3, 0}); err != nil {
+		t.Fatalf("ApplySortedIndices failed: %v", err)
+	}
+
+	source.appendEmployee("Heidi", 29, 71000, "Engineering")
+	source.appendEmployee("Ivan", 41, 90000, "Product")
+
+	if err := model.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if got := model.OriginalRowCount(); got != 7 {
+		t.Errorf("OriginalRowCount() = %d, want 7", got)
+	}
+	// Existing rows keep their order and the new match follows;
+	// the row outside the filter stays hidden
+	if got, want := model.GetVisibleRowIndices(), []int{4, 3, 0, 5}; !slices.Equal(got, want) {
+		t.Errorf("visible rows = %v, want %v", got, want)
+	}
+	if state := model.GetSortState(); state.Column != 0 || state.Direction != SortDescending {
+		t.Errorf("sort state = %+v, want column 0 descending", state)
+	}
+	if len(model.GetActiveFilters()) != 1 {
+		t.Error("Reload removed the active filter")
+	}
+
+	// Without a filter every new row is visible
+	if err := model.SetFilter(nil); err != nil {
+		t.Fatalf("SetFilter(nil) failed: %v", err)
+	}
+	source.appendEmployee("Judy", 38, 88000, "Design")
+	if err := model.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 8 {
+		t.Errorf("VisibleRowCount() = %d, want 8", got)
+	}
+}
+
+func TestTableModel_Reload_Shrink(t *testing.T) {
+	source := newEmployeeDataSource()
+	model, _ := NewTableModel(source)
+
+	// Sort by Department, then drop the last two rows and the Department column
+	if err := model.SetSortStates([]SortState{
+		{Column: 3, Direction: SortAscending},
+		{Column: 1, Direction: SortDescending},
+	}); err != nil {
+		t.Fatalf("SetSortStates failed: %v", err)
+	}
+	if err := model.ApplySortedIndices([]int{1, 3, 4, 0, 2}); err != nil {
+		t.Fatalf("ApplySortedIndices failed: %v", err)
+	}
+
+	source.data = source.data[:3]
+	source.rows = 3
+	source.cols = 3
+	source.columnNames = source.columnNames[:3]
+	source.columnTypes = source.columnTypes[:3]
+
+	if err := model.Reload(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+
+	if got, want := model.GetVisibleRowIndices(), []int{1, 0, 2}; !slices.Equal(got, want) {
+		t.Errorf("visible rows = %v, want %v", got, want)
+	}
+	if got, want := model.GetVisibleColumnIndices(), []int{0, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf("visible columns = %v, want %v", got, want)
+	}
+	if got := model.OriginalColumnCount(); got != 3 {
+		t.Errorf("OriginalColumnCount() = %d, want 3", got)
+	}
+	// The sort key on the removed column is dropped
+	if got, want := model.GetSortStates(), []SortState{{Column: 1, Direction: SortDescending}}; !slices.Equal(got, want) {
+		t.Errorf("sort states = %v, want %v", got, want)
+	}
+	if _, err := model.VisibleCell(2, 2); err != nil {
+		t.Errorf("VisibleCell(2, 2) failed after Reload: %v", err)
+	}
+}
@@ -15,7 +15,12 @@
 // Package datatable provides a reusable data table widget for Fyne applications.
 package datatable
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // DataType represents the type of data in a column.
 type DataType int
@@ -41,6 +46,10 @@ const (
 	TypeStruct
 	// TypeList represents list/array data.
 	TypeList
+	// TypeTime represents time-of-day data (without date).
+	TypeTime
+	// TypeDuration represents elapsed time (durations and intervals).
+	TypeDuration
 )
 
 // String returns the string representation of a DataType.
@@ -66,6 +75,10 @@ func (dt DataType) String() string {
 		return "Struct"
 	case TypeList:
 		return "List"
+	case TypeTime:
+		return "Time"
+	case TypeDuration:
+		return "Duration"
 	default:
 		return fmt.Sprintf("Unknown(%d)", dt)
 	}
@@ -114,6 +127,16 @@ func NewValue(raw any, dataType DataType) Value {
 	}
 }
 
+// EnsureFormatted derives Formatted from Raw and Type when it is empty, using
+// the same rules as NewValue. Null and error values are left unchanged.
+// Use it for values built as struct literals rather than with NewValue.
+func (v *Value) EnsureFormatted() {
+	if v.Formatted != "" || v.IsNull || v.Error != "" || v.Raw == nil {
+		return
+	}
+	v.Formatted = formatValue(v.Raw, v.Type)
+}
+
 // NewNullValue creates a null value of the specified type.
 func NewNullValue(dataType DataType) Value {
 	return Value{
@@ -141,15 +164,74 @@ func (v Value) IsError() bool {
 	return v.Error != ""
 }
 
-// formatValue converts a raw value to a formatted string.
+// formatValue converts a raw value to a formatted string: integers plain,
+// floats in their shortest exact form, bools as "true"/"false", and
+// time.Time values as ISO 8601 dates, times or timestamps depending on type.
 func formatValue(raw any, dataType DataType) string {
 	if raw == nil {
 		return ""
 	}
 
-	// Use default string formatting for now
-	// This can be enhanced with type-specific formatting
-	return fmt.Sprintf("%v", raw)
+	switch v := raw.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case time.Time:
+		switch dataType {
+		case TypeDate:
+			return v.Format(time.DateOnly)
+		case TypeTime:
+			return v.Format(time.TimeOnly)
+		default:
+			return v.Format(time.RFC3339)
+		}
+	case time.Duration:
+		if dataType == TypeDuration {
+			return FormatDuration(v)
+		}
+		return v.String()
+	default:
+		// Integers and everything else use their default representation
+		return fmt.Sprintf("%v", raw)
+	}
+}
+
+// FormatDuration formats d for display with days, hours, minutes and
+// seconds, leaving out zero units: 90 minutes is "1h30m" and 26 hours is
+// "1d2h". Fractions of a second keep their unit, as in "1.5s" or "250ms".
+func FormatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var b strings.Builder
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	for _, unit := range []struct {
+		size   time.Duration
+		suffix string
+	}{
+		{24 * time.Hour, "d"},
+		{time.Hour, "h"},
+		{time.Minute, "m"},
+	} {
+		if n := d / unit.size; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10))
+			b.WriteString(unit.suffix)
+			d -= n * unit.size
+		}
+	}
+	if d > 0 {
+		b.WriteString(d.String())
+	}
+	return b.String()
 }
 
 // Metadata holds optional metadata about a data source.
@@ -16,6 +16,7 @@ package datatable
 
 import (
 	"testing"
+	"time"
 )
 
 func TestDataType_String(t *testing.T) {
@@ -34,6 +35,8 @@ func TestDataType_String(t *testing.T) {
 		{"TypeDecimal", TypeDecimal, "Decimal"},
 		{"TypeStruct", TypeStruct, "Struct"},
 		{"TypeList", TypeList, "List"},
+		{"TypeTime", TypeTime, "Time"},
+		{"TypeDuration", TypeDuration, "Duration"},
 		{"Unknown", DataType(999), "Unknown(999)"},
 	}
 
@@ -89,6 +92,75 @@ func TestNewNullValue(t *testing.T) {
 	}
 }
 
+func TestValue_EnsureFormatted(t *testing.T) {
+	ts := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value Value
+		want  string
+	}{
+		{"int", Value{Raw: int64(25), Type: TypeInt}, "25"},
+		{"uint", Value{Raw: uint64(18446744073709551615), Type: TypeInt}, "18446744073709551615"},
+		{"float", Value{Raw: 1.5, Type: TypeFloat}, "1.5"},
+		{"float32", Value{Raw: float32(0.1), Type: TypeFloat}, "0.1"},
+		{"bool", Value{Raw: true, Type: TypeBool}, "true"},
+		{"string", Value{Raw: "hello", Type: TypeString}, "hello"},
+		{"date", Value{Raw: ts, Type: TypeDate}, "2025-03-14"},
+		{"time", Value{Raw: ts, Type: TypeTime}, "15:09:26"},
+		{"timestamp", Value{Raw: ts, Type: TypeTimestamp}, "2025-03-14T15:09:26Z"},
+		{"duration", Value{Raw: 90 * time.Minute, Type: TypeDuration}, "1h30m"},
+		{"existing formatted kept", Value{Raw: 25, Type: TypeInt, Formatted: "twenty-five"}, "twenty-five"},
+		{"null untouched", Value{Type: TypeInt, IsNull: true}, ""},
+		{"error untouched", NewErrorValue("bad", TypeInt), "Error: bad"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := tt.value
+			v.EnsureFormatted()
+			if v.Formatted != tt.want {
+				t.Errorf("EnsureFormatted() Formatted = %q, want %q", v.Formatted, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewValue_FormatsByType(t *testing.T) {
+	ts := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+
+	if got := NewValue(ts, TypeDate).Formatted; got != "2025-03-14" {
+		t.Errorf("NewValue(date).Formatted = %q, want 2025-03-14", got)
+	}
+	if got := NewValue(false, TypeBool).Formatted; got != "false" {
+		t.Errorf("NewValue(false).Formatted = %q, want false", got)
+	}
+	if got := NewValue(1e21, TypeFloat).Formatted; got != "1e+21" {
+		t.Errorf("NewValue(1e21).Formatted = %q, want 1e+21", got)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0s"},
+		{90 * time.Minute, "1h30m"},
+		{26 * time.Hour, "1d2h"},
+		{45 * time.Second, "45s"},
+		{time.Hour + 1500*time.Millisecond, "1h1.5s"},
+		{250 * time.Millisecond, "250ms"},
+		{-(2*time.Hour + 5*time.Minute), "-2h5m"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatDuration(tt.d); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
 func TestSortDirection_String(t *testing.T) {
 	tests := []struct {
 		name string
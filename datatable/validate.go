@@ -0,0 +1,115 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import "fmt"
+
+// validateSampleRows is the maximum number of rows ValidateSource reads.
+const validateSampleRows = 100
+
+// ValidateSource checks that src behaves as the DataSource interface
+// requires. It is meant for adapter tests:
+//
+//   - ColumnName and ColumnType succeed for every column
+//   - Row returns ColumnCount values for a sample of rows spread over the
+//     whole source, always including the first and last row
+//   - Cell succeeds for every column of the sampled rows
+//   - Cell, Row, ColumnName and ColumnType return errors for indices just
+//     outside the valid range
+//
+// Returns ErrNoDataSource if src is nil, otherwise the first problem found,
+// wrapping ErrInconsistentSource.
+func ValidateSource(src DataSource) error {
+	if src == nil {
+		return ErrNoDataSource
+	}
+
+	rowCount := src.RowCount()
+	colCount := src.ColumnCount()
+	if rowCount < 0 || colCount < 0 {
+		return fmt.Errorf("%w: negative size %dx%d", ErrInconsistentSource, rowCount, colCount)
+	}
+
+	if _, err := SchemaOf(src); err != nil {
+		return fmt.Errorf("%w: %w", ErrInconsistentSource, err)
+	}
+
+	for _, row := range sampleRows(rowCount, validateSampleRows) {
+		values, err := src.Row(row)
+		if err != nil {
+			return fmt.Errorf("%w: Row(%d): %w", ErrInconsistentSource, row, err)
+		}
+		if len(values) != colCount {
+			return fmt.Errorf("%w: Row(%d) has %d values, ColumnCount is %d",
+				ErrInconsistentSource, row, len(values), colCount)
+		}
+		for col := 0; col < colCount; col++ {
+			if _, err := src.Cell(row, col); err != nil {
+				return fmt.Errorf("%w: Cell(%d, %d): %w", ErrInconsistentSource, row, col, err)
+			}
+		}
+	}
+
+	return validateOutOfRange(src, rowCount, colCount)
+}
+
+// validateOutOfRange checks that indices just outside the source are
+// rejected.
+func validateOutOfRange(src DataSource, rowCount, colCount int) error {
+	for _, col := range []int{-1, colCount} {
+		if _, err := src.ColumnName(col); err == nil {
+			return fmt.Errorf("%w: ColumnName(%d) did not fail", ErrInconsistentSource, col)
+		}
+		if _, err := src.ColumnType(col); err == nil {
+			return fmt.Errorf("%w: ColumnType(%d) did not fail", ErrInconsistentSource, col)
+		}
+		if rowCount > 0 {
+			if _, err := src.Cell(0, col); err == nil {
+				return fmt.Errorf("%w: Cell(0, %d) did not fail", ErrInconsistentSource, col)
+			}
+		}
+	}
+
+	for _, row := range []int{-1, rowCount} {
+		if _, err := src.Row(row); err == nil {
+			return fmt.Errorf("%w: Row(%d) did not fail", ErrInconsistentSource, row)
+		}
+		if colCount > 0 {
+			if _, err := src.Cell(row, 0); err == nil {
+				return fmt.Errorf("%w: Cell(%d, 0) did not fail", ErrInconsistentSource, row)
+			}
+		}
+	}
+
+	return nil
+}
+
+// sampleRows returns up to limit row indices evenly spread over rowCount rows,
+// including the first and last row.
+func sampleRows(rowCount, limit int) []int {
+	if rowCount <= limit {
+		rows := make([]int, rowCount)
+		for i := range rows {
+			rows[i] = i
+		}
+		return rows
+	}
+
+	rows := make([]int, limit)
+	for i := range rows {
+		rows[i] = i * (rowCount - 1) / (limit - 1)
+	}
+	return rows
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"errors"
+	"testing"
+)
+
+// shortRowSource drops the last value of every row.
+type shortRowSource struct {
+	*mockDataSource
+}
+
+func (s shortRowSource) Row(row int) ([]Value, error) {
+	values, err := s.mockDataSource.Row(row)
+	if err != nil {
+		return nil, err
+	}
+	return values[:len(values)-1], nil
+}
+
+// lastCellSource fails Cell for the last row, which RowCount overstates.
+type lastCellSource struct {
+	*mockDataSource
+}
+
+func (s lastCellSource) RowCount() int {
+	return s.mockDataSource.RowCount() + 1
+}
+
+func (s lastCellSource) Row(row int) ([]Value, error) {
+	if row == s.mockDataSource.RowCount() {
+		return make([]Value, s.ColumnCount()), nil
+	}
+	return s.mockDataSource.Row(row)
+}
+
+// lenientSource returns a value instead of an error past the last row.
+type lenientSource struct {
+	*mockDataSource
+}
+
+func (s lenientSource) Row(row int) ([]Value, error) {
+	if row >= s.RowCount() {
+		return make([]Value, s.ColumnCount()), nil
+	}
+	return s.mockDataSource.Row(row)
+}
+
+func TestValidateSource(t *testing.T) {
+	concat, err := ConcatSources(newMonthSource("Jan", 1), newMonthSource("Feb", 2))
+	if err != nil {
+		t.Fatalf("ConcatSources() error = %v", err)
+	}
+
+	sources := map[string]DataSource{
+		"mock":   newMockDataSource(5, 3),
+		"large":  newMockDataSource(1000, 2),
+		"empty":  newMockDataSource(0, 0),
+		"concat": concat,
+	}
+	for name, src := range sources {
+		t.Run(name, func(t *testing.T) {
+			if err := ValidateSource(src); err != nil {
+				t.Errorf("ValidateSource() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateSource_Broken(t *testing.T) {
+	tests := []struct {
+		name string
+		src  DataSource
+		want error
+	}{
+		{"nil", nil, ErrNoDataSource},
+		{"short rows", shortRowSource{newMockDataSource(3, 2)}, ErrInconsistentSource},
+		{"overstated row count", lastCellSource{newMockDataSource(3, 2)}, ErrInconsistentSource},
+		{"no out-of-range error", lenientSource{newMockDataSource(3, 2)}, ErrInconsistentSource},
+		{"column type fails", brokenTypeSource{newMockDataSource(3, 2)}, ErrInconsistentSource},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSource(tt.src)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ValidateSource() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSampleRows(t *testing.T) {
+	rows := sampleRows(1000, 10)
+	if len(rows) != 10 || rows[0] != 0 || rows[9] != 999 {
+		t.Errorf("sampleRows(1000, 10) = %v, want 10 rows from 0 to 999", rows)
+	}
+	for i := 1; i < len(rows); i++ {
+		if rows[i] <= rows[i-1] {
+			t.Errorf("sampleRows(1000, 10) not increasing: %v", rows)
+			break
+		}
+	}
+
+	if rows := sampleRows(3, 10); len(rows) != 3 {
+		t.Errorf("sampleRows(3, 10) = %v, want all 3 rows", rows)
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ViewState is a serializable snapshot of how a TableModel is presented:
+// which columns are shown and in what order, the sort, and column widths.
+// All column references use original (data source) column indices so the
+// state stays meaningful independently of the current view.
+type ViewState struct {
+	// VisibleColumns lists the visible original column indices in display order.
+	VisibleColumns []int `json:"visibleColumns"`
+
+	// SortColumn is the original index of the primary sort column (-1 if unsorted).
+	SortColumn int `json:"sortColumn"`
+	// SortDirection is the sort direction.
+	SortDirection SortDirection `json:"sortDirection"`
+
+	// SortKeys lists every sort key in priority order, the first matching
+	// SortColumn and SortDirection. When empty, ApplyViewState restores the
+	// single key in SortColumn and SortDirection.
+	SortKeys []ViewSortKey `json:"sortKeys,omitempty"`
+
+	// ColumnWidths maps original column indices to widths. Optional.
+	ColumnWidths map[int]float32 `json:"columnWidths,omitempty"`
+}
+
+// ViewSortKey is a sort key in a ViewState.
+type ViewSortKey struct {
+	// Column is the original index of the sorted column.
+	Column int `json:"column"`
+	// Direction is the sort direction.
+	Direction SortDirection `json:"direction"`
+}
+
+// ExportViewState returns a snapshot of the current view state.
+func (m *TableModel) ExportViewState() ViewState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	state := ViewState{
+		VisibleColumns: make([]int, len(m.visibleCols)),
+		SortColumn:     -1,
+		SortDirection:  SortNone,
+	}
+	copy(state.VisibleColumns, m.visibleCols)
+
+	if len(m.sortStates) > 0 {
+		state.SortColumn = m.visibleCols[m.sortStates[0].Column]
+		state.SortDirection = m.sortStates[0].Direction
+
+		state.SortKeys = make([]ViewSortKey, len(m.sortStates))
+		for i, sortState := range m.sortStates {
+			state.SortKeys[i] = ViewSortKey{
+				Column:    m.visibleCols[sortState.Column],
+				Direction: sortState.Direction,
+			}
+		}
+	}
+
+	if len(m.columnWidths) > 0 {
+		state.ColumnWidths = make(map[int]float32, len(m.columnWidths))
+		for col, width := range m.columnWidths {
+			state.ColumnWidths[col] = width
+		}
+	}
+
+	return state
+}
+
+// ApplyViewState restores a view state captured by ExportViewState.
+// All column indices are validated against the data source before anything
+// changes; on error the model is left untouched.
+//
+// The sort keys are restored but rows are returned to filtered order, because
+// the model does not sort by itself. Callers re-run the sort for the restored
+// keys (DataTable.ApplyViewState does this).
+func (m *TableModel) ApplyViewState(state ViewState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Validate visible columns
+	seen := make(map[int]bool, len(state.VisibleColumns))
+	for _, col := range state.VisibleColumns {
+		if col < 0 || col >= m.originalCols {
+			return fmt.Errorf("%w: %d (valid range: 0-%d)", ErrInvalidColumn, col, m.originalCols-1)
+		}
+		if seen[col] {
+			return fmt.Errorf("%w: duplicate column %d", ErrInvalidColumn, col)
+		}
+		seen[col] = true
+	}
+
+	// Validate sort: every sorted column must be visible, and sorted once
+	sortKeys := state.SortKeys
+	if len(sortKeys) == 0 && state.SortColumn >= 0 && state.SortDirection != SortNone {
+		sortKeys = []ViewSortKey{{Column: state.SortColumn, Direction: state.SortDirection}}
+	}
+	var sortStates []SortState
+	for _, key := range sortKeys {
+		if key.Direction != SortAscending && key.Direction != SortDescending {
+			return fmt.Errorf("invalid sort direction: %s", key.Direction)
+		}
+		visibleIndex := slices.Index(state.VisibleColumns, key.Column)
+		if visibleIndex < 0 {
+			return fmt.Errorf("%w: sort column %d is not visible", ErrInvalidColumn, key.Column)
+		}
+		for _, sortState := range sortStates {
+			if sortState.Column == visibleIndex {
+				return fmt.Errorf("%w: duplicate sort column %d", ErrInvalidColumn, key.Column)
+			}
+		}
+		sortStates = append(sortStates, SortState{Column: visibleIndex, Direction: key.Direction})
+	}
+
+	// Validate widths
+	for col, width := range state.ColumnWidths {
+		if col < 0 || col >= m.originalCols {
+			return fmt.Errorf("%w: width for column %d (valid range: 0-%d)", ErrInvalidColumn, col, m.originalCols-1)
+		}
+		if width <= 0 {
+			return fmt.Errorf("invalid width %v for column %d", width, col)
+		}
+	}
+
+	// Apply
+	m.visibleCols = make([]int, len(state.VisibleColumns))
+	copy(m.visibleCols, state.VisibleColumns)
+
+	m.sortStates = sortStates
+	m.rebuildVisibleRows()
+
+	m.columnWidths = nil
+	if len(state.ColumnWidths) > 0 {
+		m.columnWidths = make(map[int]float32, len(state.ColumnWidths))
+		for col, width := range state.ColumnWidths {
+			m.columnWidths[col] = width
+		}
+	}
+
+	return nil
+}
+
+// SetColumnWidth records the display width of a column.
+// The column is specified by its original index.
+// Returns ErrInvalidColumn if the column index is out of range.
+func (m *TableModel) SetColumnWidth(col int, width float32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if col < 0 || col >= m.originalCols {
+		return fmt.Errorf("%w: %d (valid range: 0-%d)", ErrInvalidColumn, col, m.originalCols-1)
+	}
+	if width <= 0 {
+		return fmt.Errorf("invalid width %v for column %d", width, col)
+	}
+
+	if m.columnWidths == nil {
+		m.columnWidths = make(map[int]float32)
+	}
+	m.columnWidths[col] = width
+	return nil
+}
+
+// ColumnWidth returns the recorded width of a column by original index,
+// and whether a width has been recorded.
+func (m *TableModel) ColumnWidth(col int) (float32, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	width, ok := m.columnWidths[col]
+	return width, ok
+}
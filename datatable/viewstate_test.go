@@ -0,0 +1,168 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datatable
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestTableModel_ViewStateRoundTrip(t *testing.T) {
+	source := newMockDataSource(10, 4)
+	model, err := NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	// Hide column 1, move column 3 first, sort by original column 2
+	if err := model.SetVisibleColumns([]int{3, 0, 2}); err != nil {
+		t.Fatalf("Failed to set visible columns: %v", err)
+	}
+	if err := model.SetSort(2, SortDescending); err != nil {
+		t.Fatalf("Failed to set sort: %v", err)
+	}
+	if err := model.SetColumnWidth(3, 180); err != nil {
+		t.Fatalf("Failed to set column width: %v", err)
+	}
+
+	state := model.ExportViewState()
+	if state.SortColumn != 2 || state.SortDirection != SortDescending {
+		t.Errorf("Expected sort on column 2 descending, got %d %v", state.SortColumn, state.SortDirection)
+	}
+
+	// Serialize and restore into a fresh model
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Failed to marshal view state: %v", err)
+	}
+	var restored ViewState
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Failed to unmarshal view state: %v", err)
+	}
+
+	fresh, err := NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	if err := fresh.ApplyViewState(restored); err != nil {
+		t.Fatalf("Failed to apply view state: %v", err)
+	}
+
+	cols := fresh.GetVisibleColumnIndices()
+	expectedCols := []int{3, 0, 2}
+	if len(cols) != len(expectedCols) {
+		t.Fatalf("Expected %d visible columns, got %d", len(expectedCols), len(cols))
+	}
+	for i, col := range expectedCols {
+		if cols[i] != col {
+			t.Errorf("Expected visible column %d to be %d, got %d", i, col, cols[i])
+		}
+	}
+
+	name, _ := fresh.VisibleColumnName(0)
+	if name != "D" {
+		t.Errorf("Expected first visible column D, got %s", name)
+	}
+
+	sortState := fresh.GetSortState()
+	if sortState.Column != 2 || sortState.Direction != SortDescending {
+		t.Errorf("Expected sort on visible column 2 descending, got %d %v", sortState.Column, sortState.Direction)
+	}
+
+	width, ok := fresh.ColumnWidth(3)
+	if !ok || width != 180 {
+		t.Errorf("Expected width 180 for column 3, got %v (recorded: %v)", width, ok)
+	}
+}
+
+func TestTableModel_ViewStateSortKeys(t *testing.T) {
+	source := newMockDataSource(10, 4)
+	model, err := NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	if err := model.SetVisibleColumns([]int{3, 0, 2}); err != nil {
+		t.Fatalf("Failed to set visible columns: %v", err)
+	}
+	keys := []SortState{{Column: 2, Direction: SortDescending}, {Column: 0, Direction: SortAscending}}
+	if err := model.SetSortStates(keys); err != nil {
+		t.Fatalf("Failed to set sort keys: %v", err)
+	}
+
+	// Keys are exported by original column, in priority order
+	state := model.ExportViewState()
+	want := []ViewSortKey{{Column: 2, Direction: SortDescending}, {Column: 3, Direction: SortAscending}}
+	if !slices.Equal(state.SortKeys, want) {
+		t.Errorf("SortKeys = %v, want %v", state.SortKeys, want)
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Failed to marshal view state: %v", err)
+	}
+	var restored ViewState
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("Failed to unmarshal view state: %v", err)
+	}
+
+	fresh, err := NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	if err := fresh.ApplyViewState(restored); err != nil {
+		t.Fatalf("Failed to apply view state: %v", err)
+	}
+	if got := fresh.GetSortStates(); !slices.Equal(got, keys) {
+		t.Errorf("GetSortStates() = %v, want %v", got, keys)
+	}
+}
+
+func TestTableModel_ApplyViewState_Invalid(t *testing.T) {
+	model, err := NewTableModel(newMockDataSource(5, 3))
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		state ViewState
+	}{
+		{"column out of range", ViewState{VisibleColumns: []int{0, 5}, SortColumn: -1}},
+		{"duplicate column", ViewState{VisibleColumns: []int{0, 0}, SortColumn: -1}},
+		{"hidden sort column", ViewState{VisibleColumns: []int{0, 1}, SortColumn: 2, SortDirection: SortAscending}},
+		{"width out of range", ViewState{VisibleColumns: []int{0}, SortColumn: -1, ColumnWidths: map[int]float32{9: 100}}},
+		{"hidden secondary sort key", ViewState{VisibleColumns: []int{0, 1}, SortColumn: 0, SortDirection: SortAscending,
+			SortKeys: []ViewSortKey{{0, SortAscending}, {2, SortDescending}}}},
+		{"duplicate sort key", ViewState{VisibleColumns: []int{0, 1}, SortColumn: 1, SortDirection: SortAscending,
+			SortKeys: []ViewSortKey{{1, SortAscending}, {1, SortDescending}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.ApplyViewState(tt.state)
+			if !errors.Is(err, ErrInvalidColumn) {
+				t.Errorf("Expected ErrInvalidColumn, got %v", err)
+			}
+		})
+	}
+
+	// Model is unchanged after failed applies
+	if model.VisibleColumnCount() != 3 {
+		t.Errorf("Expected 3 visible columns after failed apply, got %d", model.VisibleColumnCount())
+	}
+}
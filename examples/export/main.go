@@ -112,6 +112,8 @@ func main() {
 		Column:   "Age",
 		Operator: filter.OpGreaterThan,
 		Value:    "27",
+		// Age is stored as text in this source; compare it as a number
+		CompareAs: filter.CompareNumeric,
 	}
 
 	if err := model.SetFilter(ageFilter); err != nil {
@@ -62,6 +62,8 @@ func main() {
 		Column:   "Age",
 		Operator: filter.OpGreaterThan,
 		Value:    "28",
+		// Age is stored as text in this source; compare it as a number
+		CompareAs: filter.CompareNumeric,
 	}
 
 	if err := model.SetFilter(simpleFilter); err != nil {
@@ -76,7 +78,7 @@ func main() {
 	fmt.Println("\n--- Example 2: Composite Filter (Age > 25 AND Role = 'Engineer') ---")
 	compositeFilter := &filter.CompositeFilter{
 		Filters: []datatable.Filter{
-			&filter.SimpleFilter{Column: "Age", Operator: filter.OpGreaterThan, Value: "25"},
+			&filter.SimpleFilter{Column: "Age", Operator: filter.OpGreaterThan, Value: "25", CompareAs: filter.CompareNumeric},
 			&filter.SimpleFilter{Column: "Role", Operator: filter.OpEqual, Value: "Engineer"},
 		},
 		Logic: filter.LogicAND,
@@ -186,17 +188,13 @@ func main() {
 		log.Fatal("Failed to set filter:", err)
 	}
 
-	// Get filtered row indices from model's internal state
-	// We need to map from visible indices to original indices
+	// Map the visible rows back to their rows in the data source
 	filteredOriginalIndices := make([]int, model.VisibleRowCount())
-	for i := 0; i < model.VisibleRowCount(); i++ {
-		// Get the cell to access underlying row (this is a workaround)
-		// In production, model should expose GetVisibleRowIndices()
-		filteredOriginalIndices[i] = i
+	for i := range filteredOriginalIndices {
+		filteredOriginalIndices[i], _ = model.OriginalRowIndex(i)
 	}
+	fmt.Printf("Visible rows come from source rows %v\n", filteredOriginalIndices)
 
-	// Since we can't directly access visibleRows, we'll work with the filtered view
-	// For this example, we'll just demonstrate the concept
 	fmt.Println("After filtering:")
 	printTable(model)
 
@@ -15,9 +15,11 @@
 package export
 
 import (
+	"bufio"
 	"encoding/csv"
 	"fmt"
 	"io"
+	"strings"
 )
 
 // CSVConfig configures CSV export options.
@@ -30,15 +32,106 @@ type CSVConfig struct {
 
 	// UseCRLF determines if lines end with \r\n instead of \n
 	UseCRLF bool
+
+	// WriteBOM writes a UTF-8 byte order mark before the data, which lets
+	// Excel on Windows detect the encoding of non-ASCII text
+	WriteBOM bool
+
+	// QuoteAll wraps every field in quotes, doubling embedded quotes, for
+	// strict parsers. By default only fields that need it are quoted.
+	QuoteAll bool
 }
 
+// utf8BOM is the UTF-8 encoded byte order mark.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 // DefaultCSVConfig returns the default CSV configuration.
 func DefaultCSVConfig() CSVConfig {
 	return CSVConfig{
 		Delimiter:      ',',
 		IncludeHeaders: true,
 		UseCRLF:        false,
+		WriteBOM:       false,
+		QuoteAll:       false,
+	}
+}
+
+// recordWriter writes CSV records; csv.Writer and quoteAllWriter implement it.
+type recordWriter interface {
+	Write(record []string) error
+	Flush()
+	Error() error
+}
+
+// newWriter writes the BOM if requested and returns a CSV writer using the
+// configured delimiter, line endings and quoting.
+func (c CSVConfig) newWriter(writer io.Writer) (recordWriter, error) {
+	if c.WriteBOM {
+		if _, err := writer.Write(utf8BOM); err != nil {
+			return nil, fmt.Errorf("failed to write BOM: %w", err)
+		}
+	}
+
+	if c.QuoteAll {
+		return &quoteAllWriter{
+			w:       bufio.NewWriter(writer),
+			comma:   c.Delimiter,
+			useCRLF: c.UseCRLF,
+		}, nil
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Comma = c.Delimiter
+	csvWriter.UseCRLF = c.UseCRLF
+	return csvWriter, nil
+}
+
+// quoteAllWriter writes CSV records like csv.Writer, but quotes every field.
+type quoteAllWriter struct {
+	w       *bufio.Writer
+	comma   rune
+	useCRLF bool
+	err     error
+}
+
+// Write writes a single record, each field quoted with embedded quotes
+// doubled. Line breaks inside fields follow useCRLF as csv.Writer does.
+func (q *quoteAllWriter) Write(record []string) error {
+	for i, field := range record {
+		if i > 0 {
+			q.w.WriteRune(q.comma)
+		}
+		q.w.WriteByte('"')
+		for _, r := range strings.ReplaceAll(field, `"`, `""`) {
+			switch {
+			case r == '\r' && q.useCRLF:
+				// Dropped as csv.Writer does; line breaks are written as \r\n
+			case r == '\n' && q.useCRLF:
+				q.w.WriteString("\r\n")
+			default:
+				q.w.WriteRune(r)
+			}
+		}
+		q.w.WriteByte('"')
+	}
+
+	var err error
+	if q.useCRLF {
+		_, err = q.w.WriteString("\r\n")
+	} else {
+		err = q.w.WriteByte('\n')
 	}
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (q *quoteAllWriter) Flush() {
+	q.err = q.w.Flush()
+}
+
+// Error reports any error from a previous Write or Flush.
+func (q *quoteAllWriter) Error() error {
+	return q.err
 }
 
 // CSVExporter exports data in CSV format.
@@ -74,9 +167,10 @@ func (e *CSVExporter) Export(
 	}
 
 	// Create CSV writer
-	csvWriter := csv.NewWriter(writer)
-	csvWriter.Comma = e.config.Delimiter
-	csvWriter.UseCRLF = e.config.UseCRLF
+	csvWriter, err := e.config.newWriter(writer)
+	if err != nil {
+		return 0, err
+	}
 
 	// Write headers if requested
 	if e.config.IncludeHeaders {
@@ -87,6 +181,7 @@ func (e *CSVExporter) Export(
 	}
 
 	// Export rows
+	columnTypes := iterator.ColumnTypes()
 	rowCount := 0
 	totalRows := iterator.TotalRows()
 
@@ -99,11 +194,7 @@ func (e *CSVExporter) Export(
 		// Convert Values to strings
 		record := make([]string, len(row))
 		for i, val := range row {
-			if val.IsNull {
-				record[i] = "" // Empty string for null values
-			} else {
-				record[i] = val.Formatted
-			}
+			record[i] = cellText(val, columnTypes, i) // Empty string for null values
 		}
 
 		// Write the record
@@ -137,13 +228,20 @@ func (e *CSVExporter) Export(
 	return rowCount, nil
 }
 
-// FileExtension returns "csv".
+// FileExtension returns "tsv" for tab-delimited output and "csv" otherwise.
 func (e *CSVExporter) FileExtension() string {
+	if e.config.Delimiter == '\t' {
+		return "tsv"
+	}
 	return "csv"
 }
 
-// MimeType returns the CSV MIME type.
+// MimeType returns the TSV MIME type for tab-delimited output and the CSV
+// MIME type otherwise.
 func (e *CSVExporter) MimeType() string {
+	if e.config.Delimiter == '\t' {
+		return "text/tab-separated-values"
+	}
 	return "text/csv"
 }
 
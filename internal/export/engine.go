@@ -133,3 +133,16 @@ func (e *Engine) ValidateExporter(exporter Exporter) error {
 
 	return nil
 }
+
+// cellText returns the text exported for the cell in column col: empty for
+// null values, otherwise the value formatted with datatable.FormatValue as
+// its column's type, so exports match what the table displays.
+func cellText(val datatable.Value, columnTypes []datatable.DataType, col int) string {
+	if val.IsNull {
+		return ""
+	}
+	if col < len(columnTypes) {
+		val.Type = columnTypes[col]
+	}
+	return datatable.FormatValue(val)
+}
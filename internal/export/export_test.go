@@ -17,6 +17,8 @@ package export
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -143,6 +145,114 @@ func TestCSVExport_CustomDelimiter(t *testing.T) {
 	}
 }
 
+// TestCSVExport_BOMAndCRLF tests the BOM and line-ending options
+func TestCSVExport_BOMAndCRLF(t *testing.T) {
+	tests := []struct {
+		name     string
+		writeBOM bool
+		useCRLF  bool
+	}{
+		{"defaults", false, false},
+		{"bom", true, false},
+		{"crlf", false, true},
+		{"bom and crlf", true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := createTestData()
+			if err != nil {
+				t.Fatalf("Failed to create test data: %v", err)
+			}
+			iterator, err := NewModelIterator(source, nil)
+			if err != nil {
+				t.Fatalf("Failed to create iterator: %v", err)
+			}
+
+			config := DefaultCSVConfig()
+			config.WriteBOM = tt.writeBOM
+			config.UseCRLF = tt.useCRLF
+
+			var buf bytes.Buffer
+			if _, err := NewCSVExporterWithConfig(config).Export(&buf, iterator, nil); err != nil {
+				t.Fatalf("Export failed: %v", err)
+			}
+			output := buf.Bytes()
+
+			if got := bytes.HasPrefix(output, []byte{0xEF, 0xBB, 0xBF}); got != tt.writeBOM {
+				t.Errorf("BOM present = %v, want %v", got, tt.writeBOM)
+			}
+			output = bytes.TrimPrefix(output, []byte{0xEF, 0xBB, 0xBF})
+
+			lines := strings.SplitAfter(string(output), "\n")
+			lines = lines[:len(lines)-1] // Trailing empty element
+			if len(lines) != 4 {
+				t.Fatalf("Expected 4 lines, got %d: %q", len(lines), output)
+			}
+			for _, line := range lines {
+				if got := strings.HasSuffix(line, "\r\n"); got != tt.useCRLF {
+					t.Errorf("line %q ends with CRLF = %v, want %v", line, got, tt.useCRLF)
+				}
+			}
+			if !strings.HasPrefix(lines[0], "Name,") {
+				t.Errorf("Expected headers on the first line, got %q", lines[0])
+			}
+		})
+	}
+}
+
+// TestTransposeExport_SingleRow tests exporting one row as field/value pairs
+func TestTransposeExport_SingleRow(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+	iterator, err := NewModelIterator(source, []int{1})
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	var buf bytes.Buffer
+	rowCount, err := NewTransposeExporter().Export(&buf, iterator, nil)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if rowCount != 1 {
+		t.Errorf("Expected 1 row exported, got %d", rowCount)
+	}
+
+	// Fields follow the source column order
+	want := "Field,Value\nName,Bob\nAge,25\nRole,Designer\n"
+	if buf.String() != want {
+		t.Errorf("Output mismatch\ngot:  %q\nwant: %q", buf.String(), want)
+	}
+}
+
+// TestTransposeExport_MultipleRows tests that rows become blank-line separated blocks
+func TestTransposeExport_MultipleRows(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+	iterator, err := NewModelIterator(source, []int{0, 2})
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	config := DefaultCSVConfig()
+	config.IncludeHeaders = false
+
+	var buf bytes.Buffer
+	if _, err := NewTransposeExporterWithConfig(config).Export(&buf, iterator, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	want := "Name,Alice\nAge,30\nRole,Engineer\n\nName,Charlie\nAge,35\nRole,Manager\n"
+	if buf.String() != want {
+		t.Errorf("Output mismatch\ngot:  %q\nwant: %q", buf.String(), want)
+	}
+}
+
 // TestCSVExport_SpecialCharacters tests CSV export with special characters
 func TestCSVExport_SpecialCharacters(t *testing.T) {
 	data := [][]string{
@@ -178,6 +288,55 @@ func TestCSVExport_SpecialCharacters(t *testing.T) {
 	}
 }
 
+// TestCSVExport_QuoteAll tests quoting every field against quoting only
+// the fields that need it
+func TestCSVExport_QuoteAll(t *testing.T) {
+	data := [][]string{
+		{"O'Neill, John", "42", "Line 1\nLine 2"},
+		{"Smith \"The Boss\"", "35", "CEO"},
+	}
+	headers := []string{"Name", "Age", "Title"}
+
+	tests := []struct {
+		name     string
+		quoteAll bool
+		useCRLF  bool
+		want     string
+	}{
+		{"quote when needed", false, false,
+			"Name,Age,Title\n\"O'Neill, John\",42,\"Line 1\nLine 2\"\n\"Smith \"\"The Boss\"\"\",35,CEO\n"},
+		{"quote all", true, false,
+			"\"Name\",\"Age\",\"Title\"\n\"O'Neill, John\",\"42\",\"Line 1\nLine 2\"\n\"Smith \"\"The Boss\"\"\",\"35\",\"CEO\"\n"},
+		{"quote all with crlf", true, true,
+			"\"Name\",\"Age\",\"Title\"\r\n\"O'Neill, John\",\"42\",\"Line 1\r\nLine 2\"\r\n\"Smith \"\"The Boss\"\"\",\"35\",\"CEO\"\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source, err := memory.NewDataSource(data, headers)
+			if err != nil {
+				t.Fatalf("Failed to create test data: %v", err)
+			}
+			iterator, err := NewModelIterator(source, nil)
+			if err != nil {
+				t.Fatalf("Failed to create iterator: %v", err)
+			}
+
+			config := DefaultCSVConfig()
+			config.QuoteAll = tt.quoteAll
+			config.UseCRLF = tt.useCRLF
+
+			var buf bytes.Buffer
+			if _, err := NewCSVExporterWithConfig(config).Export(&buf, iterator, nil); err != nil {
+				t.Fatalf("Export failed: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("Export() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestJSONExport_Basic tests basic JSON export
 func TestJSONExport_Basic(t *testing.T) {
 	source, err := createTestData()
@@ -294,6 +453,249 @@ func TestJSONExport_EmptyData(t *testing.T) {
 	}
 }
 
+// createTypedTestData builds a source with int, float, bool and decimal
+// columns whose raw values are strings, plus a null cell.
+func createTypedTestData() (datatable.DataSource, error) {
+	data := [][]datatable.Value{
+		{
+			datatable.NewValue("Alice", datatable.TypeString),
+			datatable.NewValue("30", datatable.TypeInt),
+			datatable.NewValue(1.5, datatable.TypeFloat),
+			datatable.NewValue(true, datatable.TypeBool),
+			datatable.NewValue("12345678901234567890.25", datatable.TypeDecimal),
+		},
+		{
+			datatable.NewValue("Bob", datatable.TypeString),
+			datatable.NewNullValue(datatable.TypeInt),
+			datatable.NewValue("2.25", datatable.TypeFloat),
+			datatable.NewValue("false", datatable.TypeBool),
+			datatable.NewValue("n/a", datatable.TypeDecimal),
+		},
+	}
+	headers := []string{"Name", "Age", "Score", "Active", "Balance"}
+	types := []datatable.DataType{
+		datatable.TypeString,
+		datatable.TypeInt,
+		datatable.TypeFloat,
+		datatable.TypeBool,
+		datatable.TypeDecimal,
+	}
+	return memory.NewDataSourceFromValues(data, headers, types)
+}
+
+// TestJSONExport_TypedValues tests that numbers and booleans are not quoted
+func TestJSONExport_TypedValues(t *testing.T) {
+	source, err := createTypedTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	iterator, err := NewModelIterator(source, nil)
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := NewJSONExporter().Export(&buf, iterator, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		`"Age":30`,
+		`"Score":1.5`,
+		`"Active":true`,
+		`"Balance":12345678901234567890.25`,
+		`"Age":null`,
+		`"Score":2.25`,
+		`"Active":false`,
+		`"Balance":"n/a"`, // not a number, kept as string
+		`"Name":"Alice"`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %s in output, got: %s", want, output)
+		}
+	}
+
+	var result []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Invalid JSON output: %v\nOutput: %s", err, output)
+	}
+	if result[0]["Age"] != float64(30) {
+		t.Errorf("Expected Age=30 as number, got %#v", result[0]["Age"])
+	}
+	if result[0]["Active"] != true {
+		t.Errorf("Expected Active=true as bool, got %#v", result[0]["Active"])
+	}
+}
+
+// TestJSONExport_StringifyValues tests the stringified output mode
+func TestJSONExport_StringifyValues(t *testing.T) {
+	source, err := createTypedTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	iterator, err := NewModelIterator(source, nil)
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	config := DefaultJSONConfig()
+	config.StringifyValues = true
+
+	var buf bytes.Buffer
+	if _, err := NewJSONExporterWithConfig(config).Export(&buf, iterator, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{`"Age":"30"`, `"Score":"1.5"`, `"Active":"true"`, `"Age":null`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %s in output, got: %s", want, output)
+		}
+	}
+}
+
+// TestExport_RegisteredFormatter tests that exported text uses the
+// formatter registered for the column type, as the table does
+func TestExport_RegisteredFormatter(t *testing.T) {
+	datatable.RegisterFormatter(datatable.TypeFloat, datatable.FormatterFunc(func(v datatable.Value) string {
+		return fmt.Sprintf("%.2f", v.Raw)
+	}))
+	defer datatable.RegisterFormatter(datatable.TypeFloat, nil)
+
+	source, err := createTypedTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	stringify := DefaultJSONConfig()
+	stringify.StringifyValues = true
+
+	tests := []struct {
+		name     string
+		exporter Exporter
+		want     string
+	}{
+		{"csv", NewCSVExporter(), "Alice,30,1.50,true"},
+		{"transpose", NewTransposeExporter(), "Score,1.50"},
+		{"json stringified", NewJSONExporterWithConfig(stringify), `"Score":"1.50"`},
+		{"json typed", NewJSONExporter(), `"Score":1.5`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iterator, err := NewModelIterator(source, []int{0})
+			if err != nil {
+				t.Fatalf("Failed to create iterator: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if _, err := tt.exporter.Export(&buf, iterator, nil); err != nil {
+				t.Fatalf("Export failed: %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.want) {
+				t.Errorf("Expected %s in output, got: %s", tt.want, buf.String())
+			}
+		})
+	}
+}
+
+// TestJSONExport_MatchesMarshal tests that streamed output equals marshalling
+// the whole array at once
+func TestJSONExport_MatchesMarshal(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	want := []map[string]any{
+		{"Name": "Alice", "Age": "30", "Role": "Engineer"},
+		{"Name": "Bob", "Age": "25", "Role": "Designer"},
+		{"Name": "Charlie", "Age": "35", "Role": "Manager"},
+	}
+
+	tests := []struct {
+		name   string
+		config JSONConfig
+		want   func() ([]byte, error)
+	}{
+		{
+			name:   "compact",
+			config: DefaultJSONConfig(),
+			want:   func() ([]byte, error) { return json.Marshal(want) },
+		},
+		{
+			name:   "pretty",
+			config: JSONConfig{PrettyPrint: true, Indent: "  "},
+			want: func() ([]byte, error) {
+				b, err := json.MarshalIndent(want, "", "  ")
+				return append(b, '\n'), err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iterator, err := NewModelIterator(source, nil)
+			if err != nil {
+				t.Fatalf("Failed to create iterator: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if _, err := NewJSONExporterWithConfig(tt.config).Export(&buf, iterator, nil); err != nil {
+				t.Fatalf("Export failed: %v", err)
+			}
+
+			expected, err := tt.want()
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			if buf.String() != string(expected) {
+				t.Errorf("Output mismatch\ngot:  %q\nwant: %q", buf.String(), expected)
+			}
+		})
+	}
+}
+
+// TestJSONExport_CancelMidStream tests that a cancelled export is still valid JSON
+func TestJSONExport_CancelMidStream(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	for _, pretty := range []bool{false, true} {
+		iterator, err := NewModelIterator(source, nil)
+		if err != nil {
+			t.Fatalf("Failed to create iterator: %v", err)
+		}
+
+		config := DefaultJSONConfig()
+		config.PrettyPrint = pretty
+
+		var buf bytes.Buffer
+		rowCount, err := NewJSONExporterWithConfig(config).Export(&buf, iterator, func(current, total int) bool {
+			return current < 2 // Cancel after second row
+		})
+		if err == nil || !strings.Contains(err.Error(), "cancelled") {
+			t.Errorf("PrettyPrint=%v: expected cancellation error, got: %v", pretty, err)
+		}
+		if rowCount != 2 {
+			t.Errorf("PrettyPrint=%v: expected 2 rows exported before cancel, got %d", pretty, rowCount)
+		}
+
+		var result []map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("PrettyPrint=%v: invalid JSON output: %v\nOutput: %s", pretty, err, buf.String())
+		}
+		if len(result) != 2 || result[1]["Name"] != "Bob" {
+			t.Errorf("PrettyPrint=%v: expected Alice and Bob, got %v", pretty, result)
+		}
+	}
+}
+
 // TestIterator_Subset tests iterating over a subset of rows
 func TestIterator_Subset(t *testing.T) {
 	source, err := createTestData()
@@ -341,6 +743,70 @@ func TestIterator_Subset(t *testing.T) {
 	}
 }
 
+// TestIterator_ColumnSubset tests exporting a subset of columns
+func TestIterator_ColumnSubset(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	iterator, err := NewModelIteratorWithColumns(source, nil, []int{0, 2})
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	names := iterator.ColumnNames()
+	if len(names) != 2 || names[0] != "Name" || names[1] != "Role" {
+		t.Errorf("Expected columns [Name Role], got %v", names)
+	}
+
+	var buf bytes.Buffer
+	if _, err := NewCSVExporter().Export(&buf, iterator, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []string{"Name,Role", "Alice,Engineer", "Bob,Designer", "Charlie,Manager"}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d: %q", len(want), len(lines), lines)
+	}
+	for i := range want {
+		if strings.TrimSpace(lines[i]) != want[i] {
+			t.Errorf("Line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+
+	// Columns are emitted in the given order
+	iterator, err = NewModelIteratorWithColumns(source, []int{1}, []int{2, 0})
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+	if !iterator.Next() {
+		t.Fatal("Expected one row")
+	}
+	row, err := iterator.Row()
+	if err != nil {
+		t.Fatalf("Row() failed: %v", err)
+	}
+	if len(row) != 2 || row[0].Formatted != "Designer" || row[1].Formatted != "Bob" {
+		t.Errorf("Expected row [Designer Bob], got %v", row)
+	}
+}
+
+// TestIterator_ColumnSubset_Invalid tests column index validation
+func TestIterator_ColumnSubset_Invalid(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+
+	for _, cols := range [][]int{{3}, {-1}} {
+		if _, err := NewModelIteratorWithColumns(source, nil, cols); !errors.Is(err, datatable.ErrInvalidColumn) {
+			t.Errorf("NewModelIteratorWithColumns(%v) error = %v, want ErrInvalidColumn", cols, err)
+		}
+	}
+}
+
 // TestProgressCallback tests progress callback during export
 func TestProgressCallback(t *testing.T) {
 	source, err := createTestData()
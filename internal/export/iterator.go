@@ -25,6 +25,8 @@ import (
 type ModelIterator struct {
 	model       datatable.DataSource
 	visibleRows []int // Indices of visible rows
+	columns     []int // Original indices of emitted columns
+	projected   bool  // Whether columns differs from all columns in order
 	columnNames []string
 	columnTypes []datatable.DataType
 	currentRow  int
@@ -35,28 +37,50 @@ type ModelIterator struct {
 func NewModelIterator(
 	source datatable.DataSource,
 	visibleRows []int,
+) (*ModelIterator, error) {
+	return NewModelIteratorWithColumns(source, visibleRows, nil)
+}
+
+// NewModelIteratorWithColumns creates an iterator like NewModelIterator that
+// emits only the given original column indices, in the given order.
+// A nil visibleCols emits all columns.
+// Returns ErrInvalidColumn if any column index is out of range.
+func NewModelIteratorWithColumns(
+	source datatable.DataSource,
+	visibleRows []int,
+	visibleCols []int,
 ) (*ModelIterator, error) {
 	if source == nil {
 		return nil, datatable.ErrNoDataSource
 	}
 
 	// Get column information
-	colCount := source.ColumnCount()
-	columnNames := make([]string, colCount)
-	columnTypes := make([]datatable.DataType, colCount)
-
-	for i := 0; i < colCount; i++ {
-		name, err := source.ColumnName(i)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get column name %d: %w", i, err)
-		}
-		columnNames[i] = name
+	schema, err := datatable.SchemaOf(source)
+	if err != nil {
+		return nil, err
+	}
 
-		colType, err := source.ColumnType(i)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get column type %d: %w", i, err)
+	var columns []int
+	if visibleCols != nil {
+		columns = make([]int, len(visibleCols))
+		for i, col := range visibleCols {
+			if col < 0 || col >= len(schema) {
+				return nil, fmt.Errorf("%w: %d (valid range: 0-%d)", datatable.ErrInvalidColumn, col, len(schema)-1)
+			}
+			columns[i] = col
+		}
+	} else {
+		columns = make([]int, len(schema))
+		for i := range columns {
+			columns[i] = i
 		}
-		columnTypes[i] = colType
+	}
+
+	columnNames := make([]string, len(columns))
+	columnTypes := make([]datatable.DataType, len(columns))
+	for i, col := range columns {
+		columnNames[i] = schema[col].Name
+		columnTypes[i] = schema[col].Type
 	}
 
 	// If no visible rows specified, use all rows
@@ -71,6 +95,8 @@ func NewModelIterator(
 	return &ModelIterator{
 		model:       source,
 		visibleRows: visibleRows,
+		columns:     columns,
+		projected:   len(columns) != len(schema) || !isIdentity(columns),
 		columnNames: columnNames,
 		columnTypes: columnTypes,
 		currentRow:  -1, // Start before first row
@@ -107,7 +133,19 @@ func (it *ModelIterator) Row() ([]datatable.Value, error) {
 		return nil, fmt.Errorf("failed to get row %d: %w", originalRowIdx, err)
 	}
 
-	return row, nil
+	if !it.projected {
+		return row, nil
+	}
+
+	values := make([]datatable.Value, len(it.columns))
+	for i, col := range it.columns {
+		if col >= len(row) {
+			it.err = fmt.Errorf("%w: %d", datatable.ErrInvalidColumn, col)
+			return nil, it.err
+		}
+		values[i] = row[col]
+	}
+	return values, nil
 }
 
 // RowNumber returns the current row number (0-based in the visible rows).
@@ -146,3 +184,13 @@ func (it *ModelIterator) Reset() {
 	it.currentRow = -1
 	it.err = nil
 }
+
+// isIdentity reports whether cols is 0, 1, 2, ...
+func isIdentity(cols []int) bool {
+	for i, col := range cols {
+		if col != i {
+			return false
+		}
+	}
+	return true
+}
@@ -15,9 +15,15 @@
 package export
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/magpierre/fyne-datatable/datatable"
 )
 
 // JSONConfig configures JSON export options.
@@ -27,6 +33,10 @@ type JSONConfig struct {
 
 	// Indent specifies the indentation string (used if PrettyPrint is true)
 	Indent string
+
+	// StringifyValues emits every non-null cell as its formatted string
+	// instead of a native JSON number or boolean.
+	StringifyValues bool
 }
 
 // DefaultJSONConfig returns the default JSON configuration.
@@ -59,6 +69,8 @@ func NewJSONExporterWithConfig(config JSONConfig) *JSONExporter {
 
 // Export writes data in JSON format as an array of objects.
 // Each row becomes an object with column names as keys.
+// Rows are encoded and written one at a time as the iterator produces them,
+// so memory use does not grow with the number of rows.
 func (e *JSONExporter) Export(
 	writer io.Writer,
 	iterator RowIterator,
@@ -72,6 +84,7 @@ func (e *JSONExporter) Export(
 	}
 
 	columnNames := iterator.ColumnNames()
+	columnTypes := iterator.ColumnTypes()
 	totalRows := iterator.TotalRows()
 	rowCount := 0
 
@@ -83,6 +96,14 @@ func (e *JSONExporter) Export(
 	// Track if we need to write a comma before the next object
 	needsComma := false
 
+	// Encode each object into a reused buffer. Pretty printing indents the
+	// object's fields one level deeper than the object itself.
+	var objBuf bytes.Buffer
+	encoder := json.NewEncoder(&objBuf)
+	if e.config.PrettyPrint {
+		encoder.SetIndent(e.config.Indent, e.config.Indent)
+	}
+
 	for iterator.Next() {
 		row, err := iterator.Row()
 		if err != nil {
@@ -111,35 +132,21 @@ func (e *JSONExporter) Export(
 				break
 			}
 
-			colName := columnNames[i]
-			if val.IsNull {
-				obj[colName] = nil
-			} else {
-				// Use Raw value if available, otherwise Formatted string
-				if val.Raw != nil {
-					obj[colName] = val.Raw
-				} else {
-					obj[colName] = val.Formatted
-				}
+			colType := val.Type
+			if i < len(columnTypes) {
+				colType = columnTypes[i]
 			}
+			obj[columnNames[i]] = e.jsonValue(val, colType)
 		}
 
-		// Marshal object to JSON
-		var jsonBytes []byte
-		if e.config.PrettyPrint {
-			// For pretty print, we use indentation for nested objects
-			// but not for the array wrapping (we handle that manually)
-			jsonBytes, err = json.Marshal(obj)
-		} else {
-			jsonBytes, err = json.Marshal(obj)
-		}
-
-		if err != nil {
+		// Encode object to JSON, dropping the encoder's trailing newline
+		objBuf.Reset()
+		if err := encoder.Encode(obj); err != nil {
 			return rowCount, fmt.Errorf("failed to marshal row %d: %w", rowCount, err)
 		}
 
 		// Write the JSON object
-		if _, err := writer.Write(jsonBytes); err != nil {
+		if _, err := writer.Write(bytes.TrimSuffix(objBuf.Bytes(), []byte("\n"))); err != nil {
 			return rowCount, fmt.Errorf("failed to write row %d: %w", rowCount, err)
 		}
 
@@ -185,6 +192,54 @@ func (e *JSONExporter) Export(
 	return rowCount, nil
 }
 
+// jsonValue converts a cell to the value marshalled for it.
+// Numeric columns become JSON numbers and bool columns JSON booleans; all
+// other types, and cells that cannot be converted, use the text displayed
+// for the cell by datatable.FormatValue.
+func (e *JSONExporter) jsonValue(val datatable.Value, colType datatable.DataType) any {
+	if val.IsNull {
+		return nil
+	}
+	val.EnsureFormatted()
+	val.Type = colType
+	text := datatable.FormatValue(val)
+	if e.config.StringifyValues {
+		return text
+	}
+
+	switch colType {
+	case datatable.TypeInt, datatable.TypeFloat, datatable.TypeDecimal:
+		switch raw := val.Raw.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return raw
+		case float32:
+			if !math.IsNaN(float64(raw)) && !math.IsInf(float64(raw), 0) {
+				return raw
+			}
+			return text
+		case float64:
+			if !math.IsNaN(raw) && !math.IsInf(raw, 0) {
+				return raw
+			}
+			return text
+		}
+		// Keep the formatted digits so large integers and decimals stay exact
+		formatted := strings.TrimSpace(val.Formatted)
+		if _, err := strconv.ParseFloat(formatted, 64); err == nil && json.Valid([]byte(formatted)) {
+			return json.Number(formatted)
+		}
+	case datatable.TypeBool:
+		if b, ok := val.Raw.(bool); ok {
+			return b
+		}
+		if b, err := strconv.ParseBool(strings.TrimSpace(val.Formatted)); err == nil {
+			return b
+		}
+	}
+
+	return text
+}
+
 // FileExtension returns "json".
 func (e *JSONExporter) FileExtension() string {
 	return "json"
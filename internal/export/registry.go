@@ -0,0 +1,110 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ExporterFactory creates a new exporter instance.
+type ExporterFactory func() Exporter
+
+// Names of the built-in exporters.
+const (
+	FormatCSV       = "csv"
+	FormatJSON      = "json"
+	FormatTSV       = "tsv"
+	FormatTranspose = "transpose"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]ExporterFactory)
+)
+
+func init() {
+	MustRegisterExporter(FormatCSV, func() Exporter {
+		return NewCSVExporter()
+	})
+	MustRegisterExporter(FormatJSON, func() Exporter {
+		return NewJSONExporter()
+	})
+	MustRegisterExporter(FormatTSV, func() Exporter {
+		config := DefaultCSVConfig()
+		config.Delimiter = '\t'
+		return NewCSVExporterWithConfig(config)
+	})
+	MustRegisterExporter(FormatTranspose, func() Exporter {
+		return NewTransposeExporter()
+	})
+}
+
+// RegisterExporter makes an export format available under name, so export
+// UIs can list it. factory is called for every GetExporter call.
+// Returns an error if:
+//   - The name is empty
+//   - The factory is nil
+//   - An exporter with the same name already exists
+func RegisterExporter(name string, factory ExporterFactory) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if name == "" {
+		return fmt.Errorf("exporter name cannot be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("cannot register nil factory for exporter %q", name)
+	}
+	if _, exists := registry[name]; exists {
+		return fmt.Errorf("exporter %q already registered", name)
+	}
+
+	registry[name] = factory
+	return nil
+}
+
+// MustRegisterExporter registers an exporter or panics.
+func MustRegisterExporter(name string, factory ExporterFactory) {
+	if err := RegisterExporter(name, factory); err != nil {
+		panic(err)
+	}
+}
+
+// GetExporter returns a new instance of the exporter registered under name.
+func GetExporter(name string) (Exporter, error) {
+	registryMu.RLock()
+	factory, exists := registry[name]
+	registryMu.RUnlock()
+
+	if !exists {
+		return nil, fmt.Errorf("exporter %q not found", name)
+	}
+	return factory(), nil
+}
+
+// ListExporters returns the names of all registered exporters, sorted.
+func ListExporters() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
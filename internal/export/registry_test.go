@@ -0,0 +1,160 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// upperExporter is a custom format that writes the first column in upper case.
+type upperExporter struct{}
+
+func (upperExporter) Export(writer io.Writer, iterator RowIterator, progress ProgressCallback) (int, error) {
+	count := 0
+	for iterator.Next() {
+		row, err := iterator.Row()
+		if err != nil {
+			return count, err
+		}
+		row[0].EnsureFormatted()
+		fmt.Fprintln(writer, strings.ToUpper(row[0].Formatted))
+		count++
+	}
+	return count, nil
+}
+
+func (upperExporter) FileExtension() string { return "txt" }
+func (upperExporter) MimeType() string      { return "text/plain" }
+func (upperExporter) Description() string   { return "Upper-case names" }
+
+// TestRegistry_BuiltIns tests that the built-in formats are registered
+func TestRegistry_BuiltIns(t *testing.T) {
+	names := ListExporters()
+	for _, name := range []string{FormatCSV, FormatJSON, FormatTSV} {
+		if !slices.Contains(names, name) {
+			t.Errorf("Expected %q in %v", name, names)
+		}
+	}
+	if !slices.IsSorted(names) {
+		t.Errorf("Expected sorted names, got %v", names)
+	}
+
+	tests := []struct {
+		name      string
+		extension string
+		mimeType  string
+	}{
+		{FormatCSV, "csv", "text/csv"},
+		{FormatJSON, "json", "application/json"},
+		{FormatTSV, "tsv", "text/tab-separated-values"},
+	}
+	for _, tt := range tests {
+		exporter, err := GetExporter(tt.name)
+		if err != nil {
+			t.Fatalf("GetExporter(%q) failed: %v", tt.name, err)
+		}
+		if got := exporter.FileExtension(); got != tt.extension {
+			t.Errorf("%s: FileExtension() = %q, want %q", tt.name, got, tt.extension)
+		}
+		if got := exporter.MimeType(); got != tt.mimeType {
+			t.Errorf("%s: MimeType() = %q, want %q", tt.name, got, tt.mimeType)
+		}
+	}
+}
+
+// TestRegistry_TSVExport tests that the TSV exporter writes tabs
+func TestRegistry_TSVExport(t *testing.T) {
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+	iterator, err := NewModelIterator(source, nil)
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	exporter, err := GetExporter(FormatTSV)
+	if err != nil {
+		t.Fatalf("GetExporter failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if _, err := exporter.Export(&buf, iterator, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Name\tAge\tRole") {
+		t.Errorf("Expected tab-separated headers, got: %s", buf.String())
+	}
+}
+
+// TestRegistry_Custom tests registering and using a custom exporter
+func TestRegistry_Custom(t *testing.T) {
+	const name = "test-upper"
+	t.Cleanup(func() {
+		registryMu.Lock()
+		delete(registry, name)
+		registryMu.Unlock()
+	})
+
+	if err := RegisterExporter(name, func() Exporter { return upperExporter{} }); err != nil {
+		t.Fatalf("RegisterExporter failed: %v", err)
+	}
+	if !slices.Contains(ListExporters(), name) {
+		t.Errorf("Expected %q in %v", name, ListExporters())
+	}
+
+	source, err := createTestData()
+	if err != nil {
+		t.Fatalf("Failed to create test data: %v", err)
+	}
+	iterator, err := NewModelIterator(source, nil)
+	if err != nil {
+		t.Fatalf("Failed to create iterator: %v", err)
+	}
+
+	exporter, err := GetExporter(name)
+	if err != nil {
+		t.Fatalf("GetExporter failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if _, err := NewEngine().Export(&buf, iterator, exporter, nil); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	if got, want := buf.String(), "ALICE\nBOB\nCHARLIE\n"; got != want {
+		t.Errorf("Export() = %q, want %q", got, want)
+	}
+}
+
+// TestRegistry_Errors tests invalid registrations and lookups
+func TestRegistry_Errors(t *testing.T) {
+	factory := func() Exporter { return upperExporter{} }
+
+	if err := RegisterExporter("", factory); err == nil {
+		t.Error("Expected error for empty name")
+	}
+	if err := RegisterExporter("nil-factory", nil); err == nil {
+		t.Error("Expected error for nil factory")
+	}
+	if err := RegisterExporter(FormatCSV, factory); err == nil {
+		t.Error("Expected error for duplicate name")
+	}
+	if _, err := GetExporter("no-such-format"); err == nil {
+		t.Error("Expected error for unknown exporter")
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"fmt"
+	"io"
+)
+
+// TransposeExporter exports each row as a two-column key/value table, with
+// one "Field,Value" line per column in source column order. This suits
+// single-record detail views. Multiple rows are written as repeated blocks
+// separated by a blank line.
+//
+// It uses CSVConfig for delimiter, line endings and BOM. IncludeHeaders
+// writes a "Field,Value" header line once, before the first block.
+type TransposeExporter struct {
+	config CSVConfig
+}
+
+// NewTransposeExporter creates a transpose exporter with default configuration.
+func NewTransposeExporter() *TransposeExporter {
+	return &TransposeExporter{
+		config: DefaultCSVConfig(),
+	}
+}
+
+// NewTransposeExporterWithConfig creates a transpose exporter with custom
+// configuration.
+func NewTransposeExporterWithConfig(config CSVConfig) *TransposeExporter {
+	return &TransposeExporter{
+		config: config,
+	}
+}
+
+// Export writes each row as field/value pairs.
+func (e *TransposeExporter) Export(
+	writer io.Writer,
+	iterator RowIterator,
+	progress ProgressCallback,
+) (int, error) {
+	if writer == nil {
+		return 0, fmt.Errorf("writer cannot be nil")
+	}
+	if iterator == nil {
+		return 0, fmt.Errorf("iterator cannot be nil")
+	}
+
+	csvWriter, err := e.config.newWriter(writer)
+	if err != nil {
+		return 0, err
+	}
+
+	if e.config.IncludeHeaders {
+		if err := csvWriter.Write([]string{"Field", "Value"}); err != nil {
+			return 0, fmt.Errorf("failed to write headers: %w", err)
+		}
+	}
+
+	columnNames := iterator.ColumnNames()
+	columnTypes := iterator.ColumnTypes()
+	rowCount := 0
+	totalRows := iterator.TotalRows()
+
+	for iterator.Next() {
+		row, err := iterator.Row()
+		if err != nil {
+			return rowCount, fmt.Errorf("failed to get row %d: %w", rowCount, err)
+		}
+
+		// Blank line between blocks
+		if rowCount > 0 {
+			if err := csvWriter.Write(nil); err != nil {
+				return rowCount, fmt.Errorf("failed to write row %d: %w", rowCount, err)
+			}
+		}
+
+		for i, val := range row {
+			value := cellText(val, columnTypes, i) // Empty string for null values
+			if err := csvWriter.Write([]string{columnNames[i], value}); err != nil {
+				return rowCount, fmt.Errorf("failed to write row %d: %w", rowCount, err)
+			}
+		}
+
+		rowCount++
+
+		// Report progress if callback provided
+		if progress != nil {
+			if !progress(rowCount, totalRows) {
+				// User cancelled
+				csvWriter.Flush()
+				return rowCount, fmt.Errorf("export cancelled by user")
+			}
+		}
+	}
+
+	// Check for iteration errors
+	if err := iterator.Err(); err != nil {
+		return rowCount, fmt.Errorf("iterator error: %w", err)
+	}
+
+	// Flush any buffered data
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return rowCount, fmt.Errorf("failed to flush CSV data: %w", err)
+	}
+
+	return rowCount, nil
+}
+
+// FileExtension returns "csv".
+func (e *TransposeExporter) FileExtension() string {
+	return "csv"
+}
+
+// MimeType returns the CSV MIME type.
+func (e *TransposeExporter) MimeType() string {
+	return "text/csv"
+}
+
+// Description returns a human-readable description.
+func (e *TransposeExporter) Description() string {
+	return "Field/Value Pairs (CSV)"
+}
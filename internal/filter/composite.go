@@ -54,6 +54,21 @@ type CompositeFilter struct {
 
 // Evaluate implements the Filter interface.
 func (f *CompositeFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
+	return f.combine(func(i int) (bool, error) {
+		return f.Filters[i].Evaluate(row, columnNames)
+	})
+}
+
+// EvaluateTyped implements the TypedFilter interface.
+func (f *CompositeFilter) EvaluateTyped(row []datatable.Value, columnNames []string, columnTypes []datatable.DataType) (bool, error) {
+	return f.combine(func(i int) (bool, error) {
+		return datatable.EvaluateFilter(f.Filters[i], row, columnNames, columnTypes)
+	})
+}
+
+// combine applies the filter's logic to the results of eval, which evaluates
+// the i-th child filter. Evaluation stops as soon as the result is known.
+func (f *CompositeFilter) combine(eval func(i int) (bool, error)) (bool, error) {
 	if len(f.Filters) == 0 {
 		return true, nil // Empty filter passes all rows
 	}
@@ -61,8 +76,8 @@ func (f *CompositeFilter) Evaluate(row []datatable.Value, columnNames []string)
 	switch f.Logic {
 	case LogicAND:
 		// All filters must pass
-		for _, filter := range f.Filters {
-			passes, err := filter.Evaluate(row, columnNames)
+		for i := range f.Filters {
+			passes, err := eval(i)
 			if err != nil {
 				return false, err
 			}
@@ -74,8 +89,8 @@ func (f *CompositeFilter) Evaluate(row []datatable.Value, columnNames []string)
 
 	case LogicOR:
 		// At least one filter must pass
-		for _, filter := range f.Filters {
-			passes, err := filter.Evaluate(row, columnNames)
+		for i := range f.Filters {
+			passes, err := eval(i)
 			if err != nil {
 				return false, err
 			}
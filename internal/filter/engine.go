@@ -16,8 +16,6 @@
 package filter
 
 import (
-	"fmt"
-
 	"github.com/magpierre/fyne-datatable/datatable"
 )
 
@@ -34,50 +32,17 @@ func NewEngine() *Engine {
 
 // Apply applies a single filter to a data source and returns the indices
 // of rows that pass the filter.
+// Column names are resolved once per call; use Prepare to reuse the binding
+// across calls.
 func (e *Engine) Apply(
 	source datatable.DataSource,
 	filter datatable.Filter,
 ) ([]int, error) {
-	if source == nil {
-		return nil, datatable.ErrNoDataSource
-	}
-	if filter == nil {
-		return nil, datatable.ErrInvalidFilter
-	}
-
-	rowCount := source.RowCount()
-	colCount := source.ColumnCount()
-
-	// Build column names array
-	columnNames := make([]string, colCount)
-	for i := 0; i < colCount; i++ {
-		name, err := source.ColumnName(i)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get column name: %w", err)
-		}
-		columnNames[i] = name
-	}
-
-	// Apply filter to each row
-	result := make([]int, 0, rowCount) // Pre-allocate for efficiency
-
-	for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
-		row, err := source.Row(rowIdx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get row %d: %w", rowIdx, err)
-		}
-
-		passes, err := filter.Evaluate(row, columnNames)
-		if err != nil {
-			return nil, fmt.Errorf("filter evaluation failed on row %d: %w", rowIdx, err)
-		}
-
-		if passes {
-			result = append(result, rowIdx)
-		}
+	prepared, err := Prepare(source, filter)
+	if err != nil {
+		return nil, err
 	}
-
-	return result, nil
+	return prepared.Apply(source)
 }
 
 // ApplyMultiple applies multiple filters with AND logic.
@@ -124,14 +89,9 @@ func (e *Engine) Validate(
 	// For now, just check that we can evaluate the filter on the first row
 	// More sophisticated validation could be added per filter type
 	if source.RowCount() > 0 {
-		colCount := source.ColumnCount()
-		columnNames := make([]string, colCount)
-		for i := 0; i < colCount; i++ {
-			name, err := source.ColumnName(i)
-			if err != nil {
-				return err
-			}
-			columnNames[i] = name
+		prepared, err := Prepare(source, filter)
+		if err != nil {
+			return err
 		}
 
 		row, err := source.Row(0)
@@ -139,7 +99,7 @@ func (e *Engine) Validate(
 			return err
 		}
 
-		_, err = filter.Evaluate(row, columnNames)
+		_, err = prepared.Evaluate(row)
 		return err
 	}
 
@@ -153,43 +113,9 @@ func (e *Engine) ApplyToIndices(
 	filter datatable.Filter,
 	indices []int,
 ) ([]int, error) {
-	if source == nil {
-		return nil, datatable.ErrNoDataSource
+	prepared, err := Prepare(source, filter)
+	if err != nil {
+		return nil, err
 	}
-	if filter == nil {
-		return nil, datatable.ErrInvalidFilter
-	}
-
-	colCount := source.ColumnCount()
-
-	// Build column names array
-	columnNames := make([]string, colCount)
-	for i := 0; i < colCount; i++ {
-		name, err := source.ColumnName(i)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get column name: %w", err)
-		}
-		columnNames[i] = name
-	}
-
-	// Apply filter to specified rows only
-	result := make([]int, 0, len(indices))
-
-	for _, rowIdx := range indices {
-		row, err := source.Row(rowIdx)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get row %d: %w", rowIdx, err)
-		}
-
-		passes, err := filter.Evaluate(row, columnNames)
-		if err != nil {
-			return nil, fmt.Errorf("filter evaluation failed on row %d: %w", rowIdx, err)
-		}
-
-		if passes {
-			result = append(result, rowIdx)
-		}
-	}
-
-	return result, nil
+	return prepared.ApplyToIndices(source, indices)
 }
@@ -19,85 +19,33 @@ import (
 	"testing"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
-// mockDataSource for testing
-type mockDataSource struct {
-	rows        [][]datatable.Value
-	columnNames []string
-}
-
-func newMockSource() *mockDataSource {
-	return &mockDataSource{
-		rows: [][]datatable.Value{
-			{
-				datatable.NewValue("Alice", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-				datatable.NewValue("Engineer", datatable.TypeString),
-			},
-			{
-				datatable.NewValue("Bob", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-				datatable.NewValue("Designer", datatable.TypeString),
-			},
-			{
-				datatable.NewValue("Charlie", datatable.TypeString),
-				datatable.NewValue("35", datatable.TypeInt),
-				datatable.NewValue("Manager", datatable.TypeString),
-			},
-			{
-				datatable.NewValue("Diana", datatable.TypeString),
-				datatable.NewValue("28", datatable.TypeInt),
-				datatable.NewValue("Developer", datatable.TypeString),
-			},
-		},
-		columnNames: []string{"Name", "Age", "Role"},
-	}
-}
-
-func (m *mockDataSource) RowCount() int {
-	return len(m.rows)
-}
-
-func (m *mockDataSource) ColumnCount() int {
-	return len(m.columnNames)
-}
-
-func (m *mockDataSource) ColumnName(col int) (string, error) {
-	if col < 0 || col >= len(m.columnNames) {
-		return "", datatable.ErrInvalidColumn
-	}
-	return m.columnNames[col], nil
-}
-
-func (m *mockDataSource) ColumnType(col int) (datatable.DataType, error) {
-	if col < 0 || col >= len(m.columnNames) {
-		return datatable.TypeString, datatable.ErrInvalidColumn
-	}
-	return datatable.TypeString, nil
-}
-
-func (m *mockDataSource) Cell(row, col int) (datatable.Value, error) {
-	if row < 0 || row >= len(m.rows) {
-		return datatable.Value{}, datatable.ErrInvalidRow
-	}
-	if col < 0 || col >= len(m.columnNames) {
-		return datatable.Value{}, datatable.ErrInvalidColumn
-	}
-	return m.rows[row][col], nil
-}
+// mockDataSource is the shared in-memory test source.
+type mockDataSource = datatabletest.MockSource
 
-func (m *mockDataSource) Row(row int) ([]datatable.Value, error) {
-	if row < 0 || row >= len(m.rows) {
-		return nil, datatable.ErrInvalidRow
+// newMockDataSource builds a mock source from parallel name and type slices.
+// Nil entries in data become null cells.
+func newMockDataSource(columnNames []string, columnTypes []datatable.DataType, data [][]any) *mockDataSource {
+	columns := make([]datatable.ColumnSchema, len(columnNames))
+	for i, name := range columnNames {
+		columns[i] = datatable.ColumnSchema{Name: name, Type: columnTypes[i]}
 	}
-	result := make([]datatable.Value, len(m.rows[row]))
-	copy(result, m.rows[row])
-	return result, nil
+	return datatabletest.NewMockSource(columns, data)
 }
 
-func (m *mockDataSource) Metadata() datatable.Metadata {
-	return make(datatable.Metadata)
+func newMockSource() *mockDataSource {
+	return newMockDataSource(
+		[]string{"Name", "Age", "Role"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt, datatable.TypeString},
+		[][]any{
+			{"Alice", "30", "Engineer"},
+			{"Bob", "25", "Designer"},
+			{"Charlie", "35", "Manager"},
+			{"Diana", "28", "Developer"},
+		},
+	)
 }
 
 func TestEngine_Apply_SimpleFilter(t *testing.T) {
@@ -297,3 +245,90 @@ func TestEngine_EmptyFilters(t *testing.T) {
 		t.Errorf("ApplyMultiple() with no filters got %d rows, want %d", len(got), source.RowCount())
 	}
 }
+
+// newEmployeeSource builds a subset of the employee sample data.
+func newEmployeeSource() *mockDataSource {
+	employees := [][]string{
+		{"Alice Johnson", "Software Engineer", "Engineering"},
+		{"Bob Smith", "UI/UX Designer", "Design"},
+		{"Charlie Brown", "Product Manager", "Product"},
+		{"Eve Wilson", "DevOps Engineer", "Operations"},
+		{"Frank Miller", "Marketing Specialist", "Marketing"},
+		{"Jack Thompson", "Frontend Developer", "Engineering"},
+	}
+
+	rows := make([][]any, len(employees))
+	for i, employee := range employees {
+		rows[i] = []any{employee[0], employee[1], employee[2]}
+	}
+
+	return newMockDataSource(
+		[]string{"Name", "Position", "Department"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeString, datatable.TypeString},
+		rows,
+	)
+}
+
+func TestSearchFilter_AnyColumn(t *testing.T) {
+	source := newEmployeeSource()
+	engine := NewEngine()
+
+	tests := []struct {
+		name   string
+		filter *SearchFilter
+		want   []int
+	}{
+		{
+			// Matches Position (Engineer) or Department (Engineering)
+			name:   "eng in any column",
+			filter: &SearchFilter{Term: "eng"},
+			want:   []int{0, 3, 5},
+		},
+		{
+			name:   "case insensitive",
+			filter: &SearchFilter{Term: "ENG"},
+			want:   []int{0, 3, 5},
+		},
+		{
+			name:   "restricted to department",
+			filter: &SearchFilter{Term: "eng", Columns: []int{2}},
+			want:   []int{0, 5},
+		},
+		{
+			name:   "restricted to position",
+			filter: &SearchFilter{Term: "eng", Columns: []int{1}},
+			want:   []int{0, 3},
+		},
+		{
+			name:   "no match",
+			filter: &SearchFilter{Term: "zzz"},
+			want:   []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Apply() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSearchFilter_InvalidColumn(t *testing.T) {
+	filter := &SearchFilter{Term: "x", Columns: []int{5}}
+	_, err := filter.Evaluate([]datatable.Value{datatable.NewValue("x", datatable.TypeString)}, []string{"A"})
+	if !errors.Is(err, datatable.ErrInvalidColumn) {
+		t.Errorf("Evaluate() error = %v, want ErrInvalidColumn", err)
+	}
+}
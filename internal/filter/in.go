@@ -0,0 +1,83 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// InFilter keeps rows whose cell in Column matches one of a set of values.
+// Cells are compared by their formatted text, so the values are written the
+// way the table displays them.
+type InFilter struct {
+	// Column is the name of the column to test.
+	Column string
+
+	// Values lists the accepted formatted values.
+	Values []string
+
+	// IncludeNull also accepts null cells.
+	IncludeNull bool
+
+	setOnce sync.Once
+	set     map[string]bool
+}
+
+// Evaluate implements the Filter interface.
+func (f *InFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
+	colIdx := columnIndex(columnNames, f.Column)
+	if colIdx < 0 {
+		return false, fmt.Errorf("%w: %s", datatable.ErrColumnNotFound, f.Column)
+	}
+
+	return f.evaluateAt(row, colIdx)
+}
+
+// evaluateAt evaluates the filter against the cell at colIdx.
+func (f *InFilter) evaluateAt(row []datatable.Value, colIdx int) (bool, error) {
+	if colIdx >= len(row) {
+		return false, fmt.Errorf("%w: %d", datatable.ErrInvalidColumn, colIdx)
+	}
+
+	cellValue := row[colIdx]
+	if cellValue.IsNull {
+		return f.IncludeNull, nil
+	}
+	cellValue.EnsureFormatted()
+
+	f.setOnce.Do(func() {
+		f.set = make(map[string]bool, len(f.Values))
+		for _, v := range f.Values {
+			f.set[v] = true
+		}
+	})
+	return f.set[cellValue.Formatted], nil
+}
+
+// Description implements the Filter interface.
+func (f *InFilter) Description() string {
+	values := make([]string, 0, len(f.Values)+1)
+	for _, v := range f.Values {
+		values = append(values, fmt.Sprintf("%q", v))
+	}
+	if f.IncludeNull {
+		values = append(values, "null")
+	}
+	return fmt.Sprintf("%s in (%s)", f.Column, strings.Join(values, ", "))
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestInFilter(t *testing.T) {
+	engine := NewEngine()
+	source := newMockSource()
+
+	tests := []struct {
+		name   string
+		filter *InFilter
+		want   []int
+	}{
+		{"two roles", &InFilter{Column: "Role", Values: []string{"Engineer", "Manager"}}, []int{0, 2}},
+		{"numeric column by text", &InFilter{Column: "Age", Values: []string{"25", "28"}}, []int{1, 3}},
+		{"case sensitive", &InFilter{Column: "Role", Values: []string{"engineer"}}, []int{}},
+		{"no values", &InFilter{Column: "Role"}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+
+			// The prepared path agrees with Evaluate
+			prepared, err := Prepare(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Prepare() error = %v", err)
+			}
+			row, _ := source.Row(0)
+			want, _ := tt.filter.Evaluate(row, []string{"Name", "Age", "Role"})
+			if got, _ := prepared.Evaluate(row); got != want {
+				t.Errorf("prepared Evaluate() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestInFilter_Nulls(t *testing.T) {
+	row := []datatable.Value{datatable.NewNullValue(datatable.TypeString)}
+	columns := []string{"Role"}
+
+	f := &InFilter{Column: "Role", Values: []string{""}}
+	if passes, _ := f.Evaluate(row, columns); passes {
+		t.Error("null cell matched an empty-string value")
+	}
+
+	f = &InFilter{Column: "Role", IncludeNull: true}
+	if passes, _ := f.Evaluate(row, columns); !passes {
+		t.Error("null cell rejected with IncludeNull")
+	}
+}
+
+func TestInFilter_UnknownColumn(t *testing.T) {
+	f := &InFilter{Column: "Missing", Values: []string{"x"}}
+	_, err := f.Evaluate([]datatable.Value{datatable.NewValue("x", datatable.TypeString)}, []string{"Name"})
+	if !errors.Is(err, datatable.ErrColumnNotFound) {
+		t.Errorf("Evaluate() error = %v, want ErrColumnNotFound", err)
+	}
+}
+
+func TestInFilter_Description(t *testing.T) {
+	f := &InFilter{Column: "Role", Values: []string{"Engineer", "Manager"}, IncludeNull: true}
+	want := `Role in ("Engineer", "Manager", null)`
+	if got := f.Description(); got != want {
+		t.Errorf("Description() = %q, want %q", got, want)
+	}
+}
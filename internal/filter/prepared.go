@@ -0,0 +1,209 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// rowPredicate evaluates a filter against a row of a known schema.
+type rowPredicate func(row []datatable.Value) (bool, error)
+
+// PreparedFilter is a filter bound to the column layout of a data source.
+//
+// Column names are resolved to indices once, when the filter is prepared,
+// instead of for every evaluated row. SimpleFilter, InFilter and
+// CompositeFilter are bound directly; other filters are evaluated through
+// their Evaluate method with the cached column names.
+//
+// A PreparedFilter may be reused for any source with the same columns as the
+// one it was prepared against.
+type PreparedFilter struct {
+	filter      datatable.Filter
+	columnNames []string
+	eval        rowPredicate
+}
+
+// Prepare binds filter to the columns of source.
+func Prepare(source datatable.DataSource, filter datatable.Filter) (*PreparedFilter, error) {
+	if source == nil {
+		return nil, datatable.ErrNoDataSource
+	}
+	if filter == nil {
+		return nil, datatable.ErrInvalidFilter
+	}
+
+	schema, err := datatable.SchemaOf(source)
+	if err != nil {
+		return nil, err
+	}
+	columnNames := make([]string, len(schema))
+	columnTypes := make([]datatable.DataType, len(schema))
+	for i, col := range schema {
+		columnNames[i] = col.Name
+		columnTypes[i] = col.Type
+	}
+
+	eval, err := bind(filter, columnNames, columnTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreparedFilter{
+		filter:      filter,
+		columnNames: columnNames,
+		eval:        eval,
+	}, nil
+}
+
+// Filter returns the filter that was prepared.
+func (p *PreparedFilter) Filter() datatable.Filter {
+	return p.filter
+}
+
+// Evaluate returns true if row passes the filter.
+func (p *PreparedFilter) Evaluate(row []datatable.Value) (bool, error) {
+	return p.eval(row)
+}
+
+// Apply evaluates every row of source and returns the indices of the rows
+// that pass the filter.
+func (p *PreparedFilter) Apply(source datatable.DataSource) ([]int, error) {
+	if err := p.checkSource(source); err != nil {
+		return nil, err
+	}
+
+	rowCount := source.RowCount()
+	result := make([]int, 0, rowCount) // Pre-allocate for efficiency
+
+	for rowIdx := 0; rowIdx < rowCount; rowIdx++ {
+		passes, err := p.evaluateRow(source, rowIdx)
+		if err != nil {
+			return nil, err
+		}
+		if passes {
+			result = append(result, rowIdx)
+		}
+	}
+
+	return result, nil
+}
+
+// ApplyToIndices evaluates only the rows listed in indices and returns those
+// that pass the filter, in the order given.
+func (p *PreparedFilter) ApplyToIndices(source datatable.DataSource, indices []int) ([]int, error) {
+	if err := p.checkSource(source); err != nil {
+		return nil, err
+	}
+
+	result := make([]int, 0, len(indices))
+
+	for _, rowIdx := range indices {
+		passes, err := p.evaluateRow(source, rowIdx)
+		if err != nil {
+			return nil, err
+		}
+		if passes {
+			result = append(result, rowIdx)
+		}
+	}
+
+	return result, nil
+}
+
+// checkSource verifies that source has the layout the filter was bound to.
+func (p *PreparedFilter) checkSource(source datatable.DataSource) error {
+	if source == nil {
+		return datatable.ErrNoDataSource
+	}
+	if source.ColumnCount() != len(p.columnNames) {
+		return fmt.Errorf("%w: prepared for %d columns, source has %d",
+			datatable.ErrInvalidFilter, len(p.columnNames), source.ColumnCount())
+	}
+	return nil
+}
+
+// evaluateRow fetches a row from source and evaluates it.
+func (p *PreparedFilter) evaluateRow(source datatable.DataSource, rowIdx int) (bool, error) {
+	row, err := source.Row(rowIdx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get row %d: %w", rowIdx, err)
+	}
+
+	passes, err := p.eval(row)
+	if err != nil {
+		return false, fmt.Errorf("filter evaluation failed on row %d: %w", rowIdx, err)
+	}
+	return passes, nil
+}
+
+// bind builds a predicate for filter with its column references resolved
+// against columnNames. columnTypes holds the declared type of each column.
+// Regular expressions are compiled here, once; an invalid pattern returns an
+// error wrapping ErrInvalidFilter.
+func bind(filter datatable.Filter, columnNames []string, columnTypes []datatable.DataType) (rowPredicate, error) {
+	switch f := filter.(type) {
+	case *SimpleFilter:
+		if f.Operator == OpRegex {
+			re, err := f.compiledRegex()
+			if err != nil {
+				return nil, err
+			}
+			// Bind a compiled copy; the caller's filter is left untouched
+			compiled := *f
+			compiled.regex = re
+			f = &compiled
+		}
+		colIdx := columnIndex(columnNames, f.Column)
+		if colIdx < 0 {
+			// Unknown column: let Evaluate report it per row as usual
+			break
+		}
+		colType := columnTypes[colIdx]
+		return func(row []datatable.Value) (bool, error) {
+			return f.evaluateAt(row, colIdx, colType)
+		}, nil
+
+	case *InFilter:
+		colIdx := columnIndex(columnNames, f.Column)
+		if colIdx < 0 {
+			break
+		}
+		return func(row []datatable.Value) (bool, error) {
+			return f.evaluateAt(row, colIdx)
+		}, nil
+
+	case *CompositeFilter:
+		children := make([]rowPredicate, len(f.Filters))
+		for i, child := range f.Filters {
+			eval, err := bind(child, columnNames, columnTypes)
+			if err != nil {
+				return nil, err
+			}
+			children[i] = eval
+		}
+		return func(row []datatable.Value) (bool, error) {
+			return f.combine(func(i int) (bool, error) {
+				return children[i](row)
+			})
+		}, nil
+	}
+
+	return func(row []datatable.Value) (bool, error) {
+		return datatable.EvaluateFilter(filter, row, columnNames, columnTypes)
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// newWideSource builds a source with rows x cols int cells, where cell
+// (r, c) holds r % 100.
+func newWideSource(rows, cols int) *mockDataSource {
+	columnNames := make([]string, cols)
+	for c := range columnNames {
+		columnNames[c] = fmt.Sprintf("col_%02d", c)
+	}
+
+	columnTypes := make([]datatable.DataType, cols)
+	for c := range columnTypes {
+		columnTypes[c] = datatable.TypeInt
+	}
+
+	data := make([][]any, rows)
+	for r := range data {
+		data[r] = make([]any, cols)
+		for c := range data[r] {
+			data[r][c] = int64(r % 100)
+		}
+	}
+	return newMockDataSource(columnNames, columnTypes, data)
+}
+
+// sourceRows fetches every row of source up front.
+func sourceRows(b *testing.B, source datatable.DataSource) [][]datatable.Value {
+	rows := make([][]datatable.Value, source.RowCount())
+	for r := range rows {
+		row, err := source.Row(r)
+		if err != nil {
+			b.Fatal(err)
+		}
+		rows[r] = row
+	}
+	return rows
+}
+
+// benchmarkFilter filters on columns near the end of a 20-column layout so
+// that name lookups are not trivially cheap.
+func benchmarkFilter() datatable.Filter {
+	return &CompositeFilter{
+		Filters: []datatable.Filter{
+			&SimpleFilter{Column: "col_17", Operator: OpGreaterOrEqual, Value: 25},
+			&SimpleFilter{Column: "col_18", Operator: OpLessThan, Value: 75},
+			&SimpleFilter{Column: "col_19", Operator: OpNotEqual, Value: 50},
+		},
+		Logic: LogicAND,
+	}
+}
+
+// The benchmarks below evaluate 100k pre-fetched rows so that the cost of
+// copying rows out of the source does not hide the cost of evaluation.
+
+func BenchmarkEvaluate_Unprepared_100k(b *testing.B) {
+	source := newWideSource(100000, 20)
+	columnNames, err := columnNamesOf(source)
+	if err != nil {
+		b.Fatal(err)
+	}
+	filter := benchmarkFilter()
+	rows := sourceRows(b, source)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			if _, err := filter.Evaluate(row, columnNames); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkEvaluate_Prepared_100k(b *testing.B) {
+	source := newWideSource(100000, 20)
+	prepared, err := Prepare(source, benchmarkFilter())
+	if err != nil {
+		b.Fatal(err)
+	}
+	rows := sourceRows(b, source)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			if _, err := prepared.Evaluate(row); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// columnNamesOf returns the names of all columns in source.
+func columnNamesOf(source datatable.DataSource) ([]string, error) {
+	schema, err := datatable.SchemaOf(source)
+	if err != nil {
+		return nil, err
+	}
+	columnNames := make([]string, len(schema))
+	for i, col := range schema {
+		columnNames[i] = col.Name
+	}
+	return columnNames, nil
+}
+
+// applyUnprepared evaluates filter row by row through Filter.Evaluate,
+// resolving column names on every call.
+func applyUnprepared(source datatable.DataSource, filter datatable.Filter) ([]int, error) {
+	columnNames, err := columnNamesOf(source)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]int, 0, source.RowCount())
+	for rowIdx := 0; rowIdx < source.RowCount(); rowIdx++ {
+		row, err := source.Row(rowIdx)
+		if err != nil {
+			return nil, err
+		}
+		passes, err := filter.Evaluate(row, columnNames)
+		if err != nil {
+			return nil, err
+		}
+		if passes {
+			result = append(result, rowIdx)
+		}
+	}
+	return result, nil
+}
+
+func TestPreparedFilter_MatchesApply(t *testing.T) {
+	source := newMockSource()
+	engine := NewEngine()
+
+	tests := []struct {
+		name   string
+		filter datatable.Filter
+	}{
+		{"simple", &SimpleFilter{Column: "Age", Operator: OpGreaterThan, Value: 26}},
+		{"simple regex", &SimpleFilter{Column: "Role", Operator: OpRegex, Value: "^D"}},
+		{"composite AND", &CompositeFilter{
+			Filters: []datatable.Filter{
+				&SimpleFilter{Column: "Age", Operator: OpGreaterOrEqual, Value: 28},
+				&SimpleFilter{Column: "Name", Operator: OpNotEqual, Value: "Charlie"},
+			},
+			Logic: LogicAND,
+		}},
+		{"nested OR", &CompositeFilter{
+			Filters: []datatable.Filter{
+				&SimpleFilter{Column: "Role", Operator: OpEqual, Value: "designer"},
+				&CompositeFilter{
+					Filters: []datatable.Filter{
+						&SimpleFilter{Column: "Age", Operator: OpGreaterThan, Value: 30},
+						&QueryFilter{Query: "Role = 'Manager'"},
+					},
+					Logic: LogicAND,
+				},
+			},
+			Logic: LogicOR,
+		}},
+		{"search", &SearchFilter{Term: "an"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, err := applyUnprepared(source, tt.filter)
+			if err != nil {
+				t.Fatalf("applyUnprepared() error = %v", err)
+			}
+
+			prepared, err := Prepare(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Prepare() error = %v", err)
+			}
+			got, err := prepared.Apply(source)
+			if err != nil {
+				t.Fatalf("PreparedFilter.Apply() error = %v", err)
+			}
+			fromEngine, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Engine.Apply() error = %v", err)
+			}
+
+			if !equalIndices(got, want) || !equalIndices(fromEngine, want) {
+				t.Errorf("PreparedFilter.Apply() = %v, Engine.Apply() = %v, want %v", got, fromEngine, want)
+			}
+
+			// Reuse the binding on a subset of rows
+			subset, err := prepared.ApplyToIndices(source, []int{3, 1, 0})
+			if err != nil {
+				t.Fatalf("PreparedFilter.ApplyToIndices() error = %v", err)
+			}
+			for _, idx := range subset {
+				if !containsIndex(want, idx) {
+					t.Errorf("ApplyToIndices() returned row %d not in %v", idx, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPreparedFilter_Errors(t *testing.T) {
+	source := newMockSource()
+
+	if _, err := Prepare(nil, &SearchFilter{Term: "x"}); !errors.Is(err, datatable.ErrNoDataSource) {
+		t.Errorf("Prepare(nil source) error = %v, want ErrNoDataSource", err)
+	}
+	if _, err := Prepare(source, nil); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Prepare(nil filter) error = %v, want ErrInvalidFilter", err)
+	}
+
+	// Unknown columns are still reported when rows are evaluated
+	prepared, err := Prepare(source, &SimpleFilter{Column: "Missing", Operator: OpEqual, Value: "x"})
+	if err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if _, err := prepared.Apply(source); !errors.Is(err, datatable.ErrColumnNotFound) {
+		t.Errorf("Apply() error = %v, want ErrColumnNotFound", err)
+	}
+
+	// A source with a different layout is rejected
+	prepared, err = Prepare(source, &SimpleFilter{Column: "Name", Operator: OpEqual, Value: "Alice"})
+	if err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if _, err := prepared.Apply(newDigitsSource()); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Apply(other source) error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func equalIndices(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsIndex(indices []int, idx int) bool {
+	for _, i := range indices {
+		if i == idx {
+			return true
+		}
+	}
+	return false
+}
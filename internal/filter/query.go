@@ -16,6 +16,7 @@ package filter
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/magpierre/fyne-datatable/datatable"
@@ -42,6 +43,7 @@ type expression struct {
 	columnName string
 	operator   CompareOp
 	value      string
+	regex      *regexp.Regexp // Compiled value for OpRegex
 }
 
 // Evaluate implements the Filter interface.
@@ -208,6 +210,7 @@ func parseExpression(exprStr string, columnNames []string) (expression, error) {
 		op     CompareOp
 		symbol string
 	}{
+		{OpRegex, "=~"},
 		{OpGreaterOrEqual, ">="},
 		{OpLessOrEqual, "<="},
 		{OpNotEqual, "!="},
@@ -230,6 +233,15 @@ func parseExpression(exprStr string, columnNames []string) (expression, error) {
 			expr.operator = opInfo.op
 			expr.value = value
 
+			// Compile patterns once, at parse time
+			if opInfo.op == OpRegex {
+				re, err := regexp.Compile(value)
+				if err != nil {
+					return expr, fmt.Errorf("%w: invalid pattern %q: %v", datatable.ErrInvalidFilter, value, err)
+				}
+				expr.regex = re
+			}
+
 			// Validate column exists
 			columnExists := false
 			for _, col := range columnNames {
@@ -265,6 +277,7 @@ func evaluateExpression(expr expression, row []datatable.Value, columnMap map[st
 			if cell.IsNull {
 				continue
 			}
+			cell.EnsureFormatted()
 			if strings.Contains(strings.ToLower(cell.Formatted), searchTerm) {
 				return true, nil
 			}
@@ -283,6 +296,7 @@ func evaluateExpression(expr expression, row []datatable.Value, columnMap map[st
 	}
 
 	cellValue := row[colIdx]
+	cellValue.EnsureFormatted()
 
 	// Null values don't match any comparison
 	if cellValue.IsNull {
@@ -294,6 +308,7 @@ func evaluateExpression(expr expression, row []datatable.Value, columnMap map[st
 		Column:   expr.columnName,
 		Operator: expr.operator,
 		Value:    expr.value,
+		regex:    expr.regex,
 	}
 
 	return sf.compare(cellValue, expr.value, expr.operator)
@@ -0,0 +1,71 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// SearchFilter matches rows where any of the searched columns contains
+// the term, ignoring case.
+type SearchFilter struct {
+	// Term is the substring to look for.
+	Term string
+
+	// Columns are the original column indices to search.
+	// Nil searches every column.
+	Columns []int
+}
+
+// Evaluate implements the Filter interface.
+func (f *SearchFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
+	term := strings.ToLower(f.Term)
+
+	if f.Columns == nil {
+		for _, value := range row {
+			if matchesTerm(value, term) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	for _, col := range f.Columns {
+		if col < 0 || col >= len(row) {
+			return false, fmt.Errorf("%w: %d", datatable.ErrInvalidColumn, col)
+		}
+		if matchesTerm(row[col], term) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Description implements the Filter interface.
+func (f *SearchFilter) Description() string {
+	return fmt.Sprintf("any column contains %q", f.Term)
+}
+
+// matchesTerm reports whether a non-null value contains the lowercased term.
+func matchesTerm(value datatable.Value, term string) bool {
+	if value.IsNull {
+		return false
+	}
+	value.EnsureFormatted()
+	return strings.Contains(strings.ToLower(value.Formatted), term)
+}
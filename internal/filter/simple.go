@@ -16,6 +16,7 @@ package filter
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -44,6 +45,12 @@ const (
 	OpStartsWith
 	// OpEndsWith checks if string ends with suffix.
 	OpEndsWith
+	// OpRegex checks if string matches a regular expression.
+	OpRegex
+	// OpIsNull checks if the cell is null. The filter value is ignored.
+	OpIsNull
+	// OpIsNotNull checks if the cell is not null. The filter value is ignored.
+	OpIsNotNull
 )
 
 // String returns the string representation of a CompareOp.
@@ -67,12 +74,43 @@ func (op CompareOp) String() string {
 		return "starts_with"
 	case OpEndsWith:
 		return "ends_with"
+	case OpRegex:
+		return "matches"
+	case OpIsNull:
+		return "is_null"
+	case OpIsNotNull:
+		return "is_not_null"
 	default:
 		return fmt.Sprintf("unknown(%d)", op)
 	}
 }
 
+// CompareMode selects how SimpleFilter compares cell and filter values for
+// the ordering and equality operators.
+type CompareMode int
+
+const (
+	// CompareAuto picks the comparison from the cell's column type: numeric
+	// columns compare numerically, string columns lexically, and other types
+	// numerically only when both sides parse as numbers.
+	CompareAuto CompareMode = iota
+	// CompareNumeric compares numerically whenever both sides parse as
+	// numbers, regardless of the column type.
+	CompareNumeric
+	// CompareLexical always compares the formatted strings.
+	CompareLexical
+)
+
 // SimpleFilter performs a comparison on a single column.
+//
+// Ordering and equality use the column type of the cell: on TypeInt, TypeFloat
+// and TypeDecimal columns "9" < "28", while on TypeString columns the values
+// are compared as text. Set CompareAs to override this, e.g. for numbers
+// stored in a string column.
+//
+// Null cells are handled before any comparison: they match OpIsNull, never
+// match OpIsNotNull, and never match any value comparison (including OpEqual).
+// For OpNotEqual the outcome is controlled by IncludeNullsOnNegation.
 type SimpleFilter struct {
 	// Column is the name of the column to filter on.
 	Column string
@@ -81,33 +119,71 @@ type SimpleFilter struct {
 	Operator CompareOp
 
 	// Value is the value to compare against.
+	// For OpRegex it is a regular expression pattern.
 	Value any
+
+	// IncludeNullsOnNegation makes null cells match OpNotEqual.
+	// By default nulls are excluded, as with every other comparison.
+	IncludeNullsOnNegation bool
+
+	// CompareAs overrides the comparison chosen from the column type.
+	CompareAs CompareMode
+
+	// Compiled pattern for OpRegex, set by Compile or the query parser.
+	regex *regexp.Regexp
 }
 
 // Evaluate implements the Filter interface.
 func (f *SimpleFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
-	// Find the column index
-	colIdx := -1
-	for i, name := range columnNames {
-		if name == f.Column {
-			colIdx = i
-			break
-		}
+	colIdx := columnIndex(columnNames, f.Column)
+	if colIdx < 0 {
+		return false, fmt.Errorf("%w: %s", datatable.ErrColumnNotFound, f.Column)
 	}
 
+	// Without a schema, fall back to the type carried by the cell
+	colType := datatable.TypeString
+	if colIdx < len(row) {
+		colType = row[colIdx].Type
+	}
+	return f.evaluateAt(row, colIdx, colType)
+}
+
+// EvaluateTyped implements the TypedFilter interface.
+func (f *SimpleFilter) EvaluateTyped(row []datatable.Value, columnNames []string, columnTypes []datatable.DataType) (bool, error) {
+	colIdx := columnIndex(columnNames, f.Column)
 	if colIdx < 0 {
 		return false, fmt.Errorf("%w: %s", datatable.ErrColumnNotFound, f.Column)
 	}
+	if colIdx >= len(columnTypes) {
+		return f.Evaluate(row, columnNames)
+	}
 
+	return f.evaluateAt(row, colIdx, columnTypes[colIdx])
+}
+
+// evaluateAt evaluates the filter against the cell at colIdx, comparing as
+// colType, the declared type of the column.
+func (f *SimpleFilter) evaluateAt(row []datatable.Value, colIdx int, colType datatable.DataType) (bool, error) {
 	if colIdx >= len(row) {
 		return false, fmt.Errorf("%w: %d", datatable.ErrInvalidColumn, colIdx)
 	}
 
 	cellValue := row[colIdx]
+	cellValue.EnsureFormatted()
+	cellValue.Type = colType
 
 	// Handle null values
+	switch f.Operator {
+	case OpIsNull:
+		return cellValue.IsNull, nil
+	case OpIsNotNull:
+		return !cellValue.IsNull, nil
+	}
 	if cellValue.IsNull {
-		return false, nil // Nulls don't match any comparison
+		if f.Operator == OpNotEqual {
+			return f.IncludeNullsOnNegation, nil
+		}
+		return false, nil
 	}
 
 	// Perform comparison based on operator
@@ -116,6 +192,9 @@ func (f *SimpleFilter) Evaluate(row []datatable.Value, columnNames []string) (bo
 
 // Description implements the Filter interface.
 func (f *SimpleFilter) Description() string {
+	if f.Operator == OpIsNull || f.Operator == OpIsNotNull {
+		return fmt.Sprintf("%s %s", f.Column, f.Operator)
+	}
 	return fmt.Sprintf("%s %s %v", f.Column, f.Operator, f.Value)
 }
 
@@ -136,12 +215,23 @@ func (f *SimpleFilter) compare(cellValue datatable.Value, filterValue any, op Co
 		}
 	}
 
+	// Handle regular expression matching
+	if op == OpRegex {
+		re, err := f.compiledRegex()
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(cellValue.Formatted), nil
+	}
+
 	// For numeric comparisons, try to parse as numbers
-	cellNum, cellIsNum := parseNumber(cellValue.Formatted)
-	filterNum, filterIsNum := parseNumber(fmt.Sprintf("%v", filterValue))
+	if f.numericCompare(cellValue.Type) {
+		cellNum, cellIsNum := numberFromValue(cellValue)
+		filterNum, filterIsNum := numberFromAny(filterValue)
 
-	if cellIsNum && filterIsNum {
-		return compareNumbers(cellNum, filterNum, op)
+		if cellIsNum && filterIsNum {
+			return compareNumbers(cellNum, filterNum, op)
+		}
 	}
 
 	// Fall back to string comparison
@@ -166,6 +256,108 @@ func (f *SimpleFilter) compare(cellValue datatable.Value, filterValue any, op Co
 	}
 }
 
+// columnIndex returns the index of name in columnNames, or -1.
+func columnIndex(columnNames []string, name string) int {
+	for i, n := range columnNames {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Compile compiles the pattern of an OpRegex filter so that evaluating it
+// does not compile it again. Call it when building the filter, before the
+// filter is shared: evaluation never modifies the filter, so it is then safe
+// to evaluate from several goroutines. Returns an error wrapping
+// ErrInvalidFilter if the pattern is invalid; other operators are ignored.
+func (f *SimpleFilter) Compile() error {
+	if f.Operator != OpRegex {
+		return nil
+	}
+	re, err := f.compiledRegex()
+	if err != nil {
+		return err
+	}
+	f.regex = re
+	return nil
+}
+
+// compiledRegex returns the filter's pattern compiled, reusing the
+// expression set by Compile while the pattern is unchanged.
+func (f *SimpleFilter) compiledRegex() (*regexp.Regexp, error) {
+	pattern := fmt.Sprintf("%v", f.Value)
+	if f.regex != nil && f.regex.String() == pattern {
+		return f.regex, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid pattern %q: %v", datatable.ErrInvalidFilter, pattern, err)
+	}
+	return re, nil
+}
+
+// numericCompare reports whether cells of the given type should be compared
+// numerically.
+func (f *SimpleFilter) numericCompare(t datatable.DataType) bool {
+	switch f.CompareAs {
+	case CompareNumeric:
+		return true
+	case CompareLexical:
+		return false
+	}
+	return t != datatable.TypeString
+}
+
+// numberFromValue returns the numeric value of a cell, preferring Raw over
+// parsing Formatted.
+func numberFromValue(v datatable.Value) (float64, bool) {
+	if n, ok := numberFromRaw(v.Raw); ok {
+		return n, true
+	}
+	return parseNumber(v.Formatted)
+}
+
+// numberFromAny converts a filter value to a number.
+func numberFromAny(v any) (float64, bool) {
+	if n, ok := numberFromRaw(v); ok {
+		return n, true
+	}
+	return parseNumber(fmt.Sprintf("%v", v))
+}
+
+// numberFromRaw converts Go numeric types to float64.
+func numberFromRaw(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
 // parseNumber attempts to parse a string as a float64.
 func parseNumber(s string) (float64, bool) {
 	s = strings.TrimSpace(s)
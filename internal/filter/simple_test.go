@@ -0,0 +1,291 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestSimpleFilter_Operators(t *testing.T) {
+	engine := NewEngine()
+	source := newMockSource()
+
+	tests := []struct {
+		name   string
+		filter *SimpleFilter
+		want   []int
+	}{
+		// Numeric column
+		{"Age >= 30", &SimpleFilter{Column: "Age", Operator: OpGreaterOrEqual, Value: "30"}, []int{0, 2}},
+		{"Age <= 28", &SimpleFilter{Column: "Age", Operator: OpLessOrEqual, Value: 28}, []int{1, 3}},
+		{"Age != 30", &SimpleFilter{Column: "Age", Operator: OpNotEqual, Value: "30.0"}, []int{1, 2, 3}},
+		{"Age matches ^3", &SimpleFilter{Column: "Age", Operator: OpRegex, Value: "^3"}, []int{0, 2}},
+
+		// String column
+		{"Name >= Charlie", &SimpleFilter{Column: "Name", Operator: OpGreaterOrEqual, Value: "Charlie"}, []int{2, 3}},
+		{"Name <= Bob", &SimpleFilter{Column: "Name", Operator: OpLessOrEqual, Value: "Bob"}, []int{0, 1}},
+		{"Role != engineer", &SimpleFilter{Column: "Role", Operator: OpNotEqual, Value: "engineer"}, []int{1, 2, 3}},
+		{"Role matches ^(Des|Dev)", &SimpleFilter{Column: "Role", Operator: OpRegex, Value: "^(Des|Dev)"}, []int{1, 3}},
+		{"Role matches case-insensitive", &SimpleFilter{Column: "Role", Operator: OpRegex, Value: "(?i)^manager$"}, []int{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Apply() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSimpleFilter_InvalidRegex(t *testing.T) {
+	filter := &SimpleFilter{Column: "Name", Operator: OpRegex, Value: "("}
+	_, err := filter.Evaluate([]datatable.Value{datatable.NewValue("x", datatable.TypeString)}, []string{"Name"})
+	if !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Evaluate() error = %v, want ErrInvalidFilter", err)
+	}
+
+	// Building and preparing the filter report the pattern up front
+	if err := filter.Compile(); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Compile() error = %v, want ErrInvalidFilter", err)
+	}
+	if _, err := Prepare(newMockSource(), filter); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Prepare() error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestSimpleFilter_RegexConcurrent(t *testing.T) {
+	source := newMockSource()
+	filters := []*SimpleFilter{
+		{Column: "Role", Operator: OpRegex, Value: "^(Des|Dev)"},
+		{Column: "Role", Operator: OpRegex, Value: "^(Des|Dev)"},
+	}
+	if err := filters[1].Compile(); err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	columnNames := []string{"Name", "Age", "Role"}
+
+	// Shared filters are evaluated from several goroutines at once
+	var wg sync.WaitGroup
+	for _, f := range filters {
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for row := 0; row < source.RowCount(); row++ {
+					values, _ := source.Row(row)
+					if _, err := f.Evaluate(values, columnNames); err != nil {
+						t.Errorf("Evaluate() error = %v", err)
+					}
+				}
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestSimpleFilter_Description(t *testing.T) {
+	tests := []struct {
+		filter *SimpleFilter
+		want   string
+	}{
+		{&SimpleFilter{Column: "Age", Operator: OpGreaterOrEqual, Value: 30}, "Age >= 30"},
+		{&SimpleFilter{Column: "Age", Operator: OpLessOrEqual, Value: 30}, "Age <= 30"},
+		{&SimpleFilter{Column: "Role", Operator: OpNotEqual, Value: "Manager"}, "Role != Manager"},
+		{&SimpleFilter{Column: "Role", Operator: OpRegex, Value: "^Dev"}, "Role matches ^Dev"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Description(); got != tt.want {
+			t.Errorf("Description() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestQueryFilter_RegexOperator(t *testing.T) {
+	engine := NewEngine()
+	source := newMockSource()
+
+	got, err := engine.Apply(source, &QueryFilter{Query: "Role =~ '^D' AND Age >= 28"})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	if len(got) != 1 || got[0] != 3 {
+		t.Errorf("Apply() = %v, want [3]", got)
+	}
+
+	_, err = engine.Apply(source, &QueryFilter{Query: "Role =~ '('"})
+	if !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Apply() error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+// newNullableSource has a Status column where rows 1 and 3 are null.
+func newNullableSource() *mockDataSource {
+	return newMockDataSource(
+		[]string{"Name", "Status"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeString},
+		[][]any{
+			{"Alice", "active"},
+			{"Bob", nil},
+			{"Charlie", "inactive"},
+			{"Diana", nil},
+		},
+	)
+}
+
+func TestSimpleFilter_NullSemantics(t *testing.T) {
+	engine := NewEngine()
+	source := newNullableSource()
+
+	tests := []struct {
+		name   string
+		filter *SimpleFilter
+		want   []int
+	}{
+		{"equal excludes nulls", &SimpleFilter{Column: "Status", Operator: OpEqual, Value: "active"}, []int{0}},
+		{"not equal excludes nulls by default", &SimpleFilter{Column: "Status", Operator: OpNotEqual, Value: "active"}, []int{2}},
+		{"not equal includes nulls when asked", &SimpleFilter{Column: "Status", Operator: OpNotEqual, Value: "active", IncludeNullsOnNegation: true}, []int{1, 2, 3}},
+		{"greater than excludes nulls", &SimpleFilter{Column: "Status", Operator: OpGreaterThan, Value: ""}, []int{0, 2}},
+		{"less or equal excludes nulls", &SimpleFilter{Column: "Status", Operator: OpLessOrEqual, Value: "z"}, []int{0, 2}},
+		{"contains excludes nulls", &SimpleFilter{Column: "Status", Operator: OpContains, Value: ""}, []int{0, 2}},
+		{"regex excludes nulls", &SimpleFilter{Column: "Status", Operator: OpRegex, Value: ".*"}, []int{0, 2}},
+		{"flag only affects not equal", &SimpleFilter{Column: "Status", Operator: OpEqual, Value: "active", IncludeNullsOnNegation: true}, []int{0}},
+		{"is null", &SimpleFilter{Column: "Status", Operator: OpIsNull}, []int{1, 3}},
+		{"is not null", &SimpleFilter{Column: "Status", Operator: OpIsNotNull}, []int{0, 2}},
+		{"is null ignores value", &SimpleFilter{Column: "Status", Operator: OpIsNull, Value: "active"}, []int{1, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Apply() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSimpleFilter_NullDescription(t *testing.T) {
+	if got := (&SimpleFilter{Column: "Status", Operator: OpIsNull}).Description(); got != "Status is_null" {
+		t.Errorf("Description() = %q, want %q", got, "Status is_null")
+	}
+	if got := (&SimpleFilter{Column: "Status", Operator: OpIsNotNull}).Description(); got != "Status is_not_null" {
+		t.Errorf("Description() = %q, want %q", got, "Status is_not_null")
+	}
+}
+
+func TestSimpleFilter_RawOnlyValues(t *testing.T) {
+	// Values built as literals, without Formatted
+	row := []datatable.Value{
+		{Raw: int64(25), Type: datatable.TypeInt},
+		{Raw: true, Type: datatable.TypeBool},
+	}
+	columnNames := []string{"age", "active"}
+
+	tests := []struct {
+		filter *SimpleFilter
+		want   bool
+	}{
+		{&SimpleFilter{Column: "age", Operator: OpGreaterThan, Value: 18}, true},
+		{&SimpleFilter{Column: "age", Operator: OpEqual, Value: "25"}, true},
+		{&SimpleFilter{Column: "age", Operator: OpLessThan, Value: 18}, false},
+		{&SimpleFilter{Column: "active", Operator: OpEqual, Value: "true"}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.filter.Evaluate(row, columnNames)
+		if err != nil {
+			t.Fatalf("Evaluate(%s) error = %v", tt.filter.Description(), err)
+		}
+		if got != tt.want {
+			t.Errorf("Evaluate(%s) = %v, want %v", tt.filter.Description(), got, tt.want)
+		}
+	}
+}
+
+// newDigitsSource has the same digits stored in an int and a string column.
+func newDigitsSource() *mockDataSource {
+	digits := []string{"9", "28", "100", "3"}
+	rows := make([][]any, len(digits))
+	for i, d := range digits {
+		rows[i] = []any{d, d}
+	}
+	return newMockDataSource(
+		[]string{"Count", "Code"},
+		[]datatable.DataType{datatable.TypeInt, datatable.TypeString},
+		rows,
+	)
+}
+
+func TestSimpleFilter_CompareByColumnType(t *testing.T) {
+	engine := NewEngine()
+	source := newDigitsSource()
+
+	tests := []struct {
+		name   string
+		filter *SimpleFilter
+		want   []int
+	}{
+		{"int column >", &SimpleFilter{Column: "Count", Operator: OpGreaterThan, Value: "28"}, []int{2}},
+		{"int column <", &SimpleFilter{Column: "Count", Operator: OpLessThan, Value: "28"}, []int{0, 3}},
+		{"int column < numeric value", &SimpleFilter{Column: "Count", Operator: OpLessThan, Value: 10}, []int{0, 3}},
+		{"string column >", &SimpleFilter{Column: "Code", Operator: OpGreaterThan, Value: "28"}, []int{0, 3}},
+		{"string column <", &SimpleFilter{Column: "Code", Operator: OpLessThan, Value: "28"}, []int{2}},
+		{"string column as numeric", &SimpleFilter{Column: "Code", Operator: OpGreaterThan, Value: "28", CompareAs: CompareNumeric}, []int{2}},
+		{"int column as lexical", &SimpleFilter{Column: "Count", Operator: OpGreaterThan, Value: "28", CompareAs: CompareLexical}, []int{0, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Apply(source, tt.filter)
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Apply() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Apply() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
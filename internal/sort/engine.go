@@ -17,12 +17,15 @@ package sort
 
 import (
 	"fmt"
+	"math/big"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
 )
 
 // Engine sorts data indices based on column values.
@@ -46,6 +49,36 @@ type SortSpec struct {
 
 	// DataType helps with type-aware sorting.
 	DataType datatable.DataType
+
+	// Locale is a BCP 47 language tag (e.g. "sv", "de-DE") used to collate
+	// string columns, so accented characters order as that language expects.
+	// Comparison stays case-insensitive. Empty uses plain case-insensitive
+	// byte order.
+	Locale string
+
+	// AutoDetectType sorts a TypeString column by the type its values look
+	// like, for sources that report untyped data as strings. When every
+	// sampled value parses as a number the column sorts numerically, as a
+	// date or timestamp chronologically, and as a boolean with false first.
+	// Columns of any other type are unaffected.
+	AutoDetectType bool
+}
+
+// autoDetectSampleSize is the number of non-null values AutoDetectType
+// samples from the rows being sorted.
+const autoDetectSampleSize = 100
+
+// collator returns a collator for the spec's locale, or nil when no locale
+// is set.
+func (s SortSpec) collator() (*collate.Collator, error) {
+	if s.Locale == "" {
+		return nil, nil
+	}
+	tag, err := language.Parse(s.Locale)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sort locale %q: %w", s.Locale, err)
+	}
+	return collate.New(tag, collate.IgnoreCase), nil
 }
 
 // Sort sorts row indices based on the values in a specified column.
@@ -80,6 +113,19 @@ func (e *Engine) Sort(
 	if err != nil {
 		colType = spec.DataType // Fall back to provided type
 	}
+	if spec.AutoDetectType {
+		colType = detectType(source, result, spec.Column, colType)
+	}
+
+	coll, err := spec.collator()
+	if err != nil {
+		return nil, err
+	}
+
+	// Integer columns exposed as typed slices skip per-cell Value access
+	if sortInt64Column(source, result, spec, colType) {
+		return result, nil
+	}
 
 	// Perform stable sort
 	sort.SliceStable(result, func(i, j int) bool {
@@ -96,7 +142,7 @@ func (e *Engine) Sort(
 		}
 
 		// Compare values
-		cmp := compareValues(cellI, cellJ, colType)
+		cmp := compareValuesCollated(cellI, cellJ, colType, coll)
 
 		// Apply direction
 		if spec.Direction == datatable.SortAscending {
@@ -137,14 +183,24 @@ func (e *Engine) MultiSort(
 	result := make([]int, len(indices))
 	copy(result, indices)
 
-	// Get column types
+	// Get column types and collators
 	colTypes := make([]datatable.DataType, len(specs))
+	collators := make([]*collate.Collator, len(specs))
 	for i, spec := range specs {
 		colType, err := source.ColumnType(spec.Column)
 		if err != nil {
 			colType = spec.DataType // Fall back to provided type
 		}
+		if spec.AutoDetectType {
+			colType = detectType(source, result, spec.Column, colType)
+		}
 		colTypes[i] = colType
+
+		coll, err := spec.collator()
+		if err != nil {
+			return nil, fmt.Errorf("spec %d: %w", i, err)
+		}
+		collators[i] = coll
 	}
 
 	// Perform stable sort with multiple columns
@@ -164,7 +220,7 @@ func (e *Engine) MultiSort(
 			}
 
 			// Compare values
-			cmp := compareValues(cellI, cellJ, colTypes[specIdx])
+			cmp := compareValuesCollated(cellI, cellJ, colTypes[specIdx], collators[specIdx])
 
 			if cmp != 0 {
 				// Values differ - apply direction and return
@@ -184,9 +240,133 @@ func (e *Engine) MultiSort(
 	return result, nil
 }
 
+// detectType returns the type the values of a TypeString column look like,
+// sampling up to autoDetectSampleSize non-null values from the rows in
+// indices: TypeFloat if all parse as numbers, TypeTimestamp if all parse as
+// dates or timestamps, TypeBool if all parse as booleans. Other column
+// types, and columns without sampled values, keep colType.
+func detectType(source datatable.DataSource, indices []int, col int, colType datatable.DataType) datatable.DataType {
+	if colType != datatable.TypeString {
+		return colType
+	}
+
+	isNumber, isDateTime, isBool := true, true, true
+	sampled := 0
+	for _, row := range indices {
+		if sampled == autoDetectSampleSize || !(isNumber || isDateTime || isBool) {
+			break
+		}
+		cell, err := source.Cell(row, col)
+		if err != nil || cell.IsNull {
+			continue
+		}
+		cell.EnsureFormatted()
+		text := strings.TrimSpace(cell.Formatted)
+		if text == "" {
+			continue
+		}
+		sampled++
+
+		if isNumber {
+			_, err := strconv.ParseFloat(text, 64)
+			isNumber = err == nil
+		}
+		if isDateTime {
+			_, isDateTime = parseDateTime(text)
+		}
+		if isBool {
+			_, err := strconv.ParseBool(text)
+			isBool = err == nil
+		}
+	}
+
+	switch {
+	case sampled == 0:
+		return colType
+	case isNumber:
+		return datatable.TypeFloat
+	case isDateTime:
+		return datatable.TypeTimestamp
+	case isBool:
+		return datatable.TypeBool
+	}
+	return colType
+}
+
+// sortInt64Column sorts indices in place using the typed values of an integer
+// column when source implements datatable.Int64ColumnSource. The ordering,
+// including the placement of nulls, matches compareValues. It reports false
+// and leaves indices untouched when the fast path does not apply.
+func sortInt64Column(
+	source datatable.DataSource,
+	indices []int,
+	spec SortSpec,
+	colType datatable.DataType,
+) bool {
+	if colType != datatable.TypeInt {
+		return false
+	}
+	typed, ok := source.(datatable.Int64ColumnSource)
+	if !ok {
+		return false
+	}
+	values, valid, ok := typed.Int64Values(spec.Column)
+	if !ok {
+		return false
+	}
+
+	// Out-of-range rows need the generic path's error ordering
+	for _, row := range indices {
+		if row < 0 || row >= len(values) {
+			return false
+		}
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		cmp := compareInt64At(values, valid, indices[i], indices[j])
+		if spec.Direction == datatable.SortAscending {
+			return cmp < 0
+		}
+		return cmp > 0 // Descending
+	})
+	return true
+}
+
+// compareInt64At compares rows a and b of an integer column.
+// Returns: -1 if a < b, 0 if a == b, 1 if a > b
+func compareInt64At(values []int64, valid []bool, a, b int) int {
+	// Null handling - nulls sort to end, as in compareValues
+	if valid != nil {
+		aNull, bNull := !valid[a], !valid[b]
+		if aNull && bNull {
+			return 0
+		}
+		if aNull {
+			return 1
+		}
+		if bNull {
+			return -1
+		}
+	}
+
+	switch {
+	case values[a] < values[b]:
+		return -1
+	case values[a] > values[b]:
+		return 1
+	}
+	return 0
+}
+
 // compareValues compares two Value objects based on their data type.
 // Returns: -1 if a < b, 0 if a == b, 1 if a > b
 func compareValues(a, b datatable.Value, dataType datatable.DataType) int {
+	return compareValuesCollated(a, b, dataType, nil)
+}
+
+// compareValuesCollated is compareValues with string values ordered by coll.
+// A nil coll uses case-insensitive byte order.
+func compareValuesCollated(a, b datatable.Value, dataType datatable.DataType, coll *collate.Collator) int {
 	// Null handling - nulls sort to end
 	if a.IsNull && b.IsNull {
 		return 0
@@ -198,18 +378,34 @@ func compareValues(a, b datatable.Value, dataType datatable.DataType) int {
 		return -1
 	}
 
+	// Values built without NewValue may lack Formatted
+	a.EnsureFormatted()
+	b.EnsureFormatted()
+
 	// Type-aware comparison
 	switch dataType {
-	case datatable.TypeInt, datatable.TypeFloat, datatable.TypeDecimal:
+	case datatable.TypeInt:
+		return compareInteger(a.Formatted, b.Formatted)
+
+	case datatable.TypeFloat:
 		return compareNumeric(a.Formatted, b.Formatted)
 
+	case datatable.TypeDecimal:
+		return compareDecimal(a.Formatted, b.Formatted)
+
 	case datatable.TypeDate, datatable.TypeTimestamp:
-		return compareDateTime(a.Formatted, b.Formatted)
+		return compareDateTime(a, b)
 
 	case datatable.TypeBool:
 		return compareBool(a.Formatted, b.Formatted)
 
+	case datatable.TypeDuration:
+		return compareDuration(a, b)
+
 	default:
+		if coll != nil {
+			return coll.CompareString(a.Formatted, b.Formatted)
+		}
 		// String comparison (case-insensitive)
 		return compareString(a.Formatted, b.Formatted)
 	}
@@ -234,50 +430,103 @@ func compareNumeric(a, b string) int {
 	return 0
 }
 
-// compareDateTime compares two values as dates/timestamps.
-func compareDateTime(a, b string) int {
-	// Try multiple common date formats
-	formats := []string{
-		time.RFC3339,
-		time.RFC3339Nano,
-		"2006-01-02 15:04:05.999999999 -0700 MST",
-		"2006-01-02 15:04:05",
-		"2006-01-02",
-		time.RFC1123,
-		time.RFC822,
-	}
-
-	var aTime, bTime time.Time
-	var aErr, bErr error
-
-	for _, format := range formats {
-		aTime, aErr = time.Parse(format, a)
-		if aErr == nil {
-			break
-		}
+// compareInteger compares two values as arbitrary-size integers, so values
+// outside the int64 range (e.g. large uint64) still order exactly.
+func compareInteger(a, b string) int {
+	aNum, aOk := new(big.Int).SetString(strings.TrimSpace(a), 10)
+	bNum, bOk := new(big.Int).SetString(strings.TrimSpace(b), 10)
+
+	// If parsing fails, fall back to numeric comparison
+	if !aOk || !bOk {
+		return compareNumeric(a, b)
 	}
 
-	for _, format := range formats {
-		bTime, bErr = time.Parse(format, b)
-		if bErr == nil {
-			break
+	return aNum.Cmp(bNum)
+}
+
+// compareDecimal compares two values as exact decimals, so values that differ
+// beyond float64 precision still order correctly.
+func compareDecimal(a, b string) int {
+	aNum, aOk := new(big.Rat).SetString(strings.TrimSpace(a))
+	bNum, bOk := new(big.Rat).SetString(strings.TrimSpace(b))
+
+	// If parsing fails, fall back to numeric comparison
+	if !aOk || !bOk {
+		return compareNumeric(a, b)
+	}
+
+	return aNum.Cmp(bNum)
+}
+
+// dateTimeFormats lists the layouts tried when parsing dates and timestamps.
+var dateTimeFormats = []string{
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05 MST", // Arrow timestamps outside UTC
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123,
+	time.RFC822,
+}
+
+// parseDateTime parses s with the first matching layout of dateTimeFormats.
+func parseDateTime(s string) (time.Time, bool) {
+	for _, format := range dateTimeFormats {
+		if t, err := time.Parse(format, s); err == nil {
+			return t, true
 		}
 	}
+	return time.Time{}, false
+}
+
+// compareDateTime compares two values as dates/timestamps, using Raw when
+// both hold a time.Time and parsing Formatted otherwise.
+func compareDateTime(a, b datatable.Value) int {
+	aTime, aOk := a.Raw.(time.Time)
+	bTime, bOk := b.Raw.(time.Time)
+	if !aOk || !bOk {
+		aTime, aOk = parseDateTime(a.Formatted)
+		bTime, bOk = parseDateTime(b.Formatted)
+	}
 
 	// If parsing fails, fall back to string comparison
-	if aErr != nil || bErr != nil {
-		return compareString(a, b)
+	if !aOk || !bOk {
+		return compareString(a.Formatted, b.Formatted)
+	}
+
+	return aTime.Compare(bTime)
+}
+
+// compareDuration compares two values as durations, using Raw when it holds
+// a time.Duration and parsing Formatted otherwise.
+func compareDuration(a, b datatable.Value) int {
+	aDur, aOK := durationFromValue(a)
+	bDur, bOK := durationFromValue(b)
+
+	// If parsing fails, fall back to string comparison
+	if !aOK || !bOK {
+		return compareString(a.Formatted, b.Formatted)
 	}
 
-	if aTime.Before(bTime) {
+	if aDur < bDur {
 		return -1
 	}
-	if aTime.After(bTime) {
+	if aDur > bDur {
 		return 1
 	}
 	return 0
 }
 
+// durationFromValue reads a value as time.Duration.
+func durationFromValue(v datatable.Value) (time.Duration, bool) {
+	if d, ok := v.Raw.(time.Duration); ok {
+		return d, true
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(v.Formatted))
+	return d, err == nil
+}
+
 // compareBool compares two boolean values.
 func compareBool(a, b string) int {
 	aBool, aErr := strconv.ParseBool(strings.TrimSpace(a))
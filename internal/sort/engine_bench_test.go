@@ -0,0 +1,60 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sort
+
+import (
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// benchmarkInt64Sort sorts a 200k-row Arrow Int64 column with a sprinkling
+// of nulls, either through the typed fast path or the generic Cell path.
+func benchmarkInt64Sort(b *testing.B, generic bool) {
+	const rows = 200000
+	values := make([]int64, rows)
+	valid := make([]bool, rows)
+	for i := range values {
+		values[i] = int64((i * 7919) % 100003)
+		valid[i] = i%97 != 0
+	}
+
+	var source datatable.DataSource = newInt64ArrowSource(b, values, valid)
+	if generic {
+		source = genericSource{source}
+	}
+
+	indices := make([]int, rows)
+	for i := range indices {
+		indices[i] = i
+	}
+	spec := SortSpec{Column: 0, Direction: datatable.SortAscending}
+	engine := NewEngine()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := engine.Sort(source, indices, spec); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSort_ArrowInt64_Generic(b *testing.B) {
+	benchmarkInt64Sort(b, true)
+}
+
+func BenchmarkSort_ArrowInt64_Fast(b *testing.B) {
+	benchmarkInt64Sort(b, false)
+}
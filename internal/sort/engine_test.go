@@ -17,98 +17,41 @@ package sort
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
 )
 
-// mockDataSource for testing
-type mockDataSource struct {
-	rows        [][]datatable.Value
-	columnNames []string
-	columnTypes []datatable.DataType
+// mockDataSource is the shared in-memory test source.
+type mockDataSource = datatabletest.MockSource
+
+// newMockDataSource builds a mock source from parallel name and type slices.
+// Nil entries in data become null cells.
+func newMockDataSource(columnNames []string, columnTypes []datatable.DataType, data [][]any) *mockDataSource {
+	columns := make([]datatable.ColumnSchema, len(columnNames))
+	for i, name := range columnNames {
+		columns[i] = datatable.ColumnSchema{Name: name, Type: columnTypes[i]}
+	}
+	return datatabletest.NewMockSource(columns, data)
 }
 
 func newMockSource() *mockDataSource {
-	return &mockDataSource{
-		rows: [][]datatable.Value{
-			{
-				datatable.NewValue("Alice", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-				datatable.NewValue("Engineer", datatable.TypeString),
-				datatable.NewValue("2024-01-15", datatable.TypeDate),
-			},
-			{
-				datatable.NewValue("Bob", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-				datatable.NewValue("Designer", datatable.TypeString),
-				datatable.NewValue("2024-03-20", datatable.TypeDate),
-			},
-			{
-				datatable.NewValue("Charlie", datatable.TypeString),
-				datatable.NewValue("35", datatable.TypeInt),
-				datatable.NewValue("Manager", datatable.TypeString),
-				datatable.NewValue("2024-02-10", datatable.TypeDate),
-			},
-			{
-				datatable.NewValue("Diana", datatable.TypeString),
-				datatable.NewValue("28", datatable.TypeInt),
-				datatable.NewValue("Developer", datatable.TypeString),
-				datatable.NewValue("2024-01-05", datatable.TypeDate),
-			},
-		},
-		columnNames: []string{"Name", "Age", "Role", "JoinDate"},
-		columnTypes: []datatable.DataType{
+	return newMockDataSource(
+		[]string{"Name", "Age", "Role", "JoinDate"},
+		[]datatable.DataType{
 			datatable.TypeString,
 			datatable.TypeInt,
 			datatable.TypeString,
 			datatable.TypeDate,
 		},
-	}
-}
-
-func (m *mockDataSource) RowCount() int {
-	return len(m.rows)
-}
-
-func (m *mockDataSource) ColumnCount() int {
-	return len(m.columnNames)
-}
-
-func (m *mockDataSource) ColumnName(col int) (string, error) {
-	if col < 0 || col >= len(m.columnNames) {
-		return "", datatable.ErrInvalidColumn
-	}
-	return m.columnNames[col], nil
-}
-
-func (m *mockDataSource) ColumnType(col int) (datatable.DataType, error) {
-	if col < 0 || col >= len(m.columnTypes) {
-		return datatable.TypeString, datatable.ErrInvalidColumn
-	}
-	return m.columnTypes[col], nil
-}
-
-func (m *mockDataSource) Cell(row, col int) (datatable.Value, error) {
-	if row < 0 || row >= len(m.rows) {
-		return datatable.Value{}, datatable.ErrInvalidRow
-	}
-	if col < 0 || col >= len(m.columnNames) {
-		return datatable.Value{}, datatable.ErrInvalidColumn
-	}
-	return m.rows[row][col], nil
-}
-
-func (m *mockDataSource) Row(row int) ([]datatable.Value, error) {
-	if row < 0 || row >= len(m.rows) {
-		return nil, datatable.ErrInvalidRow
-	}
-	result := make([]datatable.Value, len(m.rows[row]))
-	copy(result, m.rows[row])
-	return result, nil
-}
-
-func (m *mockDataSource) Metadata() datatable.Metadata {
-	return make(datatable.Metadata)
+		[][]any{
+			{"Alice", "30", "Engineer", "2024-01-15"},
+			{"Bob", "25", "Designer", "2024-03-20"},
+			{"Charlie", "35", "Manager", "2024-02-10"},
+			{"Diana", "28", "Developer", "2024-01-05"},
+		},
+	)
 }
 
 // TestEngine_Sort_Ascending tests ascending sort
@@ -304,31 +247,16 @@ func TestEngine_MultiSort(t *testing.T) {
 	engine := NewEngine()
 
 	// Create source with duplicate ages
-	source := &mockDataSource{
-		rows: [][]datatable.Value{
-			{
-				datatable.NewValue("Alice", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Bob", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Charlie", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Diana", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-			},
+	source := newMockDataSource(
+		[]string{"Name", "Age"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt},
+		[][]any{
+			{"Alice", "30"},
+			{"Bob", "30"},
+			{"Charlie", "25"},
+			{"Diana", "25"},
 		},
-		columnNames: []string{"Name", "Age"},
-		columnTypes: []datatable.DataType{
-			datatable.TypeString,
-			datatable.TypeInt,
-		},
-	}
+	)
 
 	indices := []int{0, 1, 2, 3}
 
@@ -367,31 +295,16 @@ func TestEngine_MultiSort(t *testing.T) {
 func TestEngine_MultiSort_MixedDirections(t *testing.T) {
 	engine := NewEngine()
 
-	source := &mockDataSource{
-		rows: [][]datatable.Value{
-			{
-				datatable.NewValue("Alice", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Bob", datatable.TypeString),
-				datatable.NewValue("30", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Charlie", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-			},
-			{
-				datatable.NewValue("Diana", datatable.TypeString),
-				datatable.NewValue("25", datatable.TypeInt),
-			},
-		},
-		columnNames: []string{"Name", "Age"},
-		columnTypes: []datatable.DataType{
-			datatable.TypeString,
-			datatable.TypeInt,
+	source := newMockDataSource(
+		[]string{"Name", "Age"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt},
+		[][]any{
+			{"Alice", "30"},
+			{"Bob", "30"},
+			{"Charlie", "25"},
+			{"Diana", "25"},
 		},
-	}
+	)
 
 	indices := []int{0, 1, 2, 3}
 
@@ -538,16 +451,16 @@ func TestEngine_MultiSort_EmptySpecs(t *testing.T) {
 func TestEngine_Sort_NullHandling(t *testing.T) {
 	engine := NewEngine()
 
-	source := &mockDataSource{
-		rows: [][]datatable.Value{
-			{datatable.NewValue("Alice", datatable.TypeString), datatable.NewValue("30", datatable.TypeInt)},
-			{datatable.NewValue("Bob", datatable.TypeString), datatable.Value{IsNull: true, Type: datatable.TypeInt}},
-			{datatable.NewValue("Charlie", datatable.TypeString), datatable.NewValue("25", datatable.TypeInt)},
-			{datatable.NewValue("Diana", datatable.TypeString), datatable.Value{IsNull: true, Type: datatable.TypeInt}},
+	source := newMockDataSource(
+		[]string{"Name", "Age"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt},
+		[][]any{
+			{"Alice", "30"},
+			{"Bob", nil},
+			{"Charlie", "25"},
+			{"Diana", nil},
 		},
-		columnNames: []string{"Name", "Age"},
-		columnTypes: []datatable.DataType{datatable.TypeString, datatable.TypeInt},
-	}
+	)
 
 	indices := []int{0, 1, 2, 3}
 
@@ -579,6 +492,127 @@ func TestEngine_Sort_NullHandling(t *testing.T) {
 	}
 }
 
+// TestEngine_Sort_RawOnlyValues tests values built without Formatted
+func TestEngine_Sort_RawOnlyValues(t *testing.T) {
+	engine := NewEngine()
+
+	// Append struct literals so the cells keep an empty Formatted
+	source := datatabletest.NewMutableMockSource([]datatable.ColumnSchema{
+		{Name: "Count", Type: datatable.TypeInt},
+		{Name: "Score", Type: datatable.TypeFloat},
+	}, nil)
+	for _, row := range [][]datatable.Value{
+		{{Raw: int64(30), Type: datatable.TypeInt}, {Raw: 2.5, Type: datatable.TypeFloat}},
+		{{Raw: int64(5), Type: datatable.TypeInt}, {Raw: 10.0, Type: datatable.TypeFloat}},
+		{{Raw: int64(100), Type: datatable.TypeInt}, {Raw: -1.0, Type: datatable.TypeFloat}},
+	} {
+		if err := source.AppendRow(row); err != nil {
+			t.Fatalf("AppendRow() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		spec SortSpec
+		want []int
+	}{
+		{SortSpec{Column: 0, Direction: datatable.SortAscending, DataType: datatable.TypeInt}, []int{1, 0, 2}},
+		{SortSpec{Column: 1, Direction: datatable.SortDescending, DataType: datatable.TypeFloat}, []int{1, 0, 2}},
+	}
+
+	for _, tt := range tests {
+		got, err := engine.Sort(source, []int{0, 1, 2}, tt.spec)
+		if err != nil {
+			t.Fatalf("Sort() error = %v", err)
+		}
+		for i := range tt.want {
+			if got[i] != tt.want[i] {
+				t.Errorf("Sort(column %d) = %v, want %v", tt.spec.Column, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+// newSwedishSource has a name column with Swedish letters and a group column
+// for multi-column sorts.
+func newSwedishSource() *mockDataSource {
+	names := []string{"Örjan", "anna", "Åsa", "Zlatan", "ärla", "Bo"}
+	rows := make([][]any, len(names))
+	for i, name := range names {
+		rows[i] = []any{name, int64(i % 2)}
+	}
+	return newMockDataSource(
+		[]string{"Name", "Group"},
+		[]datatable.DataType{datatable.TypeString, datatable.TypeInt},
+		rows,
+	)
+}
+
+func TestEngine_Sort_Locale(t *testing.T) {
+	engine := NewEngine()
+	source := newSwedishSource()
+	indices := []int{0, 1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name string
+		spec SortSpec
+		want []int
+	}{
+		// Byte order puts ä (U+00E4) before å (U+00E5)
+		{"default byte order", SortSpec{Column: 0, Direction: datatable.SortAscending}, []int{1, 5, 3, 4, 2, 0}},
+		// Swedish collates å < ä < ö after z, ignoring case
+		{"swedish", SortSpec{Column: 0, Direction: datatable.SortAscending, Locale: "sv"}, []int{1, 5, 3, 2, 4, 0}},
+		{"swedish descending", SortSpec{Column: 0, Direction: datatable.SortDescending, Locale: "sv-SE"}, []int{0, 4, 2, 3, 5, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Sort(source, indices, tt.spec)
+			if err != nil {
+				t.Fatalf("Sort() error = %v", err)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Sort() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestEngine_MultiSort_Locale(t *testing.T) {
+	engine := NewEngine()
+	source := newSwedishSource()
+
+	specs := []SortSpec{
+		{Column: 1, Direction: datatable.SortAscending},
+		{Column: 0, Direction: datatable.SortAscending, Locale: "sv"},
+	}
+	got, err := engine.MultiSort(source, []int{0, 1, 2, 3, 4, 5}, specs)
+	if err != nil {
+		t.Fatalf("MultiSort() error = %v", err)
+	}
+
+	// Group 0: Åsa, ärla, Örjan; group 1: anna, Bo, Zlatan
+	want := []int{2, 4, 0, 1, 5, 3}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("MultiSort() = %v, want %v", got, want)
+			break
+		}
+	}
+
+	// Each spec carries its own locale and is validated
+	specs[1].Locale = "not a locale!"
+	if _, err := engine.MultiSort(source, []int{0, 1}, specs); err == nil {
+		t.Error("MultiSort() with invalid locale succeeded, want error")
+	}
+	if _, err := engine.Sort(source, []int{0, 1}, specs[1]); err == nil {
+		t.Error("Sort() with invalid locale succeeded, want error")
+	}
+}
+
 // TestCompareNumeric tests numeric comparison
 func TestCompareNumeric(t *testing.T) {
 	tests := []struct {
@@ -605,6 +639,57 @@ func TestCompareNumeric(t *testing.T) {
 	}
 }
 
+// TestCompareInteger tests exact integer comparison
+func TestCompareInteger(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"10 > 9", "10", "9", 1},
+		{"negative", "-5", "3", -1},
+		{"max uint64 above max int64", "18446744073709551615", "9223372036854775807", 1},
+		{"adjacent large values", "18446744073709551614", "18446744073709551615", -1},
+		{"non-integer falls back", "2.5", "10", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareInteger(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("compareInteger(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCompareDecimal tests exact decimal comparison
+func TestCompareDecimal(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{"scale 2", "123.45", "123.44", 1},
+		{"scale 4", "0.0001", "0.0010", -1},
+		{"equal with different scale", "1.50", "1.5000", 0},
+		{"negative", "-0.01", "0.00", -1},
+		{"beyond float64 precision", "12345678901234567890.01", "12345678901234567890.02", -1},
+		{"invalid falls back", "abc", "def", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareDecimal(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("compareDecimal(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
 // TestCompareString tests string comparison
 func TestCompareString(t *testing.T) {
 	tests := []struct {
@@ -653,3 +738,145 @@ func TestCompareBool(t *testing.T) {
 		})
 	}
 }
+
+// TestCompareDuration tests duration comparison
+func TestCompareDuration(t *testing.T) {
+	tests := []struct {
+		name string
+		a    datatable.Value
+		b    datatable.Value
+		want int
+	}{
+		{"raw 45s < 1h30m", datatable.NewValue(45*time.Second, datatable.TypeDuration),
+			datatable.NewValue(90*time.Minute, datatable.TypeDuration), -1},
+		{"raw 1d2h > 1h30m", datatable.NewValue(26*time.Hour, datatable.TypeDuration),
+			datatable.NewValue(90*time.Minute, datatable.TypeDuration), 1},
+		{"parsed 90m = 1h30m", datatable.NewValue("90m", datatable.TypeDuration),
+			datatable.NewValue(90*time.Minute, datatable.TypeDuration), 0},
+		{"parsed 500ms < 2s", datatable.NewValue("500ms", datatable.TypeDuration),
+			datatable.NewValue("2s", datatable.TypeDuration), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareDuration(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("compareDuration(%q, %q) = %d, want %d", tt.a.Formatted, tt.b.Formatted, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareDateTime(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	pst := time.FixedZone("PST", -8*3600)
+	tests := []struct {
+		name string
+		a    datatable.Value
+		b    datatable.Value
+		want int
+	}{
+		// 10:00 PST is 19:00 CET, so the instants order opposite to their text
+		{"raw zoned instants", datatable.NewValue(time.Date(2024, 1, 2, 10, 0, 0, 0, pst), datatable.TypeTimestamp),
+			datatable.NewValue(time.Date(2024, 1, 2, 12, 0, 0, 0, cet), datatable.TypeTimestamp), 1},
+		{"parsed zoned text", datatable.NewValue("2024-01-02 10:00:00 UTC", datatable.TypeTimestamp),
+			datatable.NewValue("2024-01-02 10:00:00", datatable.TypeTimestamp), 0},
+		{"raw equals parsed", datatable.NewValue(time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), datatable.TypeTimestamp),
+			datatable.NewValue("2024-01-02 10:00:00", datatable.TypeTimestamp), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareDateTime(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("compareDateTime(%q, %q) = %d, want %d", tt.a.Formatted, tt.b.Formatted, got, tt.want)
+			}
+		})
+	}
+}
+
+// newUntypedSource returns a source whose columns are all reported as
+// TypeString: Amount (numbers), When (dates), Flag (booleans) and
+// Code (mixed text).
+func newUntypedSource() *mockDataSource {
+	return newMockDataSource(
+		[]string{"Amount", "When", "Flag", "Code"},
+		[]datatable.DataType{
+			datatable.TypeString, datatable.TypeString, datatable.TypeString, datatable.TypeString,
+		},
+		[][]any{
+			{"10", "2024-03-01", "true", "b7"},
+			{"9", "2023-12-31", "false", "10"},
+			{"100", "2024-01-15", "true", "a"},
+			{"-2.5", "2024-01-02", "false", "9"},
+			// A null does not prevent detection and still sorts last
+			{nil, nil, nil, nil},
+		},
+	)
+}
+
+func TestEngine_Sort_AutoDetectType(t *testing.T) {
+	engine := NewEngine()
+	source := newUntypedSource()
+	indices := []int{0, 1, 2, 3, 4}
+
+	tests := []struct {
+		name       string
+		col        int
+		autoDetect bool
+		want       []int
+	}{
+		{"numbers as text", 0, false, []int{3, 0, 2, 1, 4}},
+		{"numbers detected", 0, true, []int{3, 1, 0, 2, 4}},
+		{"dates detected", 1, true, []int{1, 3, 2, 0, 4}},
+		{"booleans detected", 2, true, []int{1, 3, 0, 2, 4}},
+		{"mixed stays text", 3, true, []int{1, 3, 2, 0, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := SortSpec{Column: tt.col, Direction: datatable.SortAscending, AutoDetectType: tt.autoDetect}
+			got, err := engine.Sort(source, indices, spec)
+			if err != nil {
+				t.Fatalf("Sort() error = %v", err)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Sort() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	// MultiSort detects the type of each spec's column
+	specs := []SortSpec{{Column: 0, Direction: datatable.SortDescending, AutoDetectType: true}}
+	got, err := engine.MultiSort(source, indices, specs)
+	if err != nil {
+		t.Fatalf("MultiSort() error = %v", err)
+	}
+	want := []int{4, 2, 0, 1, 3} // Descending puts nulls first
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("MultiSort() = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestDetectType(t *testing.T) {
+	source := newUntypedSource()
+
+	// Typed columns are left alone
+	if got := detectType(source, []int{0, 1}, 0, datatable.TypeInt); got != datatable.TypeInt {
+		t.Errorf("detectType(TypeInt) = %v, want Int", got)
+	}
+	// Only the sampled rows count: rows 1 and 3 of Code are numeric
+	if got := detectType(source, []int{1, 3}, 3, datatable.TypeString); got != datatable.TypeFloat {
+		t.Errorf("detectType(numeric subset) = %v, want Float", got)
+	}
+	// Only nulls: nothing to detect
+	if got := detectType(source, []int{4}, 0, datatable.TypeString); got != datatable.TypeString {
+		t.Errorf("detectType(nulls) = %v, want String", got)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sort
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	arrowadapter "github.com/magpierre/fyne-datatable/adapters/arrow"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// genericSource hides the typed column access of the wrapped source so the
+// engine takes the generic Cell-based path.
+type genericSource struct {
+	datatable.DataSource
+}
+
+// newInt64ArrowSource builds an Arrow-backed source with a single Int64
+// column. Entries with valid[i] == false are null; valid may be nil.
+func newInt64ArrowSource(t testing.TB, values []int64, valid []bool) *arrowadapter.ArrowDataSource {
+	t.Helper()
+
+	builder := array.NewInt64Builder(memory.NewGoAllocator())
+	defer builder.Release()
+	builder.AppendValues(values, valid)
+	arr := builder.NewArray()
+	defer arr.Release()
+
+	field := arrow.Field{Name: "n", Type: arrow.PrimitiveTypes.Int64, Nullable: true}
+	chunked := arrow.NewChunked(field.Type, []arrow.Array{arr})
+	defer chunked.Release()
+	column := arrow.NewColumn(field, chunked)
+	defer column.Release()
+
+	table := array.NewTable(arrow.NewSchema([]arrow.Field{field}, nil), []arrow.Column{*column}, int64(len(values)))
+	t.Cleanup(table.Release)
+
+	source, err := arrowadapter.NewFromArrowTable(table)
+	if err != nil {
+		t.Fatalf("NewFromArrowTable() error = %v", err)
+	}
+	t.Cleanup(source.Release)
+	return source
+}
+
+func TestEngine_Sort_Int64FastPathMatchesGeneric(t *testing.T) {
+	values := []int64{5, -3, 0, 5, 12, 0, -3, 7, 9, 1}
+	valid := []bool{true, true, false, true, true, true, false, true, true, true}
+	source := newInt64ArrowSource(t, values, valid)
+
+	if _, _, ok := datatable.DataSource(source).(datatable.Int64ColumnSource).Int64Values(0); !ok {
+		t.Fatal("Arrow source does not expose Int64 column values")
+	}
+
+	engine := NewEngine()
+	subsets := map[string][]int{
+		"all rows": {0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		"subset":   {9, 6, 3, 2, 0},
+	}
+
+	for name, indices := range subsets {
+		for _, dir := range []datatable.SortDirection{datatable.SortAscending, datatable.SortDescending} {
+			spec := SortSpec{Column: 0, Direction: dir}
+
+			fast, err := engine.Sort(source, indices, spec)
+			if err != nil {
+				t.Fatalf("Sort() error = %v", err)
+			}
+			generic, err := engine.Sort(genericSource{source}, indices, spec)
+			if err != nil {
+				t.Fatalf("Sort(generic) error = %v", err)
+			}
+
+			if len(fast) != len(generic) {
+				t.Fatalf("%s %v: fast = %v, generic = %v", name, dir, fast, generic)
+			}
+			for i := range fast {
+				if fast[i] != generic[i] {
+					t.Errorf("%s %v: fast = %v, generic = %v", name, dir, fast, generic)
+					break
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// cellText returns the text displayed for a cell. Float columns are
+// re-rendered from their raw value with floatFormat when it is set; every
+// other cell is formatted with datatable.FormatValue as a value of the
+// column's type, so formatters registered for that type apply.
+func cellText(value datatable.Value, colType datatable.DataType, floatFormat string) string {
+	value.Type = colType
+	if floatFormat == "" || colType != datatable.TypeFloat || value.IsNull || value.IsError() {
+		return datatable.FormatValue(value)
+	}
+
+	f, ok := floatValue(value)
+	if !ok {
+		return datatable.FormatValue(value)
+	}
+	return fmt.Sprintf(floatFormat, f)
+}
+
+// cellToolTip returns the tooltip for a cell showing text. With showRaw
+// set it is the string form of the raw value, revealing e.g. the full
+// precision behind a formatted float; null and error cells keep text.
+func cellToolTip(value datatable.Value, text string, showRaw bool) string {
+	if !showRaw || value.IsNull || value.IsError() || value.Raw == nil {
+		return text
+	}
+	return fmt.Sprint(value.Raw)
+}
+
+// floatValue extracts a float64 from a value's raw data.
+func floatValue(value datatable.Value) (float64, bool) {
+	switch v := value.Raw.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// parseFloatFormat turns settings input into a float format string. A plain
+// number of decimals such as "4" becomes "%.4f"; anything else must be a
+// single fmt verb that formats a float64. Empty input clears the override.
+func parseFloatFormat(text string) (string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "", nil
+	}
+
+	if decimals, err := strconv.Atoi(text); err == nil {
+		if decimals < 0 {
+			return "", fmt.Errorf("decimals cannot be negative: %d", decimals)
+		}
+		return fmt.Sprintf("%%.%df", decimals), nil
+	}
+
+	// fmt reports bad verbs, missing and extra operands inline as "%!"
+	if strings.Contains(fmt.Sprintf(text, 1.5), "%!") {
+		return "", fmt.Errorf("invalid float format: %q", text)
+	}
+	return text, nil
+}
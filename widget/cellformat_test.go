@@ -0,0 +1,156 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestCellText(t *testing.T) {
+	pi := datatable.Value{Raw: 3.14159265, Formatted: "3.14"}
+
+	tests := []struct {
+		name    string
+		value   datatable.Value
+		colType datatable.DataType
+		format  string
+		want    string
+	}{
+		{"no override", pi, datatable.TypeFloat, "", "3.14"},
+		{"four decimals", pi, datatable.TypeFloat, "%.4f", "3.1416"},
+		{"zero decimals", pi, datatable.TypeFloat, "%.0f", "3"},
+		{"scientific", datatable.Value{Raw: 0.000123, Formatted: "0.00"}, datatable.TypeFloat, "%.2e", "1.23e-04"},
+		{"float32 raw", datatable.Value{Raw: float32(2.5), Formatted: "2.50"}, datatable.TypeFloat, "%.3f", "2.500"},
+		{"string raw", datatable.Value{Raw: "1.23456", Formatted: "1.23456"}, datatable.TypeFloat, "%.1f", "1.2"},
+		{"non-float column", datatable.Value{Raw: int64(7), Formatted: "7"}, datatable.TypeInt, "%.2f", "7"},
+		{"null", datatable.Value{IsNull: true}, datatable.TypeFloat, "%.2f", ""},
+		{"unparseable raw", datatable.Value{Raw: "n/a", Formatted: "n/a"}, datatable.TypeFloat, "%.2f", "n/a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cellText(tt.value, tt.colType, tt.format)
+			if got != tt.want {
+				t.Errorf("cellText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCellText_RegisteredFormatter(t *testing.T) {
+	datatable.RegisterFormatter(datatable.TypeFloat, datatable.FormatterFunc(func(v datatable.Value) string {
+		return "≈" + v.Formatted
+	}))
+	defer datatable.RegisterFormatter(datatable.TypeFloat, nil)
+
+	// The column type picks the formatter, whatever the value's own Type
+	value := datatable.Value{Raw: 2.5, Formatted: "2.5"}
+	if got := cellText(value, datatable.TypeFloat, ""); got != "≈2.5" {
+		t.Errorf("cellText() = %q, want %q", got, "≈2.5")
+	}
+	if got := cellText(value, datatable.TypeString, ""); got != "2.5" {
+		t.Errorf("cellText() for a string column = %q, want %q", got, "2.5")
+	}
+	// A table's FloatFormat still wins
+	if got := cellText(value, datatable.TypeFloat, "%.1f"); got != "2.5" {
+		t.Errorf("cellText() with FloatFormat = %q, want %q", got, "2.5")
+	}
+}
+
+func TestCellToolTip(t *testing.T) {
+	price := datatable.Value{Raw: 1234.5678, Formatted: "1,234.57"}
+	when := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   datatable.Value
+		text    string
+		showRaw bool
+		want    string
+	}{
+		{"formatted by default", price, "1,234.57", false, "1,234.57"},
+		{"raw float", price, "1,234.57", true, "1234.5678"},
+		{"raw int", datatable.Value{Raw: int64(42), Formatted: "42"}, "42", true, "42"},
+		{"raw time", datatable.Value{Raw: when, Formatted: "2025-03-01"}, "2025-03-01", true, when.String()},
+		{"null keeps text", datatable.Value{IsNull: true, Formatted: "NULL"}, "NULL", true, "NULL"},
+		{"error keeps text", datatable.NewErrorValue("bad", datatable.TypeFloat), "#ERR", true, "#ERR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cellToolTip(tt.value, tt.text, tt.showRaw); got != tt.want {
+				t.Errorf("cellToolTip() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFloatFormat(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"  ", "", false},
+		{"4", "%.4f", false},
+		{"0", "%.0f", false},
+		{"%.3e", "%.3e", false},
+		{"%.1f%%", "%.1f%%", false},
+		{"-1", "", true},
+		{"%d", "", true},
+		{"%f %f", "", true},
+		{"abc", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseFloatFormat(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseFloatFormat(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseFloatFormat(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSettingsDialog_AppliesFloatFormat(t *testing.T) {
+	dt := newClipboardTestTable(t)
+	window := test.NewTempWindow(t, dt)
+
+	sd := NewSettingsDialog(dt, window)
+	sd.floatFormatEntry.SetText("3")
+	sd.applySettings()
+	if dt.config.FloatFormat != "%.3f" {
+		t.Errorf("Expected FloatFormat %q, got %q", "%.3f", dt.config.FloatFormat)
+	}
+
+	// A later Reconfigure keeps the format, and invalid input leaves it alone
+	sd = NewSettingsDialog(dt, window)
+	if sd.floatFormatEntry.Text != "%.3f" {
+		t.Errorf("Expected entry to show %q, got %q", "%.3f", sd.floatFormatEntry.Text)
+	}
+	sd.floatFormatEntry.SetText("%d")
+	sd.applySettings()
+	if dt.config.FloatFormat != "%.3f" {
+		t.Errorf("Expected FloatFormat to stay %q, got %q", "%.3f", dt.config.FloatFormat)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import "fmt"
+
+// ClipboardFormat selects how copied rows are serialized.
+type ClipboardFormat int
+
+const (
+	// ClipboardTSV copies rows as tab-separated values (default).
+	ClipboardTSV ClipboardFormat = iota
+	// ClipboardCSV copies rows as comma-separated values with standard quoting.
+	ClipboardCSV
+	// ClipboardJSON copies rows as a JSON array of objects.
+	ClipboardJSON
+)
+
+// String returns the string representation of a ClipboardFormat.
+func (f ClipboardFormat) String() string {
+	switch f {
+	case ClipboardTSV:
+		return "TSV"
+	case ClipboardCSV:
+		return "CSV"
+	case ClipboardJSON:
+		return "JSON"
+	default:
+		return fmt.Sprintf("Unknown(%d)", f)
+	}
+}
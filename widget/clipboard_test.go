@@ -0,0 +1,215 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"github.com/magpierre/fyne-datatable/adapters/memory"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// newClipboardTestTable builds a row-selection table with rows 0 and 2 selected.
+func newClipboardTestTable(t *testing.T) *DataTable {
+	t.Helper()
+	return newClipboardTestTableWithConfig(t, DefaultConfig())
+}
+
+// newClipboardTestTableWithConfig is newClipboardTestTable with a custom config.
+func newClipboardTestTableWithConfig(t *testing.T, config Config) *DataTable {
+	t.Helper()
+	test.NewTempApp(t)
+
+	data := [][]string{
+		{"Alice", "Engineer"},
+		{"Bob", "Manager"},
+		{`Smith, "The Boss"`, "Director"},
+	}
+	source, err := memory.NewDataSource(data, []string{"Name", "Title"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	config.SelectionMode = SelectionModeRow
+	dt := NewDataTableWithConfig(model, config)
+	dt.selectedRows[0] = true
+	dt.selectedRows[2] = true
+	return dt
+}
+
+func TestCopySelectedRowsAs_TSV(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	got, err := dt.formatSelectedRows(ClipboardTSV)
+	if err != nil {
+		t.Fatalf("Failed to format rows: %v", err)
+	}
+
+	expected := "Name\tTitle\nAlice\tEngineer\nSmith, \"The Boss\"\tDirector"
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestCopySelectedRowsAs_CSV(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	got, err := dt.formatSelectedRows(ClipboardCSV)
+	if err != nil {
+		t.Fatalf("Failed to format rows: %v", err)
+	}
+
+	expected := "Name,Title\nAlice,Engineer\n\"Smith, \"\"The Boss\"\"\",Director\n"
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
+
+func TestCopySelectedRowsAs_JSON(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	got, err := dt.formatSelectedRows(ClipboardJSON)
+	if err != nil {
+		t.Fatalf("Failed to format rows: %v", err)
+	}
+
+	var rows []map[string]any
+	if err := json.Unmarshal([]byte(got), &rows); err != nil {
+		t.Fatalf("Failed to parse JSON %q: %v", got, err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if rows[0]["Name"] != "Alice" || rows[0]["Title"] != "Engineer" {
+		t.Errorf("Unexpected first row: %v", rows[0])
+	}
+	if rows[1]["Name"] != `Smith, "The Boss"` || rows[1]["Title"] != "Director" {
+		t.Errorf("Unexpected second row: %v", rows[1])
+	}
+}
+
+func TestCopySelectedRowsAs_NoSelection(t *testing.T) {
+	dt := newClipboardTestTable(t)
+	dt.selectedRows = make(map[int]bool)
+
+	if err := dt.CopySelectedRowsAs(ClipboardJSON); err == nil {
+		t.Error("Expected error when no rows are selected")
+	}
+}
+
+func TestCopySelectedRows_IncludeHeadersOnCopy(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         ClipboardFormat
+		includeHeaders bool
+		expected       string
+	}{
+		{"TSV with headers", ClipboardTSV, true, "Name\tTitle\nAlice\tEngineer\nSmith, \"The Boss\"\tDirector"},
+		{"TSV without headers", ClipboardTSV, false, "Alice\tEngineer\nSmith, \"The Boss\"\tDirector"},
+		{"CSV with headers", ClipboardCSV, true, "Name,Title\nAlice,Engineer\n\"Smith, \"\"The Boss\"\"\",Director\n"},
+		{"CSV without headers", ClipboardCSV, false, "Alice,Engineer\n\"Smith, \"\"The Boss\"\"\",Director\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.IncludeHeadersOnCopy = tt.includeHeaders
+			dt := newClipboardTestTableWithConfig(t, config)
+
+			got, err := dt.formatSelectedRows(tt.format)
+			if err != nil {
+				t.Fatalf("Failed to format rows: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCopyColumn(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	got, err := dt.formatColumn(0)
+	if err != nil {
+		t.Fatalf("Failed to format column: %v", err)
+	}
+	expected := "Name\nAlice\nBob\nSmith, \"The Boss\""
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	// The column follows the visible row order, whatever the selection
+	if err := dt.SortByColumn(1, datatable.SortDescending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	dt.config.IncludeHeadersOnCopy = false
+	got, err = dt.formatColumn(1)
+	if err != nil {
+		t.Fatalf("Failed to format column: %v", err)
+	}
+	expected = "Manager\nEngineer\nDirector"
+	if got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	window := test.NewWindow(dt)
+	defer window.Close()
+	dt.SetWindow(window)
+	if err := dt.CopyColumn(1); err != nil {
+		t.Errorf("CopyColumn failed: %v", err)
+	}
+
+	if err := dt.CopyColumn(2); !errors.Is(err, datatable.ErrInvalidColumn) {
+		t.Errorf("CopyColumn(2) error = %v, want ErrInvalidColumn", err)
+	}
+}
+
+func TestDefaultConfig_IncludeHeadersOnCopy(t *testing.T) {
+	if !DefaultConfig().IncludeHeadersOnCopy {
+		t.Error("Expected IncludeHeadersOnCopy to default to true")
+	}
+}
+
+func TestCopy_RegisteredFormatter(t *testing.T) {
+	datatable.RegisterFormatter(datatable.TypeString, datatable.FormatterFunc(func(v datatable.Value) string {
+		return strings.ToUpper(v.Formatted)
+	}))
+	defer datatable.RegisterFormatter(datatable.TypeString, nil)
+
+	dt := newClipboardTestTable(t)
+	dt.config.IncludeHeadersOnCopy = false
+
+	// Copies hold the text the table displays
+	got, err := dt.formatColumn(1)
+	if err != nil {
+		t.Fatalf("Failed to format column: %v", err)
+	}
+	if expected := "ENGINEER\nMANAGER\nDIRECTOR"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	if got, expected := dt.formatRowsTSV([]int{0}), "ALICE\tENGINEER"; got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+}
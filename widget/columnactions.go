@@ -0,0 +1,237 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// columnActions describes which computed-column actions apply to a column.
+type columnActions struct {
+	Column     string   // Column name
+	Computed   bool     // Whether the column is computed
+	CanEdit    bool     // Whether the column has an expression to edit
+	CanRemove  bool     // Whether the column can be removed
+	Dependents []string // Columns computed from this one, which block removal
+}
+
+// columnActionsFor returns the actions available for visible column col.
+// Source columns have none. Computed columns can be edited if they are
+// defined by an expression (window columns are not), and removed unless
+// other columns are computed from them.
+func (dt *DataTable) columnActionsFor(col int) columnActions {
+	name, err := dt.model.VisibleColumnName(col)
+	if err != nil {
+		return columnActions{}
+	}
+	actions := columnActions{Column: name}
+
+	exprDS, err := dt.expressionSource()
+	if err != nil || !dt.isComputedColumn(col) {
+		return actions
+	}
+
+	actions.Computed = true
+	actions.CanEdit = exprDS.GetExpression(name) != nil
+	actions.Dependents = exprDS.GetDependents(name)
+	slices.Sort(actions.Dependents)
+	actions.CanRemove = len(actions.Dependents) == 0
+	return actions
+}
+
+// removeLabel returns the menu label of the remove action, naming the
+// columns that block removal.
+func (a columnActions) removeLabel() string {
+	if len(a.Dependents) > 0 {
+		return "Remove Column (used by " + strings.Join(a.Dependents, ", ") + ")"
+	}
+	return "Remove Column"
+}
+
+// showColumnMenu shows the actions for visible column col at pos: copying
+// the column, and for computed columns editing or removing it.
+func (dt *DataTable) showColumnMenu(col int, pos fyne.Position) {
+	if dt.window == nil {
+		return
+	}
+	actions := dt.columnActionsFor(col)
+
+	copyItem := fyne.NewMenuItem("Copy Column", func() {
+		if err := dt.CopyColumn(col); err != nil {
+			dialog.ShowError(err, dt.window)
+		}
+	})
+	items := []*fyne.MenuItem{copyItem}
+
+	if actions.Computed {
+		editItem := fyne.NewMenuItem("Edit Expression...", func() {
+			dt.ShowColumnExpressionEditor(actions.Column)
+		})
+		editItem.Disabled = !actions.CanEdit
+
+		removeItem := fyne.NewMenuItem(actions.removeLabel(), func() {
+			if err := dt.RemoveComputedColumn(actions.Column); err != nil {
+				dialog.ShowError(err, dt.window)
+			}
+		})
+		removeItem.Disabled = !actions.CanRemove
+
+		items = append(items, fyne.NewMenuItemSeparator(), editItem, removeItem)
+	}
+
+	menu := fyne.NewMenu("", items...)
+	widget.ShowPopUpMenuAtPosition(menu, dt.window.Canvas(), pos)
+}
+
+// RemoveComputedColumn removes the computed column named column from the
+// table's ExpressionDataSource.
+// Returns an error if the column is not computed, or if other columns are
+// computed from it; those must be removed first.
+func (dt *DataTable) RemoveComputedColumn(column string) error {
+	exprDS, err := dt.expressionSource()
+	if err != nil {
+		return err
+	}
+
+	if dependents := exprDS.GetDependents(column); len(dependents) > 0 {
+		slices.Sort(dependents)
+		return fmt.Errorf("cannot remove column %s: remove the columns computed from it first: %s",
+			column, strings.Join(dependents, ", "))
+	}
+	removed := slices.Index(sourceColumnNames(exprDS), column)
+	if !exprDS.IsComputedColumn(removed) {
+		return fmt.Errorf("cannot remove column %s: only computed columns can be removed", column)
+	}
+
+	wasSorted := dt.model.IsSorted()
+	visible, sortStates := viewWithoutColumn(dt.model.GetVisibleColumnIndices(), dt.model.GetSortStates(), removed)
+	if err := exprDS.RemoveColumn(column); err != nil {
+		return err
+	}
+
+	// The columns after the removed one shift left, so set the view
+	// explicitly rather than leaving it to Reload
+	if err := dt.model.Reload(); err != nil {
+		return err
+	}
+	if len(visible) > 0 {
+		if err := dt.model.SetVisibleColumns(visible); err != nil {
+			return err
+		}
+	}
+	if wasSorted {
+		// Without keys left, the rows return to source order; otherwise
+		// they are sorted again by the keys that remain
+		if err := dt.model.SetSortStates(sortStates); err != nil {
+			return err
+		}
+		if err := dt.sortBySortStates(); err != nil {
+			return err
+		}
+	}
+	dt.notifySortChanged()
+
+	if dt.config.AutoAdjustColumnWidths {
+		dt.AutoAdjustColumns()
+	}
+	dt.Refresh()
+	return nil
+}
+
+// viewWithoutColumn returns the visible columns and sort keys after source
+// column removed is deleted: the column and its sort key are dropped, later
+// source columns shift down by one and later visible positions move left.
+func viewWithoutColumn(visible []int, sortStates []datatable.SortState, removed int) ([]int, []datatable.SortState) {
+	removedPos := slices.Index(visible, removed)
+
+	newVisible := make([]int, 0, len(visible))
+	for _, col := range visible {
+		switch {
+		case col == removed:
+		case col > removed:
+			newVisible = append(newVisible, col-1)
+		default:
+			newVisible = append(newVisible, col)
+		}
+	}
+
+	newStates := make([]datatable.SortState, 0, len(sortStates))
+	for _, state := range sortStates {
+		switch {
+		case removedPos >= 0 && state.Column == removedPos:
+		case removedPos >= 0 && state.Column > removedPos:
+			newStates = append(newStates, datatable.SortState{Column: state.Column - 1, Direction: state.Direction})
+		default:
+			newStates = append(newStates, state)
+		}
+	}
+
+	return newVisible, newStates
+}
+
+// SetComputedColumnExpression replaces the expression of the computed column
+// named column. The column keeps its name and output type.
+// Returns an error if the column has no expression, or if the new
+// expression is invalid or would make the column depend on itself.
+func (dt *DataTable) SetComputedColumnExpression(column, exprStr string) error {
+	exprDS, err := dt.expressionSource()
+	if err != nil {
+		return err
+	}
+	if exprDS.GetExpression(column) == nil {
+		return fmt.Errorf("column %s has no expression to edit", column)
+	}
+
+	colType, err := exprDS.ColumnType(slices.Index(sourceColumnNames(exprDS), column))
+	if err != nil {
+		return err
+	}
+	expr, err := parseColumnExpression(exprDS, exprStr, colType)
+	if err != nil {
+		return err
+	}
+	if err := exprDS.SetColumnExpression(column, expr); err != nil {
+		return err
+	}
+
+	return dt.reloadColumns()
+}
+
+// reloadColumns reloads the model after the source's columns changed and
+// redraws the table.
+func (dt *DataTable) reloadColumns() error {
+	if err := dt.model.Reload(); err != nil {
+		return err
+	}
+
+	// An edited expression can change values and which rows pass the filters
+	dt.filterMu.Lock()
+	dt.filterGeneration++
+	dt.filterMu.Unlock()
+
+	if dt.config.AutoAdjustColumnWidths {
+		dt.AutoAdjustColumns()
+	}
+	dt.Refresh()
+	return nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/expression"
+)
+
+// newComputedTestTable creates a table over Item/Price/Qty with the computed
+// columns Total (Price * Qty), WithTax (Total * 2) and the running sum Running.
+func newComputedTestTable(t *testing.T) (*DataTable, *datatable.TableModel) {
+	t.Helper()
+	test.NewTempApp(t)
+
+	source := newOrderSource(t)
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	dt := NewDataTable(model)
+
+	if err := dt.AddComputedColumn("Total", "Price * Qty", datatable.TypeFloat); err != nil {
+		t.Fatalf("AddComputedColumn(Total) failed: %v", err)
+	}
+	if err := dt.AddComputedColumn("WithTax", "Total * 2", datatable.TypeFloat); err != nil {
+		t.Fatalf("AddComputedColumn(WithTax) failed: %v", err)
+	}
+	if err := source.AddCumulativeColumn("Running", "Qty", expression.WindowCumSum); err != nil {
+		t.Fatalf("AddCumulativeColumn failed: %v", err)
+	}
+	if err := dt.reloadColumns(); err != nil {
+		t.Fatalf("reloadColumns failed: %v", err)
+	}
+	return dt, model
+}
+
+// visibleColumnNames returns the visible column names in display order.
+func visibleColumnNames(model *datatable.TableModel) []string {
+	names := make([]string, model.VisibleColumnCount())
+	for i := range names {
+		names[i], _ = model.VisibleColumnName(i)
+	}
+	return names
+}
+
+func TestColumnActionsFor(t *testing.T) {
+	dt, _ := newComputedTestTable(t)
+
+	tests := []struct {
+		col        int
+		computed   bool
+		canEdit    bool
+		canRemove  bool
+		dependents []string
+	}{
+		{0, false, false, false, nil},               // Item: source column
+		{2, false, false, false, nil},               // Qty: source column
+		{3, true, true, false, []string{"WithTax"}}, // Total: WithTax uses it
+		{4, true, true, true, nil},                  // WithTax
+		{5, true, false, true, nil},                 // Running: window column
+		{9, false, false, false, nil},               // Out of range
+	}
+
+	for _, tt := range tests {
+		got := dt.columnActionsFor(tt.col)
+		if got.Computed != tt.computed || got.CanEdit != tt.canEdit || got.CanRemove != tt.canRemove {
+			t.Errorf("column %d (%s): computed/edit/remove = %v/%v/%v, want %v/%v/%v",
+				tt.col, got.Column, got.Computed, got.CanEdit, got.CanRemove,
+				tt.computed, tt.canEdit, tt.canRemove)
+		}
+		if !slices.Equal(got.Dependents, tt.dependents) && len(got.Dependents)+len(tt.dependents) > 0 {
+			t.Errorf("column %d: Dependents = %v, want %v", tt.col, got.Dependents, tt.dependents)
+		}
+	}
+
+	if label := dt.columnActionsFor(3).removeLabel(); !strings.Contains(label, "used by WithTax") {
+		t.Errorf("removeLabel() = %q, want the blocking column named", label)
+	}
+	if label := dt.columnActionsFor(4).removeLabel(); label != "Remove Column" {
+		t.Errorf("removeLabel() = %q, want %q", label, "Remove Column")
+	}
+
+	// Plain sources have no computed-column actions
+	plain := newEmployeeTestTable(t, DefaultConfig())
+	if got := plain.columnActionsFor(0); got.Computed || got.CanEdit || got.CanRemove {
+		t.Errorf("plain source column actions = %+v, want none", got)
+	}
+}
+
+func TestRemoveComputedColumn(t *testing.T) {
+	dt, model := newComputedTestTable(t)
+
+	err := dt.RemoveComputedColumn("Total")
+	if err == nil || !strings.Contains(err.Error(), "WithTax") {
+		t.Errorf("RemoveComputedColumn(Total) error = %v, want the dependent column named", err)
+	}
+	if err := dt.RemoveComputedColumn("Item"); err == nil {
+		t.Error("Expected error removing a source column")
+	}
+
+	// Sort by Running, then remove a column before it
+	if err := dt.SortByColumn(5, datatable.SortDescending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if err := dt.RemoveComputedColumn("WithTax"); err != nil {
+		t.Fatalf("RemoveComputedColumn(WithTax) failed: %v", err)
+	}
+	want := []string{"Item", "Price", "Qty", "Total", "Running"}
+	if got := visibleColumnNames(model); !slices.Equal(got, want) {
+		t.Errorf("visible columns = %v, want %v", got, want)
+	}
+	states := model.GetSortStates()
+	if len(states) != 1 || states[0].Column != 4 || states[0].Direction != datatable.SortDescending {
+		t.Errorf("sort states = %v, want Running (4) descending", states)
+	}
+	if val, _ := model.VisibleCell(0, 4); val.Raw != int64(7) {
+		t.Errorf("first Running value = %v, want 7", val.Raw)
+	}
+
+	// Total is no longer used, so it can go too
+	if !dt.columnActionsFor(3).CanRemove {
+		t.Error("Expected Total to be removable once WithTax is gone")
+	}
+	if err := dt.RemoveComputedColumn("Total"); err != nil {
+		t.Fatalf("RemoveComputedColumn(Total) failed: %v", err)
+	}
+	want = []string{"Item", "Price", "Qty", "Running"}
+	if got := visibleColumnNames(model); !slices.Equal(got, want) {
+		t.Errorf("visible columns = %v, want %v", got, want)
+	}
+}
+
+func TestRemoveComputedColumn_Resorts(t *testing.T) {
+	dt, model := newComputedTestTable(t)
+
+	// Sort by WithTax, then Item
+	keys := []datatable.SortState{
+		{Column: 4, Direction: datatable.SortDescending},
+		{Column: 0, Direction: datatable.SortAscending},
+	}
+	if err := model.SetSortStates(keys); err != nil {
+		t.Fatalf("SetSortStates failed: %v", err)
+	}
+	if err := dt.sortBySortStates(); err != nil {
+		t.Fatalf("sortBySortStates failed: %v", err)
+	}
+
+	// The rows follow the remaining key, Item
+	if err := dt.RemoveComputedColumn("WithTax"); err != nil {
+		t.Fatalf("RemoveComputedColumn(WithTax) failed: %v", err)
+	}
+	want := []string{"Book", "Lamp", "Pen"}
+	for row, item := range want {
+		if val, _ := model.VisibleCell(row, 0); val.Formatted != item {
+			t.Errorf("row %d: Item = %q, want %q", row, val.Formatted, item)
+		}
+	}
+}
+
+func TestSetComputedColumnExpression(t *testing.T) {
+	dt, model := newComputedTestTable(t)
+
+	if err := dt.SetComputedColumnExpression("Total", "Price + Qty"); err != nil {
+		t.Fatalf("SetComputedColumnExpression failed: %v", err)
+	}
+	// WithTax follows the new Total
+	want := []float64{11, 26, 44}
+	for row, w := range want {
+		if val, _ := model.VisibleCell(row, 4); val.Raw != w {
+			t.Errorf("row %d: WithTax = %v, want %v", row, val.Raw, w)
+		}
+	}
+
+	if err := dt.SetComputedColumnExpression("Total", "WithTax + 1"); err == nil {
+		t.Error("Expected error for a circular expression")
+	}
+	if val, _ := model.VisibleCell(0, 3); val.Raw != 5.5 {
+		t.Errorf("Total after rejected edit = %v, want 5.5", val.Raw)
+	}
+	if err := dt.SetComputedColumnExpression("Running", "Qty"); err == nil {
+		t.Error("Expected error editing a window column")
+	}
+	if err := dt.SetComputedColumnExpression("Price", "Qty"); err == nil {
+		t.Error("Expected error editing a source column")
+	}
+}
+
+func TestViewWithoutColumn(t *testing.T) {
+	states := []datatable.SortState{
+		{Column: 2, Direction: datatable.SortAscending},
+		{Column: 0, Direction: datatable.SortDescending},
+	}
+
+	// Visible order 4, 1, 3: removing source column 1 (shown second)
+	visible, sorted := viewWithoutColumn([]int{4, 1, 3}, states, 1)
+	if !slices.Equal(visible, []int{3, 2}) {
+		t.Errorf("visible = %v, want [3 2]", visible)
+	}
+	wantStates := []datatable.SortState{
+		{Column: 1, Direction: datatable.SortAscending},
+		{Column: 0, Direction: datatable.SortDescending},
+	}
+	if !slices.Equal(sorted, wantStates) {
+		t.Errorf("sort states = %v, want %v", sorted, wantStates)
+	}
+
+	// A hidden column only renumbers the columns after it
+	visible, sorted = viewWithoutColumn([]int{0, 3}, states[1:], 2)
+	if !slices.Equal(visible, []int{0, 2}) || !slices.Equal(sorted, states[1:]) {
+		t.Errorf("hidden removal = %v, %v, want [0 2], %v", visible, sorted, states[1:])
+	}
+}
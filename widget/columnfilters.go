@@ -0,0 +1,230 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+// columnFilterPrefixes maps the operator prefixes accepted in a per-column
+// filter entry to their operators. Longer prefixes come first so ">=" is not
+// read as ">".
+var columnFilterPrefixes = []struct {
+	prefix string
+	op     filter.CompareOp
+}{
+	{">=", filter.OpGreaterOrEqual},
+	{"<=", filter.OpLessOrEqual},
+	{"!=", filter.OpNotEqual},
+	{">", filter.OpGreaterThan},
+	{"<", filter.OpLessThan},
+	{"=", filter.OpEqual},
+}
+
+// isNumericType reports whether a column type compares numerically.
+func isNumericType(t datatable.DataType) bool {
+	return t == datatable.TypeInt || t == datatable.TypeFloat || t == datatable.TypeDecimal
+}
+
+// parseColumnFilter turns the text of a per-column filter entry into a
+// filter on column. On numeric columns the text is a number, compared
+// numerically, with an optional comparison prefix (">", ">=", "<", "<=", "=",
+// "!="), defaulting to equality.
+// On other columns "=" and "!=" compare the whole value and anything else
+// keeps cells containing the text, ignoring case.
+// Returns nil for blank text, and an error wrapping ErrInvalidFilter if a
+// numeric column's value is not a number.
+func parseColumnFilter(column string, colType datatable.DataType, text string) (datatable.Filter, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, nil
+	}
+
+	op, value := filter.OpContains, text
+	if isNumericType(colType) {
+		op = filter.OpEqual
+	}
+	for _, p := range columnFilterPrefixes {
+		if rest, ok := strings.CutPrefix(text, p.prefix); ok {
+			if !isNumericType(colType) && p.op != filter.OpEqual && p.op != filter.OpNotEqual {
+				break // Ordering only applies to numbers; match the text as is
+			}
+			op, value = p.op, strings.TrimSpace(rest)
+			break
+		}
+	}
+
+	f := &filter.SimpleFilter{
+		Column:   column,
+		Operator: op,
+		Value:    value,
+	}
+	if isNumericType(colType) {
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return nil, fmt.Errorf("%w: %s: %q is not a number", datatable.ErrInvalidFilter, column, value)
+		}
+		f.CompareAs = filter.CompareNumeric
+	}
+	return f, nil
+}
+
+// buildColumnFilter combines the per-column filter inputs, keyed by column
+// name, into a CompositeFilter with AND logic. Conditions follow the column
+// order of schema; inputs for columns not in schema are ignored.
+// Returns nil when every input is blank.
+func buildColumnFilter(schema []datatable.ColumnSchema, inputs map[string]string) (datatable.Filter, error) {
+	var filters []datatable.Filter
+	for _, col := range schema {
+		f, err := parseColumnFilter(col.Name, col.Type, inputs[col.Name])
+		if err != nil {
+			return nil, err
+		}
+		if f != nil {
+			filters = append(filters, f)
+		}
+	}
+
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	return &filter.CompositeFilter{
+		Filters: filters,
+		Logic:   filter.LogicAND,
+	}, nil
+}
+
+// newHeaderTemplate creates a header cell: a header button with a filter
+// entry below it, shown when withFilter is set.
+func newHeaderTemplate(withFilter bool) fyne.CanvasObject {
+	btn := newHeaderButton()
+	btn.Importance = widget.MediumImportance // Medium importance for better centered text
+	// Size will be set by UpdateHeader and AutoAdjustColumns
+
+	entry := widget.NewEntry()
+	entry.SetPlaceHolder("Filter")
+	if !withFilter {
+		entry.Hide()
+	}
+	return container.NewVBox(btn, entry)
+}
+
+// headerParts returns the button and filter entry of a header cell made by
+// newHeaderTemplate.
+func headerParts(cell fyne.CanvasObject) (*headerButton, *widget.Entry) {
+	c := cell.(*fyne.Container)
+	return c.Objects[0].(*headerButton), c.Objects[1].(*widget.Entry)
+}
+
+// updateColumnFilterEntry binds a recycled header entry to visible column col.
+func (dt *DataTable) updateColumnFilterEntry(entry *widget.Entry, col int) {
+	if !dt.config.PerColumnFilters {
+		entry.Hide()
+		return
+	}
+	entry.Show()
+
+	name, err := dt.model.VisibleColumnName(col)
+	if err != nil {
+		entry.OnChanged = nil
+		entry.Validator = nil
+		entry.Hide()
+		return
+	}
+	colType, _ := dt.model.VisibleColumnType(col)
+
+	// Swap the callbacks before restoring the text so it does not re-filter
+	entry.OnChanged = nil
+	entry.Validator = nil
+	if text := dt.columnFilterText[name]; entry.Text != text {
+		entry.SetText(text)
+	}
+	// Flag input that is not a number on numeric columns in the entry itself
+	entry.Validator = func(text string) error {
+		_, err := parseColumnFilter(name, colType, text)
+		return err
+	}
+	entry.Validate()
+	entry.OnChanged = func(text string) {
+		dt.setColumnFilterText(name, text)
+	}
+}
+
+// setColumnFilterText records the filter text for a column and re-filters
+// once the user pauses typing.
+func (dt *DataTable) setColumnFilterText(column, text string) {
+	if dt.columnFilterText == nil {
+		dt.columnFilterText = make(map[string]string)
+	}
+	dt.columnFilterText[column] = text
+
+	if dt.columnFilterDebounce == nil {
+		dt.columnFilterDebounce = newDebouncer(dt.config.FilterDebounce, dt.columnFilterClock)
+	}
+	dt.columnFilterDebounce.Trigger(func() {
+		fyne.Do(func() {
+			err := dt.applyColumnFilters()
+			// Invalid input is flagged by the entry's validator
+			if err != nil && !errors.Is(err, datatable.ErrInvalidFilter) {
+				fyne.LogError("Failed to apply column filters", err)
+			}
+		})
+	})
+}
+
+// SetColumnFilter sets the per-column filter text for a column by name and
+// applies the filters. The text follows the rules of the header entries.
+func (dt *DataTable) SetColumnFilter(column, text string) error {
+	if dt.columnFilterText == nil {
+		dt.columnFilterText = make(map[string]string)
+	}
+	dt.columnFilterText[column] = text
+	return dt.applyColumnFilters()
+}
+
+// applyColumnFilters builds the per-column filter from the entry texts and
+// applies it together with the user's filter and the facet selection.
+func (dt *DataTable) applyColumnFilters() error {
+	schema, err := datatable.SchemaOf(dt.model.GetDataSource())
+	if err != nil {
+		return err
+	}
+	columnFilter, err := buildColumnFilter(schema, dt.columnFilterText)
+	if err != nil {
+		return err
+	}
+
+	dt.filterMu.Lock()
+	dt.columnFilter = columnFilter
+	combined := andFilters(dt.userFilter, dt.facetFilter, columnFilter)
+	dt.filterGeneration++
+	dt.filterMu.Unlock()
+
+	if err := dt.model.SetFilter(combined); err != nil {
+		return err
+	}
+	dt.Refresh()
+	dt.notifySortChanged() // Filtering clears the sort
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"errors"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/adapters/slice"
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+func TestParseColumnFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		colType datatable.DataType
+		text    string
+		wantOp  filter.CompareOp
+		wantVal string
+	}{
+		{"number defaults to equal", datatable.TypeInt, "30", filter.OpEqual, "30"},
+		{"greater than", datatable.TypeInt, "> 30", filter.OpGreaterThan, "30"},
+		{"greater or equal", datatable.TypeFloat, ">=1.5", filter.OpGreaterOrEqual, "1.5"},
+		{"less or equal", datatable.TypeFloat, "<=2", filter.OpLessOrEqual, "2"},
+		{"not equal", datatable.TypeInt, "!=4", filter.OpNotEqual, "4"},
+		{"text contains", datatable.TypeString, " eng ", filter.OpContains, "eng"},
+		{"text exact", datatable.TypeString, "=Design", filter.OpEqual, "Design"},
+		{"text not equal", datatable.TypeString, "!= Design", filter.OpNotEqual, "Design"},
+		{"text ordering is literal", datatable.TypeString, ">x", filter.OpContains, ">x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseColumnFilter("Col", tt.colType, tt.text)
+			if err != nil {
+				t.Fatalf("parseColumnFilter() error = %v", err)
+			}
+			simple, ok := f.(*filter.SimpleFilter)
+			if !ok {
+				t.Fatalf("parseColumnFilter() = %T, want *filter.SimpleFilter", f)
+			}
+			if simple.Column != "Col" || simple.Operator != tt.wantOp || simple.Value != tt.wantVal {
+				t.Errorf("parseColumnFilter() = %s %s %v, want Col %s %s",
+					simple.Column, simple.Operator, simple.Value, tt.wantOp, tt.wantVal)
+			}
+			if numeric := isNumericType(tt.colType); numeric != (simple.CompareAs == filter.CompareNumeric) {
+				t.Errorf("parseColumnFilter() CompareAs = %v, want numeric comparison %v", simple.CompareAs, numeric)
+			}
+		})
+	}
+
+	if f, err := parseColumnFilter("Col", datatable.TypeInt, "   "); f != nil || err != nil {
+		t.Errorf("parseColumnFilter(blank) = %v, %v, want nil, nil", f, err)
+	}
+	if _, err := parseColumnFilter("Age", datatable.TypeInt, ">abc"); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("parseColumnFilter(>abc) error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestBuildColumnFilter(t *testing.T) {
+	schema := []datatable.ColumnSchema{
+		{Name: "Name", Type: datatable.TypeString},
+		{Name: "Age", Type: datatable.TypeInt},
+		{Name: "Department", Type: datatable.TypeString},
+	}
+
+	f, err := buildColumnFilter(schema, map[string]string{"Name": "", "Age": ""})
+	if f != nil || err != nil {
+		t.Errorf("buildColumnFilter(blank inputs) = %v, %v, want nil, nil", f, err)
+	}
+
+	// Conditions follow the schema order; unknown columns are ignored
+	f, err = buildColumnFilter(schema, map[string]string{
+		"Department": "eng",
+		"Age":        ">30",
+		"Missing":    "x",
+	})
+	if err != nil {
+		t.Fatalf("buildColumnFilter() error = %v", err)
+	}
+	composite, ok := f.(*filter.CompositeFilter)
+	if !ok {
+		t.Fatalf("buildColumnFilter() = %T, want *filter.CompositeFilter", f)
+	}
+	if composite.Logic != filter.LogicAND || len(composite.Filters) != 2 {
+		t.Fatalf("buildColumnFilter() = %s, want two conditions combined with AND", composite.Description())
+	}
+	if age := composite.Filters[0].(*filter.SimpleFilter); age.Column != "Age" || age.Operator != filter.OpGreaterThan {
+		t.Errorf("first condition = %s, want Age > 30", age.Description())
+	}
+	if dept := composite.Filters[1].(*filter.SimpleFilter); dept.Column != "Department" || dept.Operator != filter.OpContains {
+		t.Errorf("second condition = %s, want Department contains eng", dept.Description())
+	}
+
+	if _, err := buildColumnFilter(schema, map[string]string{"Age": "old"}); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("buildColumnFilter(Age: old) error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestSetColumnFilter(t *testing.T) {
+	test.NewTempApp(t)
+
+	data := [][]any{
+		{"Alice", 30, "Engineering"},
+		{"Bob", 25, "Design"},
+		{"Charlie", 35, "Engineering"},
+		{"Dana", 9, "Engineering"},
+	}
+	source, err := slice.NewFromInterfaces(data, []string{"Name", "Age", "Department"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	config := DefaultConfig()
+	config.PerColumnFilters = true
+	dt := NewDataTableWithConfig(model, config)
+
+	// Render so the header entries are bound to their columns
+	window := test.NewWindow(dt)
+	defer window.Close()
+	window.Resize(fyne.NewSize(800, 600))
+
+	// Numeric comparison: "9" is less than "28"
+	if err := dt.SetColumnFilter("Age", "<28"); err != nil {
+		t.Fatalf("SetColumnFilter failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 2 {
+		t.Errorf("Expected 2 rows with Age < 28, got %d", got)
+	}
+
+	// Column filters combine with AND, and with the search
+	if err := dt.SetColumnFilter("Department", "ENG"); err != nil {
+		t.Fatalf("SetColumnFilter failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row for Age < 28 and Department contains ENG, got %d", got)
+	}
+	if err := dt.GlobalSearch("bob"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 0 {
+		t.Errorf("Expected no rows for the search within the column filters, got %d", got)
+	}
+
+	// Clearing the search keeps the column filters
+	if err := dt.ClearFilter(); err != nil {
+		t.Fatalf("ClearFilter failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row after clearing the search, got %d", got)
+	}
+
+	// Invalid input leaves the view unchanged
+	if err := dt.SetColumnFilter("Age", ">old"); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("SetColumnFilter(>old) error = %v, want ErrInvalidFilter", err)
+	}
+	if got := model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected the previous 1 row after invalid input, got %d", got)
+	}
+
+	if err := dt.SetColumnFilter("Age", ""); err != nil {
+		t.Fatalf("SetColumnFilter failed: %v", err)
+	}
+	if err := dt.SetColumnFilter("Department", ""); err != nil {
+		t.Fatalf("SetColumnFilter failed: %v", err)
+	}
+	if got := model.VisibleRowCount(); got != 4 {
+		t.Errorf("Expected all 4 rows after clearing the column filters, got %d", got)
+	}
+}
+
+func TestColumnFilterEntry_FlagsInvalidNumber(t *testing.T) {
+	test.NewTempApp(t)
+
+	data := [][]any{
+		{"Alice", 30},
+		{"Bob", 25},
+	}
+	source, err := slice.NewFromInterfaces(data, []string{"Name", "Age"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	config := DefaultConfig()
+	config.PerColumnFilters = true
+	dt := NewDataTableWithConfig(model, config)
+	clk := &fakeClock{}
+	dt.columnFilterClock = clk
+
+	window := test.NewWindow(dt)
+	defer window.Close()
+	window.Resize(fyne.NewSize(800, 600))
+
+	cell, _ := laidOutHeader(dt, "Age")
+	if cell == nil {
+		t.Fatal("Age header is not laid out")
+	}
+	_, entry := headerParts(cell)
+
+	test.Type(entry, ">old")
+	if err := entry.Validate(); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("Age entry validation = %v, want ErrInvalidFilter", err)
+	}
+
+	entry.SetText(">26")
+	if err := entry.Validate(); err != nil {
+		t.Errorf("Age entry validation = %v, want nil for a number", err)
+	}
+
+	// The debounced apply runs once typing pauses
+	clk.fireAll()
+	if got := model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row with Age > 26, got %d", got)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/widget"
+)
+
+// tableGrid is the Fyne table behind a DataTable. It reports the columns
+// the user resizes by dragging a header divider, which widget.Table does
+// not expose: the size of each header cell is compared before and after
+// the drag.
+type tableGrid struct {
+	widget.Table
+
+	// OnColumnResized is called after a drag with each visible column
+	// whose width changed. Nil ignores resizes.
+	OnColumnResized func(col int, width float32)
+
+	headerCols  map[fyne.CanvasObject]int     // Header cell -> visible column it shows
+	dragWidths  map[fyne.CanvasObject]float32 // Header cell widths when the drag started
+	dragStarted bool
+}
+
+// newTableGrid creates a table with the given data callbacks.
+func newTableGrid(
+	length func() (int, int),
+	create func() fyne.CanvasObject,
+	update func(widget.TableCellID, fyne.CanvasObject),
+) *tableGrid {
+	g := &tableGrid{
+		Table:      widget.Table{Length: length, CreateCell: create, UpdateCell: update},
+		headerCols: make(map[fyne.CanvasObject]int),
+	}
+	g.ExtendBaseWidget(g)
+	return g
+}
+
+// trackHeader records that cell now shows the header of visible column col.
+func (g *tableGrid) trackHeader(col int, cell fyne.CanvasObject) {
+	g.headerCols[cell] = col
+}
+
+// Dragged remembers the header widths when a drag starts, then resizes
+// as the table does.
+func (g *tableGrid) Dragged(e *fyne.DragEvent) {
+	if !g.dragStarted {
+		g.dragStarted = true
+		g.dragWidths = make(map[fyne.CanvasObject]float32, len(g.headerCols))
+		for cell := range g.headerCols {
+			g.dragWidths[cell] = cell.Size().Width
+		}
+	}
+	g.Table.Dragged(e)
+}
+
+// DragEnd ends the drag and reports the columns it resized.
+func (g *tableGrid) DragEnd() {
+	g.Table.DragEnd()
+	if !g.dragStarted {
+		return
+	}
+	g.dragStarted = false
+
+	for cell, col := range g.headerCols {
+		before, ok := g.dragWidths[cell]
+		if width := cell.Size().Width; ok && width != before && g.OnColumnResized != nil {
+			g.OnColumnResized(col, width)
+		}
+	}
+	g.dragWidths = nil
+}
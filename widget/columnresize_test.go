@@ -0,0 +1,65 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+)
+
+func TestColumnResize_RecordsWidth(t *testing.T) {
+	dt := newClipboardTestTable(t)
+	window := test.NewWindow(dt)
+	defer window.Close()
+	window.Resize(fyne.NewSize(600, 400))
+
+	// Drag the divider between Name and Title to the right
+	driver := fyne.CurrentApp().Driver()
+	var name, title fyne.CanvasObject
+	for cell, col := range dt.table.headerCols {
+		switch {
+		case col == 0 && cell.Visible():
+			name = cell
+		case col == 1 && cell.Visible():
+			title = cell
+		}
+	}
+	if name == nil || title == nil {
+		t.Fatal("Expected the Name and Title headers to be shown")
+	}
+	before := name.Size().Width
+	titlePos := driver.AbsolutePositionForObject(title).Subtract(driver.AbsolutePositionForObject(dt.table))
+	divider := fyne.NewPos(titlePos.X-theme.Padding()/2, titlePos.Y+title.Size().Height/2)
+	mouse := &desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: divider}}
+	dt.table.MouseMoved(mouse)
+	dt.table.MouseDown(mouse)
+	dt.table.Dragged(&fyne.DragEvent{
+		PointEvent: fyne.PointEvent{Position: divider.AddXY(40, 0)},
+		Dragged:    fyne.NewDelta(40, 0),
+	})
+	dt.table.DragEnd()
+
+	widths := dt.model.ExportViewState().ColumnWidths
+	if got, ok := widths[0]; !ok || got <= before {
+		t.Errorf("Saved Name width = %v (saved: %v), want more than %v", got, ok, before)
+	}
+	if _, ok := widths[1]; ok {
+		t.Errorf("Expected no saved width for the untouched Title column, got %v", widths[1])
+	}
+}
@@ -107,13 +107,22 @@ func (cs *ColumnSelector) buildUI() {
 
 // applyColumnVisibility updates the model based on selected checkboxes.
 func (cs *ColumnSelector) applyColumnVisibility() {
-	// Build list of visible column indices
+	// Build list of visible column indices, keeping the current display
+	// order for columns that stay visible and appending newly shown ones
 	visibleIndices := make([]int, 0)
+	included := make(map[int]bool)
 	totalColumns := cs.model.OriginalColumnCount()
 
-	for i := 0; i < totalColumns; i++ {
+	for _, i := range cs.model.GetVisibleColumnIndices() {
 		if check, exists := cs.checkboxes[i]; exists && check.Checked {
 			visibleIndices = append(visibleIndices, i)
+			included[i] = true
+		}
+	}
+
+	for i := 0; i < totalColumns; i++ {
+		if check, exists := cs.checkboxes[i]; exists && check.Checked && !included[i] {
+			visibleIndices = append(visibleIndices, i)
 		}
 	}
 
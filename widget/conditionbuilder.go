@@ -0,0 +1,250 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+// conditionOperators are the operators offered by the condition builder,
+// in display order.
+var conditionOperators = []filter.CompareOp{
+	filter.OpEqual,
+	filter.OpNotEqual,
+	filter.OpGreaterThan,
+	filter.OpLessThan,
+	filter.OpGreaterOrEqual,
+	filter.OpLessOrEqual,
+	filter.OpContains,
+	filter.OpStartsWith,
+	filter.OpEndsWith,
+	filter.OpRegex,
+	filter.OpIsNull,
+	filter.OpIsNotNull,
+}
+
+// Logic choices shown by the condition builder.
+const (
+	matchAllLabel = "Match all (AND)"
+	matchAnyLabel = "Match any (OR)"
+)
+
+// filterCondition is the state of one condition row.
+type filterCondition struct {
+	column   string
+	operator filter.CompareOp
+	value    string
+}
+
+// buildCompositeFilter turns condition rows into a CompositeFilter.
+// Rows without a column are skipped; nil is returned when no row is complete.
+func buildCompositeFilter(conditions []filterCondition, logic filter.LogicOp) datatable.Filter {
+	filters := make([]datatable.Filter, 0, len(conditions))
+	for _, c := range conditions {
+		if c.column == "" {
+			continue
+		}
+		f := &filter.SimpleFilter{
+			Column:   c.column,
+			Operator: c.operator,
+			Value:    c.value,
+		}
+		// An invalid pattern is reported when the filter is applied
+		_ = f.Compile()
+		filters = append(filters, f)
+	}
+
+	if len(filters) == 0 {
+		return nil
+	}
+	return &filter.CompositeFilter{
+		Filters: filters,
+		Logic:   logic,
+	}
+}
+
+// parseCompareOp maps an operator label back to its CompareOp.
+func parseCompareOp(label string) (filter.CompareOp, error) {
+	for _, op := range conditionOperators {
+		if op.String() == label {
+			return op, nil
+		}
+	}
+	return filter.OpEqual, fmt.Errorf("%w: unknown operator %q", datatable.ErrInvalidFilter, label)
+}
+
+// conditionRow holds the widgets of one condition.
+type conditionRow struct {
+	columnSelect   *widget.Select
+	operatorSelect *widget.Select
+	valueEntry     *widget.Entry
+	container      *fyne.Container
+}
+
+// condition reads the row's current state.
+func (r *conditionRow) condition() filterCondition {
+	op, _ := parseCompareOp(r.operatorSelect.Selected)
+	return filterCondition{
+		column:   r.columnSelect.Selected,
+		operator: op,
+		value:    r.valueEntry.Text,
+	}
+}
+
+// ConditionBuilder lets users combine column/operator/value conditions with
+// AND or OR logic instead of writing a filter query.
+type ConditionBuilder struct {
+	widget.BaseWidget
+
+	dataTable *DataTable
+
+	// UI components
+	rows        []*conditionRow
+	rowsBox     *fyne.Container
+	logicSelect *widget.RadioGroup
+	container   *fyne.Container
+}
+
+// NewConditionBuilder creates a condition builder for the given DataTable,
+// starting with one empty condition.
+func NewConditionBuilder(dt *DataTable) *ConditionBuilder {
+	cb := &ConditionBuilder{
+		dataTable: dt,
+	}
+
+	cb.ExtendBaseWidget(cb)
+	cb.buildUI()
+	cb.AddCondition()
+
+	return cb
+}
+
+// buildUI constructs the condition builder's UI.
+func (cb *ConditionBuilder) buildUI() {
+	cb.rowsBox = container.NewVBox()
+
+	cb.logicSelect = widget.NewRadioGroup([]string{matchAllLabel, matchAnyLabel}, nil)
+	cb.logicSelect.Horizontal = true
+	cb.logicSelect.Required = true
+	cb.logicSelect.SetSelected(matchAllLabel)
+
+	addButton := widget.NewButtonWithIcon("Add Condition", theme.ContentAddIcon(), func() {
+		cb.AddCondition()
+	})
+
+	cb.container = container.NewVBox(
+		cb.logicSelect,
+		cb.rowsBox,
+		container.NewHBox(addButton),
+	)
+}
+
+// columnNames returns the names of all columns in the data source.
+func (cb *ConditionBuilder) columnNames() []string {
+	source := cb.dataTable.model.GetDataSource()
+	names := make([]string, 0, source.ColumnCount())
+	for i := 0; i < source.ColumnCount(); i++ {
+		if name, err := source.ColumnName(i); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// AddCondition appends an empty condition row.
+func (cb *ConditionBuilder) AddCondition() {
+	operators := make([]string, len(conditionOperators))
+	for i, op := range conditionOperators {
+		operators[i] = op.String()
+	}
+
+	row := &conditionRow{
+		columnSelect:   widget.NewSelect(cb.columnNames(), nil),
+		operatorSelect: widget.NewSelect(operators, nil),
+		valueEntry:     widget.NewEntry(),
+	}
+	row.columnSelect.PlaceHolder = "Column"
+	row.operatorSelect.SetSelected(filter.OpEqual.String())
+	row.valueEntry.SetPlaceHolder("Value")
+
+	removeButton := widget.NewButtonWithIcon("", theme.ContentRemoveIcon(), func() {
+		cb.removeCondition(row)
+	})
+	row.container = container.NewBorder(
+		nil,
+		nil,
+		container.NewHBox(row.columnSelect, row.operatorSelect),
+		removeButton,
+		row.valueEntry,
+	)
+
+	cb.rows = append(cb.rows, row)
+	cb.rowsBox.Add(row.container)
+}
+
+// removeCondition removes a condition row.
+func (cb *ConditionBuilder) removeCondition(row *conditionRow) {
+	for i, r := range cb.rows {
+		if r == row {
+			cb.rows = append(cb.rows[:i], cb.rows[i+1:]...)
+			cb.rowsBox.Remove(row.container)
+			return
+		}
+	}
+}
+
+// Reset removes all conditions and leaves a single empty one.
+func (cb *ConditionBuilder) Reset() {
+	cb.rows = nil
+	cb.rowsBox.RemoveAll()
+	cb.logicSelect.SetSelected(matchAllLabel)
+	cb.AddCondition()
+}
+
+// logic returns the selected logic operator.
+func (cb *ConditionBuilder) logic() filter.LogicOp {
+	if cb.logicSelect.Selected == matchAnyLabel {
+		return filter.LogicOR
+	}
+	return filter.LogicAND
+}
+
+// conditions returns the state of every row.
+func (cb *ConditionBuilder) conditions() []filterCondition {
+	conditions := make([]filterCondition, len(cb.rows))
+	for i, row := range cb.rows {
+		conditions[i] = row.condition()
+	}
+	return conditions
+}
+
+// Filter builds the composite filter described by the conditions, or nil
+// when no condition has a column selected.
+func (cb *ConditionBuilder) Filter() datatable.Filter {
+	return buildCompositeFilter(cb.conditions(), cb.logic())
+}
+
+// CreateRenderer returns the widget's renderer.
+func (cb *ConditionBuilder) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(cb.container)
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"errors"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+func TestBuildCompositeFilter(t *testing.T) {
+	conditions := []filterCondition{
+		{column: "Department", operator: filter.OpEqual, value: "Engineering"},
+		{column: "", operator: filter.OpEqual, value: "ignored"},
+		{column: "Position", operator: filter.OpContains, value: "dev"},
+	}
+
+	got := buildCompositeFilter(conditions, filter.LogicOR)
+	composite, ok := got.(*filter.CompositeFilter)
+	if !ok {
+		t.Fatalf("Expected *filter.CompositeFilter, got %T", got)
+	}
+	if composite.Logic != filter.LogicOR {
+		t.Errorf("Expected OR logic, got %v", composite.Logic)
+	}
+	if len(composite.Filters) != 2 {
+		t.Fatalf("Expected 2 conditions, got %d", len(composite.Filters))
+	}
+
+	want := []filter.SimpleFilter{
+		{Column: "Department", Operator: filter.OpEqual, Value: "Engineering"},
+		{Column: "Position", Operator: filter.OpContains, Value: "dev"},
+	}
+	for i, f := range composite.Filters {
+		simple := f.(*filter.SimpleFilter)
+		if *simple != want[i] {
+			t.Errorf("Condition %d = %+v, want %+v", i, *simple, want[i])
+		}
+	}
+
+	if got := composite.Description(); got != "(Department = Engineering OR Position contains dev)" {
+		t.Errorf("Description() = %q", got)
+	}
+
+	if got := buildCompositeFilter([]filterCondition{{value: "x"}}, filter.LogicAND); got != nil {
+		t.Errorf("Expected nil filter without columns, got %v", got)
+	}
+}
+
+func TestParseCompareOp(t *testing.T) {
+	for _, op := range conditionOperators {
+		got, err := parseCompareOp(op.String())
+		if err != nil || got != op {
+			t.Errorf("parseCompareOp(%q) = %v, %v; want %v", op.String(), got, err, op)
+		}
+	}
+
+	if _, err := parseCompareOp("~"); !errors.Is(err, datatable.ErrInvalidFilter) {
+		t.Errorf("parseCompareOp(~) error = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestConditionBuilder_UIState(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+	cb := NewConditionBuilder(dt)
+
+	cb.rows[0].columnSelect.SetSelected("Department")
+	cb.rows[0].valueEntry.SetText("Engineering")
+
+	cb.AddCondition()
+	cb.rows[1].columnSelect.SetSelected("Position")
+	cb.rows[1].operatorSelect.SetSelected(filter.OpContains.String())
+	cb.rows[1].valueEntry.SetText("designer")
+
+	cb.logicSelect.SetSelected(matchAnyLabel)
+
+	want := []filterCondition{
+		{column: "Department", operator: filter.OpEqual, value: "Engineering"},
+		{column: "Position", operator: filter.OpContains, value: "designer"},
+	}
+	got := cb.conditions()
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d conditions, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Condition %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if cb.logic() != filter.LogicOR {
+		t.Errorf("Expected OR logic")
+	}
+
+	// Removing a row drops its condition
+	cb.removeCondition(cb.rows[0])
+	if got := cb.conditions(); len(got) != 1 || got[0].column != "Position" {
+		t.Errorf("Expected only the Position condition, got %+v", got)
+	}
+
+	cb.Reset()
+	if len(cb.rows) != 1 || cb.logic() != filter.LogicAND || cb.Filter() != nil {
+		t.Error("Expected Reset to leave one empty AND condition")
+	}
+}
+
+func TestFilterBar_ApplyConditions(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+	fb := dt.filterBar
+
+	fb.builderCheck.SetChecked(true)
+	if !fb.builder.Visible() || !fb.queryEntry.Disabled() {
+		t.Fatal("Expected builder mode to show the builder and disable the query entry")
+	}
+
+	fb.builder.rows[0].columnSelect.SetSelected("Department")
+	fb.builder.rows[0].valueEntry.SetText("Engineering")
+	fb.builder.AddCondition()
+	fb.builder.rows[1].columnSelect.SetSelected("Position")
+	fb.builder.rows[1].operatorSelect.SetSelected(filter.OpContains.String())
+	fb.builder.rows[1].valueEntry.SetText("DevOps")
+
+	// AND: Engineering rows that are DevOps (none in this sample)
+	test.Tap(fb.applyButton)
+	if got := dt.model.VisibleRowCount(); got != 0 {
+		t.Errorf("Expected 0 rows for AND, got %d", got)
+	}
+
+	// OR: Engineering rows plus the DevOps engineer
+	fb.builder.logicSelect.SetSelected(matchAnyLabel)
+	test.Tap(fb.applyButton)
+	if got := dt.model.VisibleRowCount(); got != 3 {
+		t.Errorf("Expected 3 rows for OR, got %d", got)
+	}
+
+	test.Tap(fb.clearButton)
+	if got := dt.model.VisibleRowCount(); got != 5 {
+		t.Errorf("Expected all 5 rows after clearing, got %d", got)
+	}
+}
@@ -15,9 +15,14 @@
 package widget
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
@@ -26,10 +31,11 @@ import (
 	"fyne.io/fyne/v2/widget"
 
 	fynetooltip "github.com/dweymouth/fyne-tooltip"
-	ttwidget "github.com/dweymouth/fyne-tooltip/widget"
 
 	"github.com/magpierre/fyne-datatable/datatable"
 	"github.com/magpierre/fyne-datatable/datatable/expression"
+	"github.com/magpierre/fyne-datatable/internal/export"
+	"github.com/magpierre/fyne-datatable/internal/filter"
 	sortengine "github.com/magpierre/fyne-datatable/internal/sort"
 )
 
@@ -42,23 +48,51 @@ type DataTable struct {
 	// Callbacks
 	headerClickHandler      func(col int)
 	cellSelectHandler       func(row, col int)
+	selectionChangedHandler func(selected []int)
+	sortChangedHandler      func(states []datatable.SortState)
 	expressionEditorHandler func() // Callback for opening expression editor
+	pasteHandler            func(rows [][]string) error
 
 	// Internal state
-	table          *widget.Table
+	table          *tableGrid
 	filterBar      *FilterBar
+	searchBox      *SearchBox
 	statusBar      *StatusBar
 	columnSelector *ColumnSelector
+	facetPanel     *FacetPanel
 	settingsButton *widget.Button
 	window         fyne.Window
 	container      *fyne.Container
-	selectedRow    int          // Currently selected row (-1 if none)
-	selectedRows   map[int]bool // Multiple selected rows (row index -> selected)
-	selectedCell   struct {     // Currently selected cell (for cell selection mode)
+	columnWidths   map[int]float32 // Widths set per visible column index
+	overlay        *tableOverlay   // Empty-state / loading message over the grid
+	loading        bool            // Whether a loading indicator is requested
+	highlightTerm  string          // Text emphasized in cells (empty for none)
+	selectedRow    int             // Currently selected row (-1 if none)
+	selectedRows   map[int]bool    // Multiple selected rows (row index -> selected)
+	selectedCell   struct {        // Currently selected cell (for cell selection mode)
 		row int // -1 if no cell selected
 		col int // -1 if no cell selected
 	}
-	config Config
+	focusedCol int // Column of the last selected cell in either mode (-1 if none)
+	config     Config
+
+	// Sort keys last passed to sortChangedHandler
+	reportedSort []datatable.SortState
+
+	// The applied filter is the user's filter AND the facet selection
+	// AND the per-column filters
+	filterMu     sync.Mutex
+	userFilter   datatable.Filter // From SetFilter, the filter bar or search
+	facetFilter  datatable.Filter // From the facet panel
+	columnFilter datatable.Filter // From the per-column header entries
+
+	// Bumped whenever the rows passing the filters may have changed
+	filterGeneration uint64
+
+	// Per-column filter entry text by column name
+	columnFilterText     map[string]string
+	columnFilterDebounce *debouncer
+	columnFilterClock    clock // Schedules columnFilterDebounce; nil uses real timers
 }
 
 // NewDataTable creates a new DataTable widget with default configuration.
@@ -77,6 +111,7 @@ func NewDataTableWithConfig(model *datatable.TableModel, config Config) *DataTab
 	}
 	dt.selectedCell.row = -1 // No cell selected initially
 	dt.selectedCell.col = -1
+	dt.focusedCol = -1
 
 	dt.ExtendBaseWidget(dt)
 	dt.setupDefaultSorting() // Set up default sorting behavior
@@ -110,7 +145,7 @@ func (dt *DataTable) setupDefaultSorting() {
 
 		// Apply the sort
 		if newDirection == datatable.SortNone {
-			dt.ClearSort()
+			dt.RestoreSourceOrder()
 		} else {
 			if err := dt.SortByColumn(col, newDirection); err != nil {
 				// Sort error - could log or handle silently
@@ -122,39 +157,36 @@ func (dt *DataTable) setupDefaultSorting() {
 
 // buildTable constructs the underlying Fyne table widget.
 func (dt *DataTable) buildTable(config Config) {
-	dt.table = widget.NewTable(
+	dt.columnWidths = nil // Widths belong to the table being replaced
+	dt.table = newTableGrid(
 		func() (int, int) {
 			return dt.model.VisibleRowCount(), dt.model.VisibleColumnCount()
 		},
-		func() fyne.CanvasObject {
-			label := ttwidget.NewLabel("")
-			// Enable ellipsis truncation for text that's too long
-			label.Truncation = fyne.TextTruncateEllipsis
-			return label
-		},
+		newCellTemplate,
 		func(id widget.TableCellID, cell fyne.CanvasObject) {
-			label := cell.(*ttwidget.Label)
+			_, label := cellParts(cell)
+			selected := dt.config.SelectionMode == SelectionModeRow && (dt.selectedRow == id.Row || dt.selectedRows[id.Row])
+			// Stripe and highlight the row; selection and striping compose
+			style := rowStyleFor(id.Row, selected, dt.config.ZebraStripe)
+			dt.applyRowStyle(cell, style)
+
 			value, err := dt.model.VisibleCell(id.Row, id.Col)
 			if err != nil {
 				label.SetText("Error")
 				label.SetToolTip("")
+				dt.applyHighlight(cell, "", style)
 				return
 			}
 
-			text := value.Formatted
+			colType, _ := dt.model.VisibleColumnType(id.Col)
+			text := cellText(value, colType, dt.config.FloatFormat)
 			label.SetText(text)
 
 			// Always set tooltip to show full cell content
-			label.SetToolTip(text)
+			label.SetToolTip(cellToolTip(value, text, dt.config.TooltipShowsRaw))
 
-			// Highlight entire row if in row selection mode and this row is selected
-			if dt.config.SelectionMode == SelectionModeRow && (dt.selectedRow == id.Row || dt.selectedRows[id.Row]) {
-				label.Importance = widget.HighImportance
-				label.TextStyle = fyne.TextStyle{Bold: true}
-			} else {
-				label.Importance = widget.MediumImportance
-				label.TextStyle = fyne.TextStyle{}
-			}
+			// Emphasize search matches
+			dt.applyHighlight(cell, text, style)
 		},
 	)
 
@@ -165,25 +197,27 @@ func (dt *DataTable) buildTable(config Config) {
 	if config.SelectionMode == SelectionModeRow {
 		// Row selection mode - select entire row with checkboxes
 		// Set minimum size for header column buttons to make them more visible
-		dt.table.SetColumnWidth(0, 120) // Make row number column wider for checkboxes
+		dt.showColumnWidth(0, 120) // Make row number column wider for checkboxes
 	} else {
 		// Cell selection mode (default) - show simple row numbers
-		dt.table.SetColumnWidth(0, 60) // Narrower column for simple row numbers
+		dt.showColumnWidth(0, 60) // Narrower column for simple row numbers
 	}
 
-	// Enable and configure column headers
+	// Enable and configure column headers. The table draws its header row
+	// outside its scroller, so the headers stay pinned while the rows
+	// scroll in every layout buildLayout produces.
 	dt.table.ShowHeaderRow = true
 	dt.table.CreateHeader = func() fyne.CanvasObject {
-		// Create a button that can be used for both row numbers and column headers
-		btn := widget.NewButton("", nil)
-		btn.Importance = widget.MediumImportance // Medium importance for better centered text
-		// Size will be set by UpdateHeader and AutoAdjustColumns
-		return btn
+		// Used for both row numbers and column headers
+		return newHeaderTemplate(config.PerColumnFilters)
 	}
 	dt.table.UpdateHeader = func(id widget.TableCellID, cell fyne.CanvasObject) {
 		// Handle row number buttons (header column)
 		if id.Col == -1 {
-			btn := cell.(*widget.Button)
+			btn, entry := headerParts(cell)
+			btn.OnDragEnd = nil // Row numbers cannot be reordered
+			btn.OnTappedSecondary = nil
+			entry.Hide()
 
 			if config.SelectionMode == SelectionModeRow {
 				// Row selection mode - show toggle button with row number
@@ -202,15 +236,7 @@ func (dt *DataTable) buildTable(config Config) {
 
 				// Set click handler for toggle functionality
 				btn.OnTapped = func() {
-					// Toggle selection state
-					dt.selectedRows[rowIndex] = !dt.selectedRows[rowIndex]
-
-					// Update single selection if this is the only selected row
-					if dt.selectedRows[rowIndex] {
-						dt.selectedRow = rowIndex
-					} else {
-						dt.selectedRow = -1
-					}
+					dt.toggleRowSelection(rowIndex)
 
 					// Refresh to show updated highlighting
 					dt.table.Refresh()
@@ -232,7 +258,11 @@ func (dt *DataTable) buildTable(config Config) {
 		}
 
 		// Handle column headers
-		btn := cell.(*widget.Button)
+		dt.table.trackHeader(id.Col, cell)
+		btn, entry := headerParts(cell)
+		btn.OnDragEnd = nil // Set below once the column is known
+		btn.OnTappedSecondary = nil
+		dt.updateColumnFilterEntry(entry, id.Col)
 
 		// Use medium importance for better centered text appearance
 		btn.Importance = widget.MediumImportance
@@ -251,14 +281,7 @@ func (dt *DataTable) buildTable(config Config) {
 		}
 
 		// Add sort indicator if this column is sorted
-		sortState := dt.model.GetSortState()
-		if sortState.IsSorted() && sortState.Column == id.Col {
-			if sortState.Direction == datatable.SortAscending {
-				headerText += " ↑"
-			} else if sortState.Direction == datatable.SortDescending {
-				headerText += " ↓"
-			}
-		}
+		headerText += sortIndicator(dt.model.GetSortStates(), id.Col)
 		btn.SetText(headerText)
 
 		// Set click handler for this column
@@ -268,31 +291,24 @@ func (dt *DataTable) buildTable(config Config) {
 				dt.headerClickHandler(colIndex)
 			}
 		}
+
+		// Drag the header sideways to reorder columns
+		btn.OnDragEnd = func(dx float32) {
+			dt.handleHeaderDrag(btn, colIndex, dx)
+		}
+
+		// Right-click a column to copy it, or to edit or remove a computed one
+		btn.OnTappedSecondary = func(pos fyne.Position) {
+			dt.showColumnMenu(colIndex, pos)
+		}
 	}
 
 	// Set selection handler based on mode
 	if config.SelectionMode == SelectionModeRow {
 		// Row selection mode - notify with full row
 		dt.table.OnSelected = func(id widget.TableCellID) {
-			// Toggle the row in multi-selection map
-			dt.selectedRows[id.Row] = !dt.selectedRows[id.Row]
-
-			// Update single selection to track the most recently clicked row
-			if dt.selectedRows[id.Row] {
-				dt.selectedRow = id.Row
-			} else {
-				// If deselected, set to -1 if no other rows selected
-				hasSelection := false
-				for _, selected := range dt.selectedRows {
-					if selected {
-						hasSelection = true
-						break
-					}
-				}
-				if !hasSelection {
-					dt.selectedRow = -1
-				}
-			}
+			dt.focusedCol = id.Col // For SortKey and the status bar null count
+			dt.toggleRowSelection(id.Row)
 
 			dt.table.Refresh()
 			dt.Refresh() // Also refresh the DataTable widget itself
@@ -308,6 +324,7 @@ func (dt *DataTable) buildTable(config Config) {
 			// Store the selected cell coordinates
 			dt.selectedCell.row = id.Row
 			dt.selectedCell.col = id.Col
+			dt.focusedCol = id.Col
 
 			// Clear row selection in cell mode and refresh
 			dt.selectedRow = -1
@@ -323,7 +340,7 @@ func (dt *DataTable) buildTable(config Config) {
 	// Set minimum column width if specified
 	if config.MinColumnWidth > 0 {
 		for i := 0; i < dt.model.VisibleColumnCount(); i++ {
-			dt.table.SetColumnWidth(i, float32(config.MinColumnWidth))
+			dt.showColumnWidth(i, float32(config.MinColumnWidth))
 		}
 	}
 
@@ -332,10 +349,34 @@ func (dt *DataTable) buildTable(config Config) {
 		dt.AutoAdjustColumns()
 	}
 
+	// Widths the user set win over the defaults
+	dt.restoreColumnWidths()
+	dt.table.OnColumnResized = dt.setColumnWidth
+
 	// Enable focus for keyboard shortcuts
 	// Note: Table widget doesn't have OnTapped, so we'll handle focus differently
 }
 
+// sortIndicator returns the header suffix for a visible column given the
+// model's sort keys: an arrow for the direction, followed by the key's
+// priority when more than one column is sorted (e.g. " ↑2").
+func sortIndicator(states []datatable.SortState, col int) string {
+	for i, state := range states {
+		if state.Column != col {
+			continue
+		}
+		arrow := " ↑"
+		if state.Direction == datatable.SortDescending {
+			arrow = " ↓"
+		}
+		if len(states) > 1 {
+			return arrow + strconv.Itoa(i+1)
+		}
+		return arrow
+	}
+	return ""
+}
+
 // isComputedColumn checks if the given visible column index corresponds to a computed column.
 func (dt *DataTable) isComputedColumn(visibleColIndex int) bool {
 	if dt.model == nil {
@@ -403,7 +444,7 @@ func (dt *DataTable) AutoAdjustColumns() {
 		}
 
 		// Set the column width
-		dt.table.SetColumnWidth(col, width)
+		dt.showColumnWidth(col, width)
 	}
 
 	// Refresh the table to apply changes
@@ -427,6 +468,11 @@ func (dt *DataTable) buildLayout() {
 		topComponents = append(topComponents, dt.filterBar)
 	}
 
+	if dt.config.ShowSearchBox {
+		dt.searchBox = NewSearchBox(dt)
+		topComponents = append(topComponents, dt.searchBox)
+	}
+
 	if len(topComponents) > 0 {
 		top = container.NewVBox(topComponents...)
 	}
@@ -454,13 +500,46 @@ func (dt *DataTable) buildLayout() {
 		bottom = container.NewBorder(nil, nil, nil, dt.settingsButton, dt.statusBar)
 	}
 
+	// Facet lists sit beside the grid
+	var left fyne.CanvasObject
+	if len(dt.config.FacetColumns) > 0 {
+		dt.facetPanel = NewFacetPanel(dt, dt.config.FacetColumns)
+		left = dt.facetPanel
+	}
+
+	// Stack the empty-state / loading overlay above the grid
+	dt.overlay = newTableOverlay()
+	center := container.NewStack(dt.table, dt.overlay.container)
+	dt.updateOverlay()
+
 	// Build container with border layout (no right component now)
-	dt.container = container.NewBorder(top, bottom, nil, nil, dt.table)
+	dt.container = container.NewBorder(top, bottom, left, nil, center)
+}
+
+// SetLoading shows or hides a loading indicator over the table.
+// While loading is active it replaces the empty-state message.
+func (dt *DataTable) SetLoading(loading bool) {
+	dt.loading = loading
+	dt.updateOverlay()
+}
+
+// IsLoading reports whether the loading indicator is active.
+func (dt *DataTable) IsLoading() bool {
+	return dt.loading
+}
+
+// updateOverlay syncs the overlay with the current row count and loading flag.
+func (dt *DataTable) updateOverlay() {
+	if dt.overlay == nil {
+		return
+	}
+	state := overlayStateFor(dt.model.VisibleRowCount(), dt.loading)
+	dt.overlay.apply(state, dt.config.EmptyStateText)
 }
 
 // SetWindow sets the window reference for the DataTable.
 // This is required for the settings dialog to work properly.
-// It also registers keyboard shortcuts for copy operations.
+// It also registers keyboard shortcuts for copy, paste and select all.
 func (dt *DataTable) SetWindow(window fyne.Window) {
 	dt.window = window
 
@@ -488,6 +567,34 @@ func (dt *DataTable) SetWindow(window fyne.Window) {
 			Modifier: fyne.KeyModifierSuper,
 		}
 		window.Canvas().AddShortcut(cmdCShortcut, copyHandler)
+
+		// Register Ctrl+A / CMD+A to select all visible rows
+		selectAllHandler := func(shortcut fyne.Shortcut) {
+			if dt.config.SelectionMode == SelectionModeRow {
+				dt.SelectAllVisible()
+			}
+		}
+		window.Canvas().AddShortcut(&desktop.CustomShortcut{
+			KeyName:  fyne.KeyA,
+			Modifier: fyne.KeyModifierControl,
+		}, selectAllHandler)
+		window.Canvas().AddShortcut(&desktop.CustomShortcut{
+			KeyName:  fyne.KeyA,
+			Modifier: fyne.KeyModifierSuper,
+		}, selectAllHandler)
+
+		// Register Ctrl+V / CMD+V to paste the clipboard into the table
+		pasteHandler := func(shortcut fyne.Shortcut) {
+			dt.pasteShortcut()
+		}
+		window.Canvas().AddShortcut(&desktop.CustomShortcut{
+			KeyName:  fyne.KeyV,
+			Modifier: fyne.KeyModifierControl,
+		}, pasteHandler)
+		window.Canvas().AddShortcut(&desktop.CustomShortcut{
+			KeyName:  fyne.KeyV,
+			Modifier: fyne.KeyModifierSuper,
+		}, pasteHandler)
 	}
 }
 
@@ -540,6 +647,36 @@ func (dt *DataTable) OnCellSelected(handler func(row, col int)) {
 	// Note: The actual handler is set in buildTable() based on SelectionMode
 }
 
+// sortBySortStates sorts the visible rows by all of the model's sort keys,
+// e.g. after they were restored or remapped. Without keys the rows keep
+// their order.
+func (dt *DataTable) sortBySortStates() error {
+	states := dt.model.GetSortStates()
+	if len(states) == 0 {
+		return nil
+	}
+
+	source := dt.model.GetDataSource()
+	visibleCols := dt.model.GetVisibleColumnIndices()
+	specs := make([]sortengine.SortSpec, len(states))
+	for i, state := range states {
+		originalCol := visibleCols[state.Column]
+		colType, _ := source.ColumnType(originalCol)
+		specs[i] = sortengine.SortSpec{
+			Column:         originalCol,
+			Direction:      state.Direction,
+			DataType:       colType,
+			AutoDetectType: dt.config.AutoDetectSortTypes,
+		}
+	}
+
+	sortedIndices, err := sortengine.NewEngine().MultiSort(source, dt.model.GetVisibleRowIndices(), specs)
+	if err != nil {
+		return err
+	}
+	return dt.model.ApplySortedIndices(sortedIndices)
+}
+
 // SortByColumn sorts the table by the specified column.
 func (dt *DataTable) SortByColumn(col int, direction datatable.SortDirection) error {
 	// Set sort state in model
@@ -563,9 +700,10 @@ func (dt *DataTable) SortByColumn(col int, direction datatable.SortDirection) er
 		dt.model.GetDataSource(),
 		dt.model.GetVisibleRowIndices(),
 		sortengine.SortSpec{
-			Column:    originalCol,
-			Direction: direction,
-			DataType:  colType,
+			Column:         originalCol,
+			Direction:      direction,
+			DataType:       colType,
+			AutoDetectType: dt.config.AutoDetectSortTypes,
 		},
 	)
 	if err != nil {
@@ -578,6 +716,7 @@ func (dt *DataTable) SortByColumn(col int, direction datatable.SortDirection) er
 	}
 
 	dt.Refresh()
+	dt.notifySortChanged()
 	return nil
 }
 
@@ -587,29 +726,123 @@ func (dt *DataTable) ClearSort() error {
 		return err
 	}
 	dt.Refresh()
+	dt.notifySortChanged()
 	return nil
 }
 
-// SetFilter applies a filter to the table.
+// RestoreSourceOrder shows the visible rows in data source order again,
+// keeping any applied filter.
+func (dt *DataTable) RestoreSourceOrder() {
+	dt.model.RestoreSourceOrder()
+	dt.Refresh()
+	dt.notifySortChanged()
+}
+
+// SetFilter applies a filter to the table, replacing the previous one.
+// Values checked in the facet panel and per-column filter entries keep
+// restricting the rows as well.
 func (dt *DataTable) SetFilter(filter datatable.Filter) error {
-	if err := dt.model.SetFilter(filter); err != nil {
+	return dt.SetFilterContext(context.Background(), filter)
+}
+
+// SetFilterContext applies a filter to the table, abandoning the filter pass
+// if ctx is cancelled. A cancelled pass leaves the current view unchanged.
+func (dt *DataTable) SetFilterContext(ctx context.Context, filter datatable.Filter) error {
+	if err := dt.applyUserFilter(ctx, filter); err != nil {
+		return err
+	}
+	dt.Refresh()
+	dt.notifySortChanged() // Filtering clears the sort
+	return nil
+}
+
+// applyUserFilter replaces the user's filter and applies it together with
+// the facet selection and the per-column filters, without refreshing the
+// widget.
+func (dt *DataTable) applyUserFilter(ctx context.Context, filter datatable.Filter) error {
+	dt.filterMu.Lock()
+	combined := andFilters(filter, dt.facetFilter, dt.columnFilter)
+	dt.filterMu.Unlock()
+
+	// Show the running estimate while the pass is in progress
+	if dt.statusBar != nil {
+		defer dt.statusBar.trackProgress(ctx)()
+	}
+
+	if err := dt.model.SetFilterContext(ctx, combined); err != nil {
+		return err
+	}
+
+	dt.filterMu.Lock()
+	dt.userFilter = filter
+	dt.filterGeneration++
+	dt.filterMu.Unlock()
+	return nil
+}
+
+// setFacetFilter replaces the facet selection filter and applies it together
+// with the user's filter and the per-column filters.
+func (dt *DataTable) setFacetFilter(facetFilter datatable.Filter) error {
+	dt.filterMu.Lock()
+	dt.facetFilter = facetFilter
+	combined := andFilters(dt.userFilter, facetFilter, dt.columnFilter)
+	dt.filterGeneration++
+	dt.filterMu.Unlock()
+
+	if err := dt.model.SetFilter(combined); err != nil {
 		return err
 	}
 	dt.Refresh()
+	dt.notifySortChanged() // Filtering clears the sort
 	return nil
 }
 
 // SetExpressionEditorHandler sets the callback function for opening the expression editor.
+// Without a handler, the settings dialog opens the built-in editor from
+// ShowExpressionEditor when the source is an ExpressionDataSource.
 func (dt *DataTable) SetExpressionEditorHandler(handler func()) {
 	dt.expressionEditorHandler = handler
 }
 
-// ClearFilter removes any active filter.
+// ClearFilter removes the filter applied through SetFilter, the filter bar
+// or the search box. Facet panel selections stay applied.
 func (dt *DataTable) ClearFilter() error {
-	if err := dt.model.SetFilter(nil); err != nil {
+	return dt.SetFilter(nil)
+}
+
+// GlobalSearch filters the table to rows where any visible column contains
+// term, ignoring case, and highlights the matches. An empty term removes the
+// filter and the highlight. Like SetFilter, the search replaces any filter
+// applied through the filter bar.
+func (dt *DataTable) GlobalSearch(term string) error {
+	if strings.TrimSpace(term) == "" {
+		dt.highlightTerm = ""
+		return dt.ClearFilter()
+	}
+
+	// Highlight the matches the filter keeps
+	dt.highlightTerm = term
+	return dt.SetFilter(&filter.SearchFilter{
+		Term:    term,
+		Columns: dt.model.GetVisibleColumnIndices(),
+	})
+}
+
+// ApplyViewState restores a view state on the model, re-runs the restored
+// sort keys and applies any saved column widths.
+func (dt *DataTable) ApplyViewState(state datatable.ViewState) error {
+	if err := dt.model.ApplyViewState(state); err != nil {
 		return err
 	}
+
+	dt.restoreColumnWidths()
+
+	if err := dt.sortBySortStates(); err != nil {
+		return err
+	}
+
 	dt.Refresh()
+	dt.notifySortChanged()
 	return nil
 }
 
@@ -620,10 +853,15 @@ func (dt *DataTable) Reconfigure(newConfig Config) {
 	dt.config = newConfig
 
 	// Clear selection when reconfiguring
+	hadSelection := len(dt.selectedRowIndices()) > 0
 	dt.selectedRow = -1
 	dt.selectedRows = make(map[int]bool) // Clear multi-selection
 	dt.selectedCell.row = -1             // Clear cell selection
 	dt.selectedCell.col = -1
+	dt.focusedCol = -1
+	if hadSelection {
+		dt.notifySelectionChanged()
+	}
 
 	// Save reference to old container that renderer is using
 	oldContainer := dt.container
@@ -659,6 +897,10 @@ func (dt *DataTable) Refresh() {
 	if dt.statusBar != nil {
 		dt.statusBar.Update()
 	}
+	if dt.facetPanel != nil {
+		dt.facetPanel.Update()
+	}
+	dt.updateOverlay()
 	dt.BaseWidget.Refresh()
 }
 
@@ -680,24 +922,83 @@ const (
 // Config holds configuration options for DataTable.
 type Config struct {
 	ShowFilterBar          bool
+	ShowSearchBox          bool
 	ShowStatusBar          bool
+	ShowStatusDetail       bool // Include filter and sort state in the status bar
 	ShowColumnSelector     bool
 	ShowSettingsButton     bool
 	AutoAdjustColumnWidths bool
 	SelectionMode          SelectionMode
 	MinColumnWidth         int
+
+	// FilterDebounce is how long the filter bar waits after the last
+	// keystroke before re-filtering. Zero applies on every keystroke.
+	FilterDebounce time.Duration
+
+	// IncludeHeadersOnCopy prepends a header row when copying rows as
+	// TSV or CSV. JSON output always keys values by column name.
+	IncludeHeadersOnCopy bool
+
+	// EmptyStateText is shown centered over the table when no rows are
+	// visible. Empty uses DefaultEmptyStateText.
+	EmptyStateText string
+
+	// ZebraStripe draws StripeColor behind every other visible row.
+	ZebraStripe bool
+	// StripeColor is the theme color name used for stripes.
+	// Empty uses DefaultStripeColor.
+	StripeColor fyne.ThemeColorName
+
+	// FloatFormat is a fmt verb (e.g. "%.4f" or "%.3e") used to display
+	// float columns from their raw values. Empty shows the data source's
+	// own formatting. Only the display changes; raw values are untouched.
+	FloatFormat string
+
+	// SortKey is the key that cycles the sort of the selected cell's column
+	// (none, ascending, descending) like clicking its header. Empty
+	// disables it.
+	SortKey fyne.KeyName
+
+	// AutoDetectSortTypes sorts columns the data source reports as strings
+	// by the type their values look like, so numbers, dates and booleans
+	// from untyped sources (e.g. CSV text) sort by value rather than
+	// alphabetically.
+	AutoDetectSortTypes bool
+
+	// TooltipShowsRaw makes cell tooltips show the raw value (e.g. the
+	// full-precision float) instead of the displayed text.
+	TooltipShowsRaw bool
+
+	// FacetColumns lists original column indices shown in a facet panel
+	// beside the table. Each column gets a checkable list of its distinct
+	// values; checked values filter the rows. Empty hides the panel.
+	FacetColumns []int
+
+	// PerColumnFilters shows a filter entry under each column header.
+	// Numeric columns accept a comparison such as ">10" or "<=5"; other
+	// columns match cells containing the text. Non-empty entries are
+	// combined with AND.
+	PerColumnFilters bool
 }
 
 // DefaultConfig returns a Config with default values.
 func DefaultConfig() Config {
 	return Config{
 		ShowFilterBar:          true,
+		ShowSearchBox:          false,
 		ShowStatusBar:          true,
+		ShowStatusDetail:       true,
 		ShowColumnSelector:     false,
 		ShowSettingsButton:     true, // Show settings button by default
 		AutoAdjustColumnWidths: false,
 		SelectionMode:          SelectionModeRow, // Default to row selection
 		MinColumnWidth:         100,
+		FilterDebounce:         250 * time.Millisecond,
+		IncludeHeadersOnCopy:   true,
+		EmptyStateText:         DefaultEmptyStateText,
+		ZebraStripe:            false,
+		StripeColor:            DefaultStripeColor,
+		SortKey:                fyne.KeyS,
 	}
 }
 
@@ -716,10 +1017,52 @@ func DefaultConfig() Config {
 // CopySelectedRows copies the selected rows to the clipboard as tab-separated values.
 // This method handles both single and multi-row selection.
 func (dt *DataTable) CopySelectedRows() error {
+	return dt.CopySelectedRowsAs(ClipboardTSV)
+}
+
+// CopySelectedRowsAs copies the selected rows to the clipboard in the given format.
+// This method handles both single and multi-row selection.
+func (dt *DataTable) CopySelectedRowsAs(format ClipboardFormat) error {
+	copiedText, err := dt.formatSelectedRows(format)
+	if err != nil {
+		return err
+	}
+
+	// Copy to clipboard
+	if dt.window != nil {
+		dt.window.Clipboard().SetContent(copiedText)
+	}
+
+	return nil
+}
+
+// formatSelectedRows serializes the selected rows (visible columns only) in the given format.
+func (dt *DataTable) formatSelectedRows(format ClipboardFormat) (string, error) {
 	if dt.config.SelectionMode != SelectionModeRow {
-		return fmt.Errorf("copy is only available in row selection mode")
+		return "", fmt.Errorf("copy is only available in row selection mode")
+	}
+
+	selectedRowIndices := dt.selectedRowIndices()
+	if len(selectedRowIndices) == 0 {
+		return "", fmt.Errorf("no rows selected")
 	}
 
+	switch format {
+	case ClipboardTSV:
+		return dt.formatRowsTSV(selectedRowIndices), nil
+	case ClipboardCSV:
+		csvConfig := export.DefaultCSVConfig()
+		csvConfig.IncludeHeaders = dt.config.IncludeHeadersOnCopy
+		return dt.exportRows(selectedRowIndices, export.NewCSVExporterWithConfig(csvConfig))
+	case ClipboardJSON:
+		return dt.exportRows(selectedRowIndices, export.NewJSONExporter())
+	default:
+		return "", fmt.Errorf("unsupported clipboard format: %s", format)
+	}
+}
+
+// selectedRowIndices returns the selected visible row indices in ascending order.
+func (dt *DataTable) selectedRowIndices() []int {
 	// Get selected rows - check multi-selection map first
 	var selectedRowIndices []int
 
@@ -735,50 +1078,68 @@ func (dt *DataTable) CopySelectedRows() error {
 		selectedRowIndices = []int{dt.selectedRow}
 	}
 
-	if len(selectedRowIndices) == 0 {
-		return fmt.Errorf("no rows selected")
-	}
-
 	// Sort the indices to maintain row order in the clipboard
 	sort.Ints(selectedRowIndices)
+	return selectedRowIndices
+}
 
+// formatRowsTSV joins the given visible rows with tabs and newlines,
+// preceded by a header row when IncludeHeadersOnCopy is set.
+func (dt *DataTable) formatRowsTSV(rowIndices []int) string {
 	// Build the copied data
 	var rows []string
 
 	// Add header row
-	var headerRow []string
-	for col := 0; col < dt.model.VisibleColumnCount(); col++ {
-		colName, err := dt.model.VisibleColumnName(col)
-		if err != nil {
-			colName = fmt.Sprintf("Column %d", col)
+	if dt.config.IncludeHeadersOnCopy {
+		var headerRow []string
+		for col := 0; col < dt.model.VisibleColumnCount(); col++ {
+			colName, err := dt.model.VisibleColumnName(col)
+			if err != nil {
+				colName = fmt.Sprintf("Column %d", col)
+			}
+			headerRow = append(headerRow, colName)
 		}
-		headerRow = append(headerRow, colName)
+		rows = append(rows, strings.Join(headerRow, "\t"))
 	}
-	rows = append(rows, strings.Join(headerRow, "\t"))
 
 	// Add data rows
-	for _, rowIndex := range selectedRowIndices {
+	for _, rowIndex := range rowIndices {
 		var rowData []string
 		for col := 0; col < dt.model.VisibleColumnCount(); col++ {
-			cell, err := dt.model.VisibleCell(rowIndex, col)
-			if err != nil {
-				rowData = append(rowData, "Error")
-			} else {
-				rowData = append(rowData, cell.Formatted)
-			}
+			rowData = append(rowData, dt.visibleCellText(rowIndex, col))
 		}
 		rows = append(rows, strings.Join(rowData, "\t"))
 	}
 
 	// Join all rows with newlines
-	copiedText := strings.Join(rows, "\n")
+	return strings.Join(rows, "\n")
+}
 
-	// Copy to clipboard
-	if dt.window != nil {
-		dt.window.Clipboard().SetContent(copiedText)
+// exportRows serializes the given visible rows with an export.Exporter.
+func (dt *DataTable) exportRows(rowIndices []int, exporter export.Exporter) (string, error) {
+	visibleRows := dt.model.GetVisibleRowIndices()
+	originalRows := make([]int, 0, len(rowIndices))
+	for _, row := range rowIndices {
+		if row < 0 || row >= len(visibleRows) {
+			return "", fmt.Errorf("%w: %d", datatable.ErrInvalidRow, row)
+		}
+		originalRows = append(originalRows, visibleRows[row])
 	}
 
-	return nil
+	iterator, err := export.NewModelIteratorWithColumns(
+		dt.model.GetDataSource(),
+		originalRows,
+		dt.model.GetVisibleColumnIndices(),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to format rows: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := exporter.Export(&buf, iterator, nil); err != nil {
+		return "", fmt.Errorf("failed to format rows: %w", err)
+	}
+	return buf.String(), nil
 }
 
 // CopySelectedCell copies the selected cell to the clipboard.
@@ -798,15 +1159,63 @@ func (dt *DataTable) CopySelectedCell() error {
 	if err != nil {
 		return fmt.Errorf("error getting cell value: %w", err)
 	}
+	colType, _ := dt.model.VisibleColumnType(dt.selectedCell.col)
 
-	// Copy to clipboard - just the cell value, no header
+	// Copy to clipboard - just the cell text as displayed, no header
 	if dt.window != nil {
-		dt.window.Clipboard().SetContent(cell.Formatted)
+		dt.window.Clipboard().SetContent(cellText(cell, colType, dt.config.FloatFormat))
 	}
 
 	return nil
 }
 
+// CopyColumn copies a visible column to the clipboard: its header, when
+// IncludeHeadersOnCopy is set, then its value in each visible row, one
+// value per line, e.g. to paste a list of email addresses.
+// Returns ErrInvalidColumn if visibleCol is out of range.
+func (dt *DataTable) CopyColumn(visibleCol int) error {
+	text, err := dt.formatColumn(visibleCol)
+	if err != nil {
+		return err
+	}
+
+	if dt.window != nil {
+		dt.window.Clipboard().SetContent(text)
+	}
+	return nil
+}
+
+// formatColumn joins a visible column's values across the visible rows with
+// newlines, preceded by its header when IncludeHeadersOnCopy is set.
+func (dt *DataTable) formatColumn(visibleCol int) (string, error) {
+	colName, err := dt.model.VisibleColumnName(visibleCol)
+	if err != nil {
+		return "", err
+	}
+
+	rowCount := dt.model.VisibleRowCount()
+	lines := make([]string, 0, rowCount+1)
+	if dt.config.IncludeHeadersOnCopy {
+		lines = append(lines, colName)
+	}
+	for row := 0; row < rowCount; row++ {
+		lines = append(lines, dt.visibleCellText(row, visibleCol))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// visibleCellText returns the text displayed for a visible cell, so copied
+// values match the table, or "Error" if the cell cannot be read.
+func (dt *DataTable) visibleCellText(row, col int) string {
+	cell, err := dt.model.VisibleCell(row, col)
+	if err != nil {
+		return "Error"
+	}
+	colType, _ := dt.model.VisibleColumnType(col)
+	return cellText(cell, colType, dt.config.FloatFormat)
+}
+
 // TypedKey handles keyboard events for the DataTable.
 // This enables keyboard shortcuts like Cmd+C for copying selected rows or cells.
 func (dt *DataTable) TypedKey(event *fyne.KeyEvent) {
@@ -819,6 +1228,12 @@ func (dt *DataTable) TypedKey(event *fyne.KeyEvent) {
 			_ = dt.CopySelectedCell()
 		}
 	}
+
+	// Sort the focused column as a header click would
+	if dt.config.SortKey != "" && event.Name == dt.config.SortKey &&
+		dt.focusedCol >= 0 && dt.headerClickHandler != nil {
+		dt.headerClickHandler(dt.focusedCol)
+	}
 }
 
 // FocusGained is called when the DataTable receives focus.
@@ -0,0 +1,130 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/adapters/memory"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// laidOutHeader returns the shown header cell of the column named name and
+// its position relative to the table, or nil if it is not shown.
+func laidOutHeader(dt *DataTable, name string) (fyne.CanvasObject, fyne.Position) {
+	driver := fyne.CurrentApp().Driver()
+	tablePos := driver.AbsolutePositionForObject(dt.table)
+	for cell := range dt.table.headerCols {
+		if btn, _ := headerParts(cell); cell.Visible() && btn.Text == name {
+			return cell, driver.AbsolutePositionForObject(cell).Subtract(tablePos)
+		}
+	}
+	return nil, fyne.Position{}
+}
+
+func TestHeaderStaysPinned(t *testing.T) {
+	// Each layout buildLayout can produce around the grid
+	layouts := []struct {
+		name      string
+		configure func(*Config)
+	}{
+		{"default", func(*Config) {}},
+		{"bare", func(c *Config) { c.ShowFilterBar, c.ShowStatusBar, c.ShowSettingsButton = false, false, false }},
+		{"search and columns", func(c *Config) { c.ShowSearchBox, c.ShowColumnSelector = true, true }},
+		{"facets", func(c *Config) { c.FacetColumns = []int{1} }},
+		{"column filters", func(c *Config) { c.PerColumnFilters = true }},
+		{"cell selection", func(c *Config) { c.SelectionMode = SelectionModeCell }},
+	}
+
+	for _, layout := range layouts {
+		t.Run(layout.name, func(t *testing.T) {
+			test.NewTempApp(t)
+
+			data := make([][]string, 200)
+			for i := range data {
+				data[i] = []string{fmt.Sprintf("row %d", i), fmt.Sprintf("group %d", i%3)}
+			}
+			source, err := memory.NewDataSource(data, []string{"Name", "Group"})
+			if err != nil {
+				t.Fatalf("Failed to create data source: %v", err)
+			}
+			model, err := datatable.NewTableModel(source)
+			if err != nil {
+				t.Fatalf("Failed to create model: %v", err)
+			}
+			config := DefaultConfig()
+			layout.configure(&config)
+			dt := NewDataTableWithConfig(model, config)
+
+			window := test.NewWindow(dt)
+			defer window.Close()
+			window.Resize(fyne.NewSize(600, 400))
+
+			// Track the rows drawn to see the body scroll
+			lastRow := -1
+			updateCell := dt.table.UpdateCell
+			dt.table.UpdateCell = func(id widget.TableCellID, cell fyne.CanvasObject) {
+				lastRow = max(lastRow, id.Row)
+				updateCell(id, cell)
+			}
+
+			header, before := laidOutHeader(dt, "Name")
+			if header == nil {
+				t.Fatal("Expected the Name header to be shown")
+			}
+
+			dt.table.ScrollToBottom()
+
+			if lastRow != len(data)-1 {
+				t.Fatalf("last row drawn = %d after scrolling, want %d", lastRow, len(data)-1)
+			}
+			header, after := laidOutHeader(dt, "Name")
+			if header == nil {
+				t.Fatal("Expected the Name header to stay shown after scrolling")
+			}
+			if after != before {
+				t.Errorf("header moved from %v to %v when the rows scrolled", before, after)
+			}
+		})
+	}
+}
+
+func TestApplyViewState_SortKeys(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+
+	state := dt.model.ExportViewState()
+	state.SortKeys = []datatable.ViewSortKey{
+		{Column: 2, Direction: datatable.SortAscending},  // Department
+		{Column: 0, Direction: datatable.SortDescending}, // Name
+	}
+	state.SortColumn, state.SortDirection = 2, datatable.SortAscending
+	if err := dt.ApplyViewState(state); err != nil {
+		t.Fatalf("ApplyViewState failed: %v", err)
+	}
+
+	// Every key is sorted, not just the primary one
+	want := []string{"Bob Smith", "Jack Thompson", "Alice Johnson", "Frank Miller", "Eve Wilson"}
+	for row, name := range want {
+		cell, _ := dt.model.VisibleCell(row, 0)
+		if cell.Formatted != name {
+			t.Errorf("row %d = %q, want %q", row, cell.Formatted, name)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"sync"
+	"time"
+)
+
+// clock abstracts timer scheduling so debounce behaviour can be tested
+// without real delays.
+type clock interface {
+	AfterFunc(d time.Duration, f func()) timer
+}
+
+// timer is the subset of *time.Timer used by the debouncer.
+type timer interface {
+	Stop() bool
+}
+
+// realClock schedules callbacks using the standard library timers.
+type realClock struct{}
+
+// AfterFunc implements clock using time.AfterFunc.
+func (realClock) AfterFunc(d time.Duration, f func()) timer {
+	return time.AfterFunc(d, f)
+}
+
+// debouncer collapses bursts of calls into a single call that runs once
+// no new call has arrived for the configured delay.
+type debouncer struct {
+	mu      sync.Mutex
+	delay   time.Duration
+	clock   clock
+	pending timer
+}
+
+// newDebouncer creates a debouncer with the given delay.
+// A nil clock uses real timers.
+func newDebouncer(delay time.Duration, c clock) *debouncer {
+	if c == nil {
+		c = realClock{}
+	}
+	return &debouncer{
+		delay: delay,
+		clock: c,
+	}
+}
+
+// Trigger schedules fn to run after the delay, replacing any call that is
+// still waiting. With a non-positive delay fn runs immediately.
+func (d *debouncer) Trigger(fn func()) {
+	d.mu.Lock()
+	if d.pending != nil {
+		d.pending.Stop()
+		d.pending = nil
+	}
+
+	if d.delay <= 0 {
+		d.mu.Unlock()
+		fn()
+		return
+	}
+
+	var scheduled timer
+	scheduled = d.clock.AfterFunc(d.delay, func() {
+		d.mu.Lock()
+		// A newer Trigger or Stop has replaced this call
+		if d.pending != scheduled {
+			d.mu.Unlock()
+			return
+		}
+		d.pending = nil
+		d.mu.Unlock()
+
+		fn()
+	})
+	d.pending = scheduled
+	d.mu.Unlock()
+}
+
+// Stop cancels any pending call.
+func (d *debouncer) Stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pending != nil {
+		d.pending.Stop()
+		d.pending = nil
+	}
+}
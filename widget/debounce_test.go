@@ -0,0 +1,154 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock records scheduled callbacks so tests can fire them manually.
+type fakeClock struct {
+	mu     sync.Mutex
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	fn      func()
+	stopped bool
+}
+
+func (t *fakeTimer) Stop() bool {
+	wasActive := !t.stopped
+	t.stopped = true
+	return wasActive
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{fn: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// fireAll runs every scheduled callback that has not been stopped.
+func (c *fakeClock) fireAll() {
+	c.mu.Lock()
+	timers := c.timers
+	c.timers = nil
+	c.mu.Unlock()
+
+	for _, t := range timers {
+		if !t.stopped {
+			t.fn()
+		}
+	}
+}
+
+func TestDebouncer_RapidTriggersCollapse(t *testing.T) {
+	clk := &fakeClock{}
+	d := newDebouncer(250*time.Millisecond, clk)
+
+	var applied []string
+	for _, query := range []string{"a", "ag", "age", "age >", "age > 3"} {
+		q := query
+		d.Trigger(func() {
+			applied = append(applied, q)
+		})
+	}
+
+	if len(applied) != 0 {
+		t.Fatalf("Expected no apply before timer fires, got %v", applied)
+	}
+
+	clk.fireAll()
+
+	if len(applied) != 1 {
+		t.Fatalf("Expected exactly 1 apply, got %d: %v", len(applied), applied)
+	}
+	if applied[0] != "age > 3" {
+		t.Errorf("Expected last query to be applied, got %q", applied[0])
+	}
+}
+
+func TestDebouncer_StaleCallbackIgnored(t *testing.T) {
+	clk := &fakeClock{}
+	d := newDebouncer(250*time.Millisecond, clk)
+
+	calls := 0
+	d.Trigger(func() { calls++ })
+	first := clk.timers[0]
+
+	d.Trigger(func() { calls++ })
+
+	// Simulate the first timer firing even though Stop raced with it
+	first.fn()
+	if calls != 0 {
+		t.Errorf("Expected superseded callback to be ignored, got %d calls", calls)
+	}
+
+	clk.fireAll()
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestDebouncer_Stop(t *testing.T) {
+	clk := &fakeClock{}
+	d := newDebouncer(250*time.Millisecond, clk)
+
+	calls := 0
+	d.Trigger(func() { calls++ })
+	d.Stop()
+	clk.fireAll()
+
+	if calls != 0 {
+		t.Errorf("Expected no calls after Stop, got %d", calls)
+	}
+}
+
+func TestDebouncer_SeparateBursts(t *testing.T) {
+	clk := &fakeClock{}
+	d := newDebouncer(250*time.Millisecond, clk)
+
+	calls := 0
+	d.Trigger(func() { calls++ })
+	d.Trigger(func() { calls++ })
+	clk.fireAll()
+
+	d.Trigger(func() { calls++ })
+	clk.fireAll()
+
+	if calls != 2 {
+		t.Errorf("Expected 2 calls for 2 bursts, got %d", calls)
+	}
+}
+
+func TestDebouncer_ZeroDelayRunsImmediately(t *testing.T) {
+	clk := &fakeClock{}
+	d := newDebouncer(0, clk)
+
+	calls := 0
+	d.Trigger(func() { calls++ })
+
+	if calls != 1 {
+		t.Errorf("Expected immediate call with zero delay, got %d", calls)
+	}
+	if len(clk.timers) != 0 {
+		t.Errorf("Expected no timers scheduled, got %d", len(clk.timers))
+	}
+}
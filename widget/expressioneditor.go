@@ -0,0 +1,288 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/expression"
+)
+
+// computedColumnTypes lists the output types offered by the expression
+// editor, in menu order.
+var computedColumnTypes = []datatable.DataType{
+	datatable.TypeFloat,
+	datatable.TypeInt,
+	datatable.TypeString,
+	datatable.TypeBool,
+	datatable.TypeDate,
+	datatable.TypeTimestamp,
+}
+
+// expressionSource returns the model's data source as an ExpressionDataSource.
+// Other sources cannot gain columns; wrap them with
+// expression.NewExpressionDataSource before creating the model.
+func (dt *DataTable) expressionSource() (*expression.ExpressionDataSource, error) {
+	if dt.model == nil {
+		return nil, datatable.ErrNoDataSource
+	}
+	exprDS, ok := dt.model.GetDataSource().(*expression.ExpressionDataSource)
+	if !ok {
+		return nil, fmt.Errorf("computed columns need an expression data source; " +
+			"wrap the source with expression.NewExpressionDataSource")
+	}
+	return exprDS, nil
+}
+
+// sourceColumnNames returns the names of all columns of source, including
+// hidden ones.
+func sourceColumnNames(source datatable.DataSource) []string {
+	names := make([]string, 0, source.ColumnCount())
+	for i := 0; i < source.ColumnCount(); i++ {
+		if name, err := source.ColumnName(i); err == nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// parseColumnExpression compiles exprStr against the columns of source.
+// Returns an error if the expression is empty, fails to compile or
+// references unknown columns.
+func parseColumnExpression(
+	source datatable.DataSource,
+	exprStr string,
+	outputType datatable.DataType,
+) (*expression.Expression, error) {
+	return expression.ParseWithContext(exprStr, sourceColumnNames(source), expression.ArrowType(outputType))
+}
+
+// validateComputedColumn checks a new computed column against the columns
+// of source and compiles its expression.
+// Returns an error if:
+//   - The name is empty or already used by a column
+//   - The expression is empty, fails to compile or references unknown columns
+func validateComputedColumn(
+	source datatable.DataSource,
+	name, exprStr string,
+	outputType datatable.DataType,
+) (*expression.Expression, error) {
+	if name == "" {
+		return nil, fmt.Errorf("column name cannot be empty")
+	}
+	if slices.Contains(sourceColumnNames(source), name) {
+		return nil, fmt.Errorf("column %s already exists", name)
+	}
+
+	return parseColumnExpression(source, exprStr, outputType)
+}
+
+// AddComputedColumn adds a column computed from exprStr to the table's
+// ExpressionDataSource and shows it as the last visible column. The header
+// of a computed column is prefixed with "#".
+// The filters and sort stay applied.
+func (dt *DataTable) AddComputedColumn(name, exprStr string, outputType datatable.DataType) error {
+	exprDS, err := dt.expressionSource()
+	if err != nil {
+		return err
+	}
+
+	name = strings.TrimSpace(name)
+	expr, err := validateComputedColumn(exprDS, name, exprStr, outputType)
+	if err != nil {
+		return err
+	}
+	if err := exprDS.AddComputedColumn(name, expr, outputType); err != nil {
+		return err
+	}
+
+	if err := dt.model.Reload(); err != nil {
+		return err
+	}
+
+	// Reload only shows new columns when no column was hidden
+	newCol := exprDS.ColumnCount() - 1
+	visible := dt.model.GetVisibleColumnIndices()
+	if !slices.Contains(visible, newCol) {
+		if err := dt.model.SetVisibleColumns(append(visible, newCol)); err != nil {
+			return err
+		}
+	}
+
+	if dt.config.AutoAdjustColumnWidths {
+		dt.AutoAdjustColumns()
+	}
+	dt.Refresh()
+	return nil
+}
+
+// openExpressionEditor calls the handler set with SetExpressionEditorHandler,
+// or shows the built-in editor.
+func (dt *DataTable) openExpressionEditor() {
+	if dt.expressionEditorHandler != nil {
+		dt.expressionEditorHandler()
+		return
+	}
+	dt.ShowExpressionEditor()
+}
+
+// hasExpressionEditor reports whether the settings dialog offers an
+// expression editor.
+func (dt *DataTable) hasExpressionEditor() bool {
+	if dt.expressionEditorHandler != nil {
+		return true
+	}
+	_, err := dt.expressionSource()
+	return err == nil
+}
+
+// ShowExpressionEditor shows the built-in dialog for adding a computed
+// column: a name, an expression over the current columns and an output type.
+// The expression is checked as it is typed, and the dialog stays open
+// until the column is added or the user cancels.
+// Requires SetWindow to have been called.
+func (dt *DataTable) ShowExpressionEditor() {
+	dt.showExpressionDialog("")
+}
+
+// ShowColumnExpressionEditor shows the expression editor for the existing
+// computed column named column. Its name and output type are fixed; saving
+// replaces the expression.
+// Requires SetWindow to have been called.
+func (dt *DataTable) ShowColumnExpressionEditor(column string) {
+	dt.showExpressionDialog(column)
+}
+
+// showExpressionDialog shows the expression editor, adding a new column
+// when column is empty and editing that column otherwise.
+func (dt *DataTable) showExpressionDialog(column string) {
+	if dt.window == nil {
+		return
+	}
+	exprDS, err := dt.expressionSource()
+	if err != nil {
+		dialog.ShowError(err, dt.window)
+		return
+	}
+
+	typeNames := make([]string, len(computedColumnTypes))
+	for i, t := range computedColumnTypes {
+		typeNames[i] = t.String()
+	}
+	outputType := computedColumnTypes[0]
+
+	nameEntry := widget.NewEntry()
+	nameEntry.SetPlaceHolder("Column name")
+	exprEntry := widget.NewMultiLineEntry()
+	exprEntry.SetPlaceHolder("e.g. price * quantity")
+	exprEntry.Wrapping = fyne.TextWrapWord
+
+	title, action := "Add Computed Column", "Add"
+	if column != "" {
+		expr := exprDS.GetExpression(column)
+		if expr == nil {
+			dialog.ShowError(fmt.Errorf("column %s has no expression to edit", column), dt.window)
+			return
+		}
+		if t, err := exprDS.ColumnType(slices.Index(sourceColumnNames(exprDS), column)); err == nil {
+			outputType = t
+		}
+		title, action = "Edit Computed Column", "Save"
+		nameEntry.SetText(column)
+		nameEntry.Disable()
+		exprEntry.SetText(expr.Source())
+	}
+
+	columnsLabel := widget.NewLabel("Columns: " + strings.Join(sourceColumnNames(exprDS), ", "))
+	columnsLabel.Wrapping = fyne.TextWrapWord
+	statusLabel := widget.NewLabel("")
+	statusLabel.Wrapping = fyne.TextWrapWord
+
+	actionButton := widget.NewButton(action, nil)
+	actionButton.Importance = widget.HighImportance
+	if column == "" {
+		actionButton.Disable()
+	}
+
+	// Check the input on every change so errors show before saving
+	validate := func() {
+		var err error
+		if column == "" {
+			_, err = validateComputedColumn(exprDS, strings.TrimSpace(nameEntry.Text), exprEntry.Text, outputType)
+		} else {
+			_, err = parseColumnExpression(exprDS, exprEntry.Text, outputType)
+		}
+		if err != nil {
+			statusLabel.SetText(err.Error())
+			actionButton.Disable()
+			return
+		}
+		statusLabel.SetText("")
+		actionButton.Enable()
+	}
+	nameEntry.OnChanged = func(string) { validate() }
+	exprEntry.OnChanged = func(string) { validate() }
+
+	typeSelect := widget.NewSelect(typeNames, nil)
+	typeSelect.SetSelected(outputType.String())
+	typeSelect.OnChanged = func(selected string) {
+		outputType = computedColumnTypes[slices.Index(typeNames, selected)]
+		validate()
+	}
+	if column != "" {
+		typeSelect.Disable()
+	}
+
+	form := widget.NewForm(
+		widget.NewFormItem("Name", nameEntry),
+		widget.NewFormItem("Expression", exprEntry),
+		widget.NewFormItem("Output Type", typeSelect),
+	)
+	content := container.NewVBox(form, columnsLabel, statusLabel)
+
+	d := dialog.NewCustomWithoutButtons(title, content, dt.window)
+	actionButton.OnTapped = func() {
+		var err error
+		if column == "" {
+			err = dt.AddComputedColumn(nameEntry.Text, exprEntry.Text, outputType)
+		} else {
+			err = dt.SetComputedColumnExpression(column, exprEntry.Text)
+		}
+		if err != nil {
+			statusLabel.SetText(err.Error())
+			return
+		}
+		d.Hide()
+	}
+	d.SetButtons([]fyne.CanvasObject{
+		widget.NewButton("Cancel", d.Hide),
+		actionButton,
+	})
+	d.Resize(fyne.NewSize(480, 360))
+	d.Show()
+	if column == "" {
+		dt.window.Canvas().Focus(nameEntry)
+	} else {
+		dt.window.Canvas().Focus(exprEntry)
+	}
+}
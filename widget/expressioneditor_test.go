@@ -0,0 +1,157 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/adapters/slice"
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/expression"
+)
+
+// newOrderSource creates an expression source over Item/Price/Qty rows.
+func newOrderSource(t *testing.T) *expression.ExpressionDataSource {
+	t.Helper()
+
+	data := [][]any{
+		{"Pen", 1.5, 4},
+		{"Book", 12.0, 1},
+		{"Lamp", 20.0, 2},
+	}
+	source, err := slice.NewFromInterfaces(data, []string{"Item", "Price", "Qty"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	return expression.NewExpressionDataSource(source)
+}
+
+func TestValidateComputedColumn(t *testing.T) {
+	source := newOrderSource(t)
+
+	tests := []struct {
+		name     string
+		column   string
+		expr     string
+		wantErr  bool
+		wantCols []string
+	}{
+		{"valid", "Total", "Price * Qty", false, []string{"Price", "Qty"}},
+		{"empty name", "", "Price * Qty", true, nil},
+		{"existing column", "Price", "Price * 2", true, nil},
+		{"empty expression", "Total", "  ", true, nil},
+		{"syntax error", "Total", "Price *", true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := validateComputedColumn(source, tt.column, tt.expr, datatable.TypeFloat)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateComputedColumn() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got := expr.InputColumns()
+			if len(got) != len(tt.wantCols) {
+				t.Fatalf("InputColumns() = %v, want %v", got, tt.wantCols)
+			}
+			for i := range got {
+				if got[i] != tt.wantCols[i] {
+					t.Errorf("InputColumns() = %v, want %v", got, tt.wantCols)
+				}
+			}
+		})
+	}
+}
+
+func TestAddComputedColumn(t *testing.T) {
+	test.NewTempApp(t)
+
+	model, err := datatable.NewTableModel(newOrderSource(t))
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	dt := NewDataTable(model)
+
+	if !dt.hasExpressionEditor() {
+		t.Error("Expected the built-in editor for an expression source")
+	}
+
+	// Invalid input leaves the table unchanged
+	if err := dt.AddComputedColumn("Total", "Price *", datatable.TypeFloat); err == nil {
+		t.Error("Expected error for invalid expression")
+	}
+	if got := model.VisibleColumnCount(); got != 3 {
+		t.Fatalf("Expected 3 columns after invalid input, got %d", got)
+	}
+
+	// Hide a column; the new column is still shown, last
+	if err := model.SetVisibleColumns([]int{0, 1}); err != nil {
+		t.Fatalf("SetVisibleColumns failed: %v", err)
+	}
+	if err := dt.AddComputedColumn(" Total ", "Price * Qty", datatable.TypeFloat); err != nil {
+		t.Fatalf("AddComputedColumn failed: %v", err)
+	}
+
+	if got := model.VisibleColumnCount(); got != 3 {
+		t.Fatalf("Expected 3 visible columns, got %d", got)
+	}
+	if name, _ := model.VisibleColumnName(2); name != "Total" {
+		t.Errorf("Expected the new column last, got %q", name)
+	}
+	if !dt.isComputedColumn(2) {
+		t.Error("Expected the new column to be computed, shown with a # header")
+	}
+	if dt.isComputedColumn(0) {
+		t.Error("Expected source columns not to be computed")
+	}
+
+	want := []float64{6, 12, 40}
+	for row, w := range want {
+		val, err := model.VisibleCell(row, 2)
+		if err != nil {
+			t.Fatalf("VisibleCell(%d, 2) failed: %v", row, err)
+		}
+		if got, ok := val.Raw.(float64); !ok || got != w {
+			t.Errorf("row %d: Total = %v, want %v", row, val.Raw, w)
+		}
+	}
+
+	// The new column can be referenced, and names stay unique
+	if err := dt.AddComputedColumn("Total", "Price", datatable.TypeFloat); err == nil {
+		t.Error("Expected error for duplicate column name")
+	}
+	if err := dt.AddComputedColumn("WithTax", "Total * 2", datatable.TypeFloat); err != nil {
+		t.Errorf("AddComputedColumn referencing a computed column failed: %v", err)
+	}
+}
+
+func TestAddComputedColumn_PlainSource(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+	if dt.hasExpressionEditor() {
+		t.Error("Expected no editor without a handler or expression source")
+	}
+	if err := dt.AddComputedColumn("Upper", "upper(Name)", datatable.TypeString); err == nil {
+		t.Error("Expected error for a source without computed columns")
+	}
+
+	dt.SetExpressionEditorHandler(func() {})
+	if !dt.hasExpressionEditor() {
+		t.Error("Expected an editor once a handler is set")
+	}
+}
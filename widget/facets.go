@@ -0,0 +1,318 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"slices"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+// facetValueLimit caps the number of values listed for one facet column.
+const facetValueLimit = 50
+
+// nullFacetLabel is shown for null cells in a facet list.
+const nullFacetLabel = "(empty)"
+
+// facetKey identifies a facet value by its formatted text.
+type facetKey struct {
+	text string
+	null bool
+}
+
+// label returns the text shown for the value.
+func (k facetKey) label() string {
+	if k.null {
+		return nullFacetLabel
+	}
+	return k.text
+}
+
+// facetSelection holds the checked values of one facet column.
+type facetSelection struct {
+	column      string
+	values      []string
+	includeNull bool
+}
+
+// buildFacetFilter translates facet selections into a filter. Each column
+// with checked values becomes an InFilter, and columns are combined with
+// AND. Columns with nothing checked do not restrict the rows.
+// Returns nil when nothing is checked.
+func buildFacetFilter(selections []facetSelection) datatable.Filter {
+	var filters []datatable.Filter
+	for _, s := range selections {
+		if len(s.values) == 0 && !s.includeNull {
+			continue
+		}
+		filters = append(filters, &filter.InFilter{
+			Column:      s.column,
+			Values:      s.values,
+			IncludeNull: s.includeNull,
+		})
+	}
+	return andFilters(filters...)
+}
+
+// andFilters combines the non-nil filters with AND. It returns nil when
+// there are none and the filter itself when there is one.
+func andFilters(filters ...datatable.Filter) datatable.Filter {
+	var active []datatable.Filter
+	for _, f := range filters {
+		if f != nil {
+			active = append(active, f)
+		}
+	}
+
+	switch len(active) {
+	case 0:
+		return nil
+	case 1:
+		return active[0]
+	}
+	return &filter.CompositeFilter{Filters: active, Logic: filter.LogicAND}
+}
+
+// facetValues returns the distinct values of column col over rows, in the
+// order they first appear, and the number of rows holding each. At most
+// limit values are collected (limit < 0 means no limit); truncated reports
+// whether more exist. Cells that cannot be read are skipped.
+func facetValues(source datatable.DataSource, rows []int, col, limit int) (values []facetKey, counts map[facetKey]int, truncated bool) {
+	counts = make(map[facetKey]int)
+	for _, row := range rows {
+		value, err := source.Cell(row, col)
+		if err != nil {
+			continue
+		}
+		key := facetKey{null: value.IsNull}
+		if !value.IsNull {
+			value.EnsureFormatted()
+			key.text = value.Formatted
+		}
+
+		if _, seen := counts[key]; !seen {
+			if len(values) == limit {
+				truncated = true
+				continue
+			}
+			values = append(values, key)
+		}
+		counts[key]++
+	}
+	return values, counts, truncated
+}
+
+// facet is one column of a FacetPanel.
+type facet struct {
+	column  int // Original column index
+	name    string
+	values  []facetKey
+	checks  []*widget.Check
+	checked map[facetKey]bool
+}
+
+// selection returns the checked values of the facet.
+func (f *facet) selection() facetSelection {
+	s := facetSelection{column: f.name}
+	for _, key := range f.values {
+		if !f.checked[key] {
+			continue
+		}
+		if key.null {
+			s.includeNull = true
+		} else {
+			s.values = append(s.values, key.text)
+		}
+	}
+	return s
+}
+
+// FacetPanel shows the distinct values of categorical columns as checkable
+// lists. Checking values restricts the table to rows holding one of the
+// checked values in every column with a selection. Each value shows how many
+// rows hold it under every filter except its own column's selection, so the
+// counts say how many rows checking the value would add. They are updated
+// whenever the table's filters change.
+type FacetPanel struct {
+	widget.BaseWidget
+
+	dataTable *DataTable
+	facets    []*facet
+	container *fyne.Container
+
+	// Filter generation of the table the counts were computed for
+	countedGeneration uint64
+	counted           bool
+}
+
+// NewFacetPanel creates a facet panel for the given original column indices.
+// Columns out of range are ignored.
+func NewFacetPanel(dt *DataTable, columns []int) *FacetPanel {
+	fp := &FacetPanel{dataTable: dt}
+
+	fp.ExtendBaseWidget(fp)
+	fp.buildUI(columns)
+
+	return fp
+}
+
+// buildUI lists the values of each facet column over all rows.
+func (fp *FacetPanel) buildUI(columns []int) {
+	source := fp.dataTable.model.GetDataSource()
+	allRows, _ := matchingRows(source, nil)
+
+	items := make([]*widget.AccordionItem, 0, len(columns))
+	for _, col := range columns {
+		name, err := source.ColumnName(col)
+		if err != nil {
+			continue
+		}
+
+		values, _, truncated := facetValues(source, allRows, col, facetValueLimit)
+		f := &facet{
+			column:  col,
+			name:    name,
+			values:  values,
+			checked: make(map[facetKey]bool),
+		}
+
+		list := container.NewVBox()
+		for _, key := range values {
+			key := key // Capture for closure
+			check := widget.NewCheck(key.label(), func(checked bool) {
+				f.checked[key] = checked
+				fp.apply()
+			})
+			f.checks = append(f.checks, check)
+			list.Add(check)
+		}
+		if truncated {
+			list.Add(widget.NewLabel(fmt.Sprintf("Showing first %d values", facetValueLimit)))
+		}
+
+		fp.facets = append(fp.facets, f)
+		items = append(items, widget.NewAccordionItem(name, list))
+	}
+
+	accordion := widget.NewAccordion(items...)
+	accordion.MultiOpen = true
+	accordion.OpenAll()
+
+	clearBtn := widget.NewButton("Clear", func() {
+		fp.Clear()
+	})
+
+	scroll := container.NewVScroll(accordion)
+	scroll.SetMinSize(fyne.NewSize(200, 150))
+
+	fp.container = container.NewBorder(nil, clearBtn, nil, nil, scroll)
+	fp.Update()
+}
+
+// Filter returns the filter for the checked values, or nil if nothing is
+// checked.
+func (fp *FacetPanel) Filter() datatable.Filter {
+	selections := make([]facetSelection, len(fp.facets))
+	for i, f := range fp.facets {
+		selections[i] = f.selection()
+	}
+	return buildFacetFilter(selections)
+}
+
+// apply sends the current selection to the table.
+func (fp *FacetPanel) apply() {
+	if err := fp.dataTable.setFacetFilter(fp.Filter()); err != nil {
+		fyne.LogError("Failed to apply facet filter", err)
+	}
+}
+
+// Clear unchecks every value and removes the facet filter.
+func (fp *FacetPanel) Clear() {
+	for _, f := range fp.facets {
+		clear(f.checked)
+		for _, check := range f.checks {
+			check.Checked = false
+			check.Refresh()
+		}
+	}
+	fp.apply()
+}
+
+// Update recounts the rows holding each value if the table's filters
+// changed since the last count. Each facet is counted with the user's filter,
+// the per-column filters and the selections of the other facets.
+func (fp *FacetPanel) Update() {
+	dt := fp.dataTable
+	dt.filterMu.Lock()
+	generation := dt.filterGeneration
+	others := andFilters(dt.userFilter, dt.columnFilter)
+	dt.filterMu.Unlock()
+
+	if fp.counted && generation == fp.countedGeneration {
+		return
+	}
+
+	source := dt.model.GetDataSource()
+	selections := make([]facetSelection, len(fp.facets))
+	for i, f := range fp.facets {
+		selections[i] = f.selection()
+	}
+
+	for i, f := range fp.facets {
+		otherFacets := slices.Delete(slices.Clone(selections), i, i+1)
+		rows, err := matchingRows(source, andFilters(others, buildFacetFilter(otherFacets)))
+		if err != nil {
+			fyne.LogError("Failed to count facet values", err)
+			return
+		}
+
+		_, counts, _ := facetValues(source, rows, f.column, -1)
+		for i, key := range f.values {
+			f.checks[i].Text = fmt.Sprintf("%s (%d)", key.label(), counts[key])
+			f.checks[i].Refresh()
+		}
+	}
+
+	fp.countedGeneration = generation
+	fp.counted = true
+}
+
+// matchingRows returns the source rows passing f, or every row if f is nil.
+func matchingRows(source datatable.DataSource, f datatable.Filter) ([]int, error) {
+	if f == nil {
+		rows := make([]int, source.RowCount())
+		for i := range rows {
+			rows[i] = i
+		}
+		return rows, nil
+	}
+
+	prepared, err := filter.Prepare(source, f)
+	if err != nil {
+		return nil, err
+	}
+	return prepared.Apply(source)
+}
+
+// CreateRenderer returns the widget's renderer.
+func (fp *FacetPanel) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(fp.container)
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+func TestBuildFacetFilter(t *testing.T) {
+	if f := buildFacetFilter(nil); f != nil {
+		t.Errorf("buildFacetFilter(nil) = %v, want nil", f)
+	}
+	if f := buildFacetFilter([]facetSelection{{column: "Department"}}); f != nil {
+		t.Errorf("buildFacetFilter(nothing checked) = %v, want nil", f)
+	}
+
+	// One column gives a single InFilter
+	f := buildFacetFilter([]facetSelection{
+		{column: "Department", values: []string{"Engineering", "Design"}},
+		{column: "Position"},
+	})
+	in, ok := f.(*filter.InFilter)
+	if !ok {
+		t.Fatalf("buildFacetFilter() = %T, want *filter.InFilter", f)
+	}
+	if in.Column != "Department" || !slices.Equal(in.Values, []string{"Engineering", "Design"}) || in.IncludeNull {
+		t.Errorf("InFilter = %+v", in)
+	}
+
+	// Several columns are combined with AND
+	f = buildFacetFilter([]facetSelection{
+		{column: "Department", values: []string{"Engineering"}},
+		{column: "Position", values: []string{"Software Engineer"}, includeNull: true},
+	})
+	composite, ok := f.(*filter.CompositeFilter)
+	if !ok {
+		t.Fatalf("buildFacetFilter() = %T, want *filter.CompositeFilter", f)
+	}
+	if composite.Logic != filter.LogicAND || len(composite.Filters) != 2 {
+		t.Fatalf("CompositeFilter = %s, want two filters combined with AND", composite.Description())
+	}
+	second := composite.Filters[1].(*filter.InFilter)
+	if second.Column != "Position" || !second.IncludeNull {
+		t.Errorf("second InFilter = %+v", second)
+	}
+}
+
+func TestFacetPanel_FiltersTable(t *testing.T) {
+	config := DefaultConfig()
+	config.FacetColumns = []int{2}
+	dt := newEmployeeTestTable(t, config)
+
+	if dt.facetPanel == nil || len(dt.facetPanel.facets) != 1 {
+		t.Fatal("Expected a facet panel with one column")
+	}
+	facet := dt.facetPanel.facets[0]
+	if facet.name != "Department" || len(facet.values) != 4 {
+		t.Fatalf("facet = %s with %d values, want Department with 4", facet.name, len(facet.values))
+	}
+	if got := facet.checks[0].Text; got != "Engineering (2)" {
+		t.Errorf("first value label = %q, want %q", got, "Engineering (2)")
+	}
+
+	// Checking values filters the table
+	facet.checks[0].SetChecked(true) // Engineering
+	facet.checks[1].SetChecked(true) // Design
+	if got := dt.model.VisibleRowCount(); got != 3 {
+		t.Errorf("Expected 3 rows in Engineering or Design, got %d", got)
+	}
+
+	// Other filters combine with the facets, and counts follow them
+	if err := dt.GlobalSearch("smith"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := dt.model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row for \"smith\" within the facets, got %d", got)
+	}
+	if got := facet.checks[0].Text; got != "Engineering (0)" {
+		t.Errorf("Engineering label = %q after search, want count 0", got)
+	}
+
+	// Clearing the search keeps the facet selection
+	if err := dt.ClearFilter(); err != nil {
+		t.Fatalf("ClearFilter failed: %v", err)
+	}
+	if got := dt.model.VisibleRowCount(); got != 3 {
+		t.Errorf("Expected 3 rows after clearing the search, got %d", got)
+	}
+
+	dt.facetPanel.Clear()
+	if got := dt.model.VisibleRowCount(); got != 5 {
+		t.Errorf("Expected all 5 rows after clearing facets, got %d", got)
+	}
+	if facet.checks[0].Checked {
+		t.Error("Expected checks to be cleared")
+	}
+}
+
+func TestFacetPanel_CountsExcludeOwnSelection(t *testing.T) {
+	config := DefaultConfig()
+	config.FacetColumns = []int{2, 1}
+	dt := newEmployeeTestTable(t, config)
+	department, position := dt.facetPanel.facets[0], dt.facetPanel.facets[1]
+
+	department.checks[0].SetChecked(true) // Engineering
+
+	// The department counts ignore the department selection
+	if got := department.checks[1].Text; got != "Design (1)" {
+		t.Errorf("Design label = %q, want %q", got, "Design (1)")
+	}
+	// Other facets are counted within it
+	if got := position.checks[0].Text; got != "Software Engineer (1)" {
+		t.Errorf("Software Engineer label = %q, want %q", got, "Software Engineer (1)")
+	}
+	if got := position.checks[1].Text; got != "UI/UX Designer (0)" {
+		t.Errorf("UI/UX Designer label = %q, want %q", got, "UI/UX Designer (0)")
+	}
+
+	// A refresh without a filter change keeps the counts
+	department.checks[1].Text = "stale"
+	dt.Refresh()
+	if got := department.checks[1].Text; got != "stale" {
+		t.Errorf("Design label = %q after refresh, want it left alone", got)
+	}
+	if err := dt.GlobalSearch("smith"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := department.checks[1].Text; got != "Design (1)" {
+		t.Errorf("Design label = %q after search, want %q", got, "Design (1)")
+	}
+}
@@ -15,10 +15,15 @@
 package widget
 
 import (
+	"context"
+	"errors"
+	"sync"
+
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
 
+	"github.com/magpierre/fyne-datatable/datatable"
 	"github.com/magpierre/fyne-datatable/internal/filter"
 )
 
@@ -29,16 +34,24 @@ type FilterBar struct {
 	dataTable *DataTable
 
 	// UI components
-	queryEntry  *widget.Entry
-	applyButton *widget.Button
-	clearButton *widget.Button
-	container   *fyne.Container
+	queryEntry   *widget.Entry
+	applyButton  *widget.Button
+	clearButton  *widget.Button
+	builderCheck *widget.Check
+	builder      *ConditionBuilder
+	container    *fyne.Container
+
+	// Incremental filtering
+	debouncer *debouncer
+	cancelMu  sync.Mutex
+	cancel    context.CancelFunc // Cancels the in-flight filter pass, if any
 }
 
 // NewFilterBar creates a new filter bar for the given DataTable.
 func NewFilterBar(dt *DataTable) *FilterBar {
 	fb := &FilterBar{
 		dataTable: dt,
+		debouncer: newDebouncer(dt.config.FilterDebounce, nil),
 	}
 
 	fb.ExtendBaseWidget(fb)
@@ -55,30 +68,86 @@ func (fb *FilterBar) buildUI() {
 	fb.queryEntry.OnSubmitted = func(query string) {
 		fb.applyFilter()
 	}
+	fb.queryEntry.OnChanged = func(query string) {
+		// Re-filter once the user pauses typing
+		fb.debouncer.Trigger(func() {
+			fb.applyQueryAsync(query)
+		})
+	}
 
 	// Create apply button
 	fb.applyButton = widget.NewButton("Apply Filter", func() {
-		fb.applyFilter()
+		if fb.builderCheck.Checked {
+			fb.applyConditions()
+		} else {
+			fb.applyFilter()
+		}
 	})
 
 	// Create clear button
 	fb.clearButton = widget.NewButton("Clear", func() {
+		fb.cancelInFlight()
 		fb.queryEntry.SetText("")
+		fb.debouncer.Stop() // SetText triggers OnChanged
+		fb.builder.Reset()
 		fb.dataTable.ClearFilter()
 	})
 
+	// Condition builder, with the query entry kept as the advanced mode
+	fb.builder = NewConditionBuilder(fb.dataTable)
+	fb.builder.Hide()
+	fb.builderCheck = widget.NewCheck("Builder", func(checked bool) {
+		fb.setBuilderMode(checked)
+	})
+
 	// Build container
-	fb.container = container.NewBorder(
-		nil,
-		nil,
-		widget.NewLabel("Filter:"),
-		container.NewHBox(fb.applyButton, fb.clearButton),
-		fb.queryEntry,
+	fb.container = container.NewVBox(
+		container.NewBorder(
+			nil,
+			nil,
+			widget.NewLabel("Filter:"),
+			container.NewHBox(fb.builderCheck, fb.applyButton, fb.clearButton),
+			fb.queryEntry,
+		),
+		fb.builder,
 	)
 }
 
+// setBuilderMode switches between the condition builder and the query entry.
+func (fb *FilterBar) setBuilderMode(builder bool) {
+	if builder {
+		fb.debouncer.Stop()
+		fb.queryEntry.Disable()
+		fb.builder.Show()
+	} else {
+		fb.queryEntry.Enable()
+		fb.builder.Hide()
+	}
+	fb.container.Refresh()
+}
+
+// applyConditions applies the condition builder's composite filter.
+func (fb *FilterBar) applyConditions() {
+	fb.debouncer.Stop()
+	fb.cancelInFlight()
+
+	conditionFilter := fb.builder.Filter()
+	if conditionFilter == nil {
+		fb.dataTable.ClearFilter()
+		return
+	}
+
+	if err := fb.dataTable.SetFilter(conditionFilter); err != nil {
+		// Show error (in a real app, this would show a dialog)
+		fb.queryEntry.SetPlaceHolder("Error: " + err.Error())
+	}
+}
+
 // applyFilter applies the current query as a filter.
 func (fb *FilterBar) applyFilter() {
+	fb.debouncer.Stop()
+	fb.cancelInFlight()
+
 	query := fb.queryEntry.Text
 
 	if query == "" {
@@ -98,6 +167,59 @@ func (fb *FilterBar) applyFilter() {
 	}
 }
 
+// applyQueryAsync applies query from a debounce callback, off the UI goroutine.
+// Any filter pass still running for an earlier query is cancelled first.
+func (fb *FilterBar) applyQueryAsync(query string) {
+	ctx := fb.beginFilter()
+
+	var queryFilter datatable.Filter
+	if query != "" {
+		queryFilter = &filter.QueryFilter{
+			Query: query,
+		}
+	}
+
+	err := fb.dataTable.applyUserFilter(ctx, queryFilter)
+	if errors.Is(err, context.Canceled) {
+		// Superseded by a newer query
+		return
+	}
+
+	fyne.Do(func() {
+		if err != nil {
+			fb.queryEntry.SetPlaceHolder("Error: " + err.Error())
+			return
+		}
+		fb.dataTable.Refresh()
+		fb.dataTable.notifySortChanged() // Filtering clears the sort
+	})
+}
+
+// beginFilter cancels any in-flight filter pass and returns the context for a new one.
+func (fb *FilterBar) beginFilter() context.Context {
+	fb.cancelMu.Lock()
+	defer fb.cancelMu.Unlock()
+
+	if fb.cancel != nil {
+		fb.cancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	fb.cancel = cancel
+	return ctx
+}
+
+// cancelInFlight cancels the filter pass started by the last debounced apply.
+func (fb *FilterBar) cancelInFlight() {
+	fb.cancelMu.Lock()
+	defer fb.cancelMu.Unlock()
+
+	if fb.cancel != nil {
+		fb.cancel()
+		fb.cancel = nil
+	}
+}
+
 // CreateRenderer returns the widget's renderer.
 func (fb *FilterBar) CreateRenderer() fyne.WidgetRenderer {
 	return widget.NewSimpleRenderer(fb.container)
@@ -0,0 +1,176 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// headerButton is a header cell button that can also be dragged
+// horizontally to reorder columns.
+//
+// Column resizing is unaffected: the table starts a resize from the
+// padding gap between header cells, which this button does not cover.
+type headerButton struct {
+	widget.Button
+
+	// OnDragEnd is called with the total horizontal drag distance.
+	// Nil disables dragging (e.g. for row number headers).
+	OnDragEnd func(dx float32)
+
+	// OnTappedSecondary is called with the absolute position of a
+	// right-click. Nil ignores right-clicks.
+	OnTappedSecondary func(pos fyne.Position)
+
+	dragDX float32
+}
+
+// newHeaderButton creates an empty header button.
+func newHeaderButton() *headerButton {
+	b := &headerButton{}
+	b.ExtendBaseWidget(b)
+	return b
+}
+
+// Dragged accumulates horizontal movement while the header is dragged.
+func (b *headerButton) Dragged(e *fyne.DragEvent) {
+	b.dragDX += e.Dragged.DX
+}
+
+// TappedSecondary reports a right-click to OnTappedSecondary.
+func (b *headerButton) TappedSecondary(e *fyne.PointEvent) {
+	if b.OnTappedSecondary != nil {
+		b.OnTappedSecondary(e.AbsolutePosition)
+	}
+}
+
+// DragEnd reports the drag distance to OnDragEnd.
+func (b *headerButton) DragEnd() {
+	dx := b.dragDX
+	b.dragDX = 0
+	if b.OnDragEnd != nil {
+		b.OnDragEnd(dx)
+	}
+}
+
+// dropColumnFor returns the visible column a header dragged from column
+// from by dx lands on. The header moves past a neighbour once it has
+// covered half that neighbour's width (plus the inter-cell padding).
+func dropColumnFor(from int, dx float32, count int, padding float32, width func(col int) float32) int {
+	target := from
+	remaining := dx
+
+	for remaining > 0 && target+1 < count {
+		step := width(target+1) + padding
+		if remaining < step/2 {
+			break
+		}
+		remaining -= step
+		target++
+	}
+
+	for remaining < 0 && target > 0 {
+		step := width(target-1) + padding
+		if -remaining < step/2 {
+			break
+		}
+		remaining += step
+		target--
+	}
+
+	return target
+}
+
+// setColumnWidth sets a visible column's width chosen by the user and also
+// records it on the model, so ExportViewState saves it.
+func (dt *DataTable) setColumnWidth(col int, width float32) {
+	dt.showColumnWidth(col, width)
+	if visibleCols := dt.model.GetVisibleColumnIndices(); col >= 0 && col < len(visibleCols) {
+		if err := dt.model.SetColumnWidth(visibleCols[col], width); err != nil {
+			fyne.LogError("Failed to record column width", err)
+		}
+	}
+}
+
+// showColumnWidth sets a visible column's width and remembers it so drags
+// can be mapped to columns and widths can follow moved columns. Unlike
+// setColumnWidth it leaves the model alone, for widths the table picks.
+func (dt *DataTable) showColumnWidth(col int, width float32) {
+	if dt.columnWidths == nil {
+		dt.columnWidths = make(map[int]float32)
+	}
+	dt.columnWidths[col] = width
+	dt.table.SetColumnWidth(col, width)
+}
+
+// restoreColumnWidths shows the widths recorded on the model for the
+// visible columns.
+func (dt *DataTable) restoreColumnWidths() {
+	for col, originalCol := range dt.model.GetVisibleColumnIndices() {
+		if width, ok := dt.model.ColumnWidth(originalCol); ok {
+			dt.showColumnWidth(col, width)
+		}
+	}
+}
+
+// columnWidth returns the remembered width of a visible column, or fallback.
+func (dt *DataTable) columnWidth(col int, fallback float32) float32 {
+	if width, ok := dt.columnWidths[col]; ok {
+		return width
+	}
+	return fallback
+}
+
+// MoveColumn moves the visible column at index from to index to.
+// Column widths and the sort indicator follow the moved column.
+func (dt *DataTable) MoveColumn(from, to int) error {
+	// Remember widths by original column before the order changes
+	before := dt.model.GetVisibleColumnIndices()
+
+	if err := dt.model.MoveColumn(from, to); err != nil {
+		return err
+	}
+
+	widths := make(map[int]float32, len(dt.columnWidths))
+	for col, width := range dt.columnWidths {
+		if col >= 0 && col < len(before) {
+			widths[before[col]] = width
+		}
+	}
+	for col, originalCol := range dt.model.GetVisibleColumnIndices() {
+		if width, ok := widths[originalCol]; ok {
+			dt.showColumnWidth(col, width)
+		}
+	}
+
+	dt.Refresh()
+	return nil
+}
+
+// handleHeaderDrag moves a column after its header was dragged by dx.
+func (dt *DataTable) handleHeaderDrag(btn *headerButton, from int, dx float32) {
+	padding := btn.Theme().Size(theme.SizeNamePadding)
+	fallback := btn.Size().Width
+	count := dt.model.VisibleColumnCount()
+
+	to := dropColumnFor(from, dx, count, padding, func(col int) float32 {
+		return dt.columnWidth(col, fallback)
+	})
+	if to != from {
+		_ = dt.MoveColumn(from, to)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestDropColumnFor(t *testing.T) {
+	width := func(col int) float32 { return 100 }
+
+	tests := []struct {
+		name     string
+		from     int
+		dx       float32
+		expected int
+	}{
+		{"no movement", 1, 0, 1},
+		{"short drag stays", 1, 40, 1},
+		{"half a column moves right", 1, 60, 2},
+		{"two columns right", 0, 210, 2},
+		{"clamped at last column", 2, 1000, 3},
+		{"half a column moves left", 2, -60, 1},
+		{"clamped at first column", 2, -1000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dropColumnFor(tt.from, tt.dx, 4, 4, width); got != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataTable_MoveColumn(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	if err := dt.SortByColumn(0, datatable.SortAscending); err != nil {
+		t.Fatalf("Failed to sort: %v", err)
+	}
+	dt.setColumnWidth(0, 150)
+	dt.setColumnWidth(1, 90)
+
+	if err := dt.MoveColumn(0, 1); err != nil {
+		t.Fatalf("Failed to move column: %v", err)
+	}
+
+	if name, _ := dt.model.VisibleColumnName(0); name != "Title" {
+		t.Errorf("Expected Title first, got %s", name)
+	}
+	if sortState := dt.model.GetSortState(); sortState.Column != 1 {
+		t.Errorf("Expected sort to follow Name to column 1, got %d", sortState.Column)
+	}
+	if dt.columnWidth(0, 0) != 90 || dt.columnWidth(1, 0) != 150 {
+		t.Errorf("Expected widths to follow columns, got %v and %v", dt.columnWidth(0, 0), dt.columnWidth(1, 0))
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// DefaultHighlightColor is the theme color used for highlighted matches.
+const DefaultHighlightColor = theme.ColorNamePrimary
+
+// textRange is a half-open byte range [start, end) within a string.
+type textRange struct {
+	start int
+	end   int
+}
+
+// highlightRanges returns the non-overlapping byte ranges of text that
+// match term, ignoring case, from left to right.
+func highlightRanges(text, term string) []textRange {
+	if term == "" {
+		return nil
+	}
+
+	var ranges []textRange
+	for i := 0; i < len(text); {
+		if n, ok := matchFoldPrefix(text[i:], term); ok {
+			ranges = append(ranges, textRange{start: i, end: i + n})
+			i += n
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+	}
+	return ranges
+}
+
+// matchFoldPrefix reports whether s starts with term under case folding,
+// returning the number of bytes of s that matched. Runes are compared one
+// by one because folding can change a rune's encoded length.
+func matchFoldPrefix(s, term string) (int, bool) {
+	n := 0
+	for _, want := range term {
+		if n >= len(s) {
+			return 0, false
+		}
+		got, size := utf8.DecodeRuneInString(s[n:])
+		if got != want && !strings.EqualFold(string(got), string(want)) {
+			return 0, false
+		}
+		n += size
+	}
+	return n, true
+}
+
+// highlightSegments splits text into rich-text runs, emphasizing the ranges.
+func highlightSegments(text string, ranges []textRange, style rowStyle) []widget.RichTextSegment {
+	plain := widget.RichTextStyleInline
+	plain.TextStyle = style.textStyle
+
+	match := widget.RichTextStyleInline
+	match.ColorName = DefaultHighlightColor
+	match.TextStyle = fyne.TextStyle{Bold: true, Italic: style.textStyle.Italic}
+
+	segments := make([]widget.RichTextSegment, 0, 2*len(ranges)+1)
+	last := 0
+	for _, r := range ranges {
+		if r.start > last {
+			segments = append(segments, &widget.TextSegment{Text: text[last:r.start], Style: plain})
+		}
+		segments = append(segments, &widget.TextSegment{Text: text[r.start:r.end], Style: match})
+		last = r.end
+	}
+	if last < len(text) {
+		segments = append(segments, &widget.TextSegment{Text: text[last:], Style: plain})
+	}
+	return segments
+}
+
+// applyHighlight shows text in the cell's rich-text view with matches of
+// the highlight term emphasized, or in the plain label when nothing matches.
+func (dt *DataTable) applyHighlight(cell fyne.CanvasObject, text string, style rowStyle) {
+	_, label := cellParts(cell)
+	rich := cellHighlight(cell)
+
+	ranges := highlightRanges(text, dt.highlightTerm)
+	if len(ranges) == 0 {
+		if rich.Visible() {
+			rich.Hide()
+			label.Show()
+		}
+		return
+	}
+
+	rich.Segments = highlightSegments(text, ranges, style)
+	rich.Refresh()
+	label.Hide()
+	rich.Show()
+}
+
+// SetHighlightTerm emphasizes case-insensitive matches of term in every
+// rendered cell. An empty term turns highlighting off.
+func (dt *DataTable) SetHighlightTerm(term string) {
+	dt.highlightTerm = term
+	dt.Refresh()
+}
+
+// HighlightTerm returns the current highlight term.
+func (dt *DataTable) HighlightTerm() string {
+	return dt.highlightTerm
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestHighlightRanges(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		term string
+		want []textRange
+	}{
+		{"empty term", "Engineer", "", nil},
+		{"no match", "Designer", "eng", nil},
+		{"prefix", "Engineering", "eng", []textRange{{0, 3}}},
+		{"case insensitive", "DevOps ENGINEER", "eng", []textRange{{7, 10}}},
+		{"multiple", "Eng. engineer", "eng", []textRange{{0, 3}, {5, 8}}},
+		{"non-overlapping", "aaaa", "aa", []textRange{{0, 2}, {2, 4}}},
+		{"whole text", "abc", "ABC", []textRange{{0, 3}}},
+		{"term longer than text", "ab", "abc", nil},
+		{"multibyte", "Café CAFÉ", "café", []textRange{{0, 5}, {6, 11}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := highlightRanges(tt.text, tt.term)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("highlightRanges(%q, %q) = %v, want %v", tt.text, tt.term, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHighlightSegments(t *testing.T) {
+	text := "Senior Engineer"
+	segments := highlightSegments(text, highlightRanges(text, "eng"), rowStyleFor(0, false, false))
+
+	if len(segments) != 3 {
+		t.Fatalf("Expected 3 segments, got %d", len(segments))
+	}
+
+	wantText := []string{"Senior ", "Eng", "ineer"}
+	wantBold := []bool{false, true, false}
+	for i, segment := range segments {
+		ts := segment.(*widget.TextSegment)
+		if ts.Text != wantText[i] {
+			t.Errorf("Segment %d text = %q, want %q", i, ts.Text, wantText[i])
+		}
+		if ts.Style.TextStyle.Bold != wantBold[i] {
+			t.Errorf("Segment %d bold = %v, want %v", i, ts.Style.TextStyle.Bold, wantBold[i])
+		}
+	}
+	if segments[1].(*widget.TextSegment).Style.ColorName != DefaultHighlightColor {
+		t.Errorf("Expected match color %q", DefaultHighlightColor)
+	}
+}
+
+func TestApplyHighlight(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+	cell := newCellTemplate()
+	_, label := cellParts(cell)
+	rich := cellHighlight(cell)
+	style := rowStyleFor(0, false, false)
+
+	dt.SetHighlightTerm("eng")
+	dt.applyHighlight(cell, "Software Engineer", style)
+	if !rich.Visible() || label.Visible() {
+		t.Error("Expected rich text shown for a matching cell")
+	}
+	if got := rich.String(); got != "Software Engineer" {
+		t.Errorf("Expected rich text %q, got %q", "Software Engineer", got)
+	}
+
+	// The recycled cell goes back to the plain label
+	dt.applyHighlight(cell, "Designer", style)
+	if rich.Visible() || !label.Visible() {
+		t.Error("Expected plain label for a non-matching cell")
+	}
+
+	// GlobalSearch sets and clears the highlight
+	if err := dt.GlobalSearch("design"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if dt.HighlightTerm() != "design" {
+		t.Errorf("Expected highlight term %q, got %q", "design", dt.HighlightTerm())
+	}
+	if err := dt.GlobalSearch(""); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if dt.HighlightTerm() != "" {
+		t.Errorf("Expected highlight cleared, got %q", dt.HighlightTerm())
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// DefaultEmptyStateText is shown over the table when no rows are visible.
+const DefaultEmptyStateText = "No rows match your filter"
+
+// overlayState describes what, if anything, is drawn over the table grid.
+type overlayState int
+
+const (
+	overlayHidden  overlayState = iota // Rows are visible; no overlay
+	overlayEmpty                       // No visible rows; show the empty-state text
+	overlayLoading                     // Loading is active; show a spinner
+)
+
+// overlayStateFor decides which overlay to show. Loading takes precedence
+// over the empty state so a spinner is shown while data is still arriving.
+func overlayStateFor(visibleRows int, loading bool) overlayState {
+	if loading {
+		return overlayLoading
+	}
+	if visibleRows == 0 {
+		return overlayEmpty
+	}
+	return overlayHidden
+}
+
+// tableOverlay is the centered message/spinner drawn over the table.
+type tableOverlay struct {
+	container *fyne.Container
+	label     *widget.Label
+	activity  *widget.Activity
+}
+
+// newTableOverlay creates a hidden overlay.
+func newTableOverlay() *tableOverlay {
+	o := &tableOverlay{
+		label:    widget.NewLabel(""),
+		activity: widget.NewActivity(),
+	}
+	o.label.Alignment = fyne.TextAlignCenter
+	o.activity.Hide()
+	o.container = container.NewCenter(container.NewVBox(o.activity, o.label))
+	o.container.Hide()
+	return o
+}
+
+// apply shows or hides the overlay for the given state.
+func (o *tableOverlay) apply(state overlayState, emptyText string) {
+	switch state {
+	case overlayLoading:
+		o.label.SetText("Loading…")
+		o.activity.Show()
+		o.activity.Start()
+		o.container.Show()
+	case overlayEmpty:
+		if emptyText == "" {
+			emptyText = DefaultEmptyStateText
+		}
+		o.activity.Stop()
+		o.activity.Hide()
+		o.label.SetText(emptyText)
+		o.container.Show()
+	default:
+		o.activity.Stop()
+		o.activity.Hide()
+		o.container.Hide()
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// rejectAllFilter matches no rows.
+type rejectAllFilter struct{}
+
+func (rejectAllFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
+	return false, nil
+}
+
+func (rejectAllFilter) Description() string {
+	return "reject all"
+}
+
+func TestOverlayStateFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     int
+		loading  bool
+		expected overlayState
+	}{
+		{"rows visible", 5, false, overlayHidden},
+		{"no rows", 0, false, overlayEmpty},
+		{"loading with no rows", 0, true, overlayLoading},
+		{"loading with rows", 5, true, overlayLoading},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := overlayStateFor(tt.rows, tt.loading); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDataTable_EmptyStateOverlay(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	if dt.overlay.container.Visible() {
+		t.Fatal("Expected overlay hidden when rows are visible")
+	}
+
+	if err := dt.SetFilter(rejectAllFilter{}); err != nil {
+		t.Fatalf("Failed to set filter: %v", err)
+	}
+	if !dt.overlay.container.Visible() {
+		t.Fatal("Expected overlay visible when filter matches nothing")
+	}
+	if dt.overlay.label.Text != DefaultEmptyStateText {
+		t.Errorf("Expected %q, got %q", DefaultEmptyStateText, dt.overlay.label.Text)
+	}
+
+	if err := dt.ClearFilter(); err != nil {
+		t.Fatalf("Failed to clear filter: %v", err)
+	}
+	if dt.overlay.container.Visible() {
+		t.Error("Expected overlay hidden once rows reappear")
+	}
+}
+
+func TestDataTable_SetLoading(t *testing.T) {
+	dt := newClipboardTestTable(t)
+
+	dt.SetLoading(true)
+	if !dt.IsLoading() {
+		t.Error("Expected IsLoading to be true")
+	}
+	if !dt.overlay.container.Visible() || !dt.overlay.activity.Visible() {
+		t.Error("Expected loading overlay with spinner")
+	}
+
+	dt.SetLoading(false)
+	if dt.overlay.container.Visible() {
+		t.Error("Expected overlay hidden after loading ends")
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2/dialog"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// OnPaste sets a callback that handles pasted clipboard content in place of
+// the table. The handler receives the rows parsed from the clipboard; the
+// error it returns is reported as Paste's error.
+func (dt *DataTable) OnPaste(handler func(rows [][]string) error) {
+	dt.pasteHandler = handler
+}
+
+// PasteFromClipboard pastes the clipboard content into the table as Paste
+// does. Requires SetWindow to have been called.
+func (dt *DataTable) PasteFromClipboard() error {
+	if dt.window == nil {
+		return fmt.Errorf("paste needs a window: call SetWindow first")
+	}
+	return dt.Paste(dt.window.Clipboard().Content())
+}
+
+// Paste pastes tab-separated text, such as a block copied from a
+// spreadsheet, into the table. Without an OnPaste handler the data source
+// must implement datatable.MutableDataSource:
+//   - In cell selection mode with a cell selected, the block replaces the
+//     cells from the selected cell rightwards and down
+//   - Otherwise each line is appended as a new row, with one value per
+//     visible column; hidden columns are left null
+//
+// Values are parsed according to their column's type, and empty fields
+// become nulls. The block is checked as a whole before anything is written,
+// so an error leaves the data unchanged.
+func (dt *DataTable) Paste(text string) error {
+	rows, err := parseClipboardRows(text)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	if dt.pasteHandler != nil {
+		return dt.pasteHandler(rows)
+	}
+
+	source, ok := dt.model.GetDataSource().(datatable.MutableDataSource)
+	if !ok {
+		return fmt.Errorf("paste needs a data source that implements datatable.MutableDataSource")
+	}
+
+	if dt.config.SelectionMode == SelectionModeCell && dt.selectedCell.row >= 0 && dt.selectedCell.col >= 0 {
+		err = dt.pasteCells(source, rows, dt.selectedCell.row, dt.selectedCell.col)
+	} else {
+		err = dt.appendPastedRows(source, rows)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := dt.model.Reload(); err != nil {
+		return err
+	}
+
+	// Pasted values can change which rows pass the filters
+	dt.filterMu.Lock()
+	dt.filterGeneration++
+	dt.filterMu.Unlock()
+
+	dt.Refresh()
+	return nil
+}
+
+// pasteShortcut pastes the clipboard, showing any error in a dialog.
+func (dt *DataTable) pasteShortcut() {
+	if err := dt.PasteFromClipboard(); err != nil && dt.window != nil {
+		dialog.ShowError(err, dt.window)
+	}
+}
+
+// parseClipboardRows splits tab-separated text into rows of fields.
+// Fields may be quoted as spreadsheets do when they contain tabs, newlines
+// or quotes, with quotes inside doubled. Rows keep their own length, and
+// blank lines are skipped.
+func parseClipboardRows(text string) ([][]string, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = -1 // Ragged rows are checked by the caller
+	reader.LazyQuotes = true    // Unquoted fields such as 5" keep their quote
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse clipboard content: %w", err)
+	}
+	return rows, nil
+}
+
+// pasteCells replaces a block of cells whose top-left corner is the visible
+// cell at row, col.
+func (dt *DataTable) pasteCells(source datatable.MutableDataSource, rows [][]string, row, col int) error {
+	visibleCols := dt.model.GetVisibleColumnIndices()
+
+	type cellUpdate struct {
+		row, col int
+		value    datatable.Value
+	}
+	var updates []cellUpdate
+
+	for r, fields := range rows {
+		originalRow, err := dt.model.OriginalRowIndex(row + r)
+		if err != nil {
+			return fmt.Errorf("pasted block has %d rows, only %d fit below the selected cell: %w",
+				len(rows), dt.model.VisibleRowCount()-row, err)
+		}
+		if col+len(fields) > len(visibleCols) {
+			return fmt.Errorf("%w: pasted row %d has %d values, only %d fit right of the selected cell",
+				datatable.ErrSchemaMismatch, r+1, len(fields), len(visibleCols)-col)
+		}
+
+		for c, field := range fields {
+			originalCol := visibleCols[col+c]
+			value, err := dt.pastedValue(originalCol, field)
+			if err != nil {
+				return fmt.Errorf("pasted row %d: %w", r+1, err)
+			}
+			updates = append(updates, cellUpdate{originalRow, originalCol, value})
+		}
+	}
+
+	for _, u := range updates {
+		if err := source.SetCell(u.row, u.col, u.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendPastedRows appends each pasted row, mapping its values to the
+// visible columns in display order.
+func (dt *DataTable) appendPastedRows(source datatable.MutableDataSource, rows [][]string) error {
+	visibleCols := dt.model.GetVisibleColumnIndices()
+
+	newRows := make([][]datatable.Value, 0, len(rows))
+	for r, fields := range rows {
+		if len(fields) != len(visibleCols) {
+			return fmt.Errorf("%w: pasted row %d has %d values, the table shows %d columns",
+				datatable.ErrSchemaMismatch, r+1, len(fields), len(visibleCols))
+		}
+
+		values := make([]datatable.Value, source.ColumnCount())
+		for col := range values {
+			colType, _ := source.ColumnType(col)
+			values[col] = datatable.NewNullValue(colType)
+		}
+		for c, field := range fields {
+			value, err := dt.pastedValue(visibleCols[c], field)
+			if err != nil {
+				return fmt.Errorf("pasted row %d: %w", r+1, err)
+			}
+			values[visibleCols[c]] = value
+		}
+		newRows = append(newRows, values)
+	}
+
+	for _, values := range newRows {
+		if err := source.AppendRow(values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pastedValue parses a pasted field as a value of source column col.
+func (dt *DataTable) pastedValue(col int, field string) (datatable.Value, error) {
+	colType, err := dt.model.GetDataSource().ColumnType(col)
+	if err != nil {
+		return datatable.Value{}, err
+	}
+	value, err := parsePastedValue(field, colType)
+	if err != nil {
+		name, _ := dt.model.GetDataSource().ColumnName(col)
+		return datatable.Value{}, fmt.Errorf("column %s: %w", name, err)
+	}
+	return value, nil
+}
+
+// parsePastedValue parses text as a value of type colType. Empty text is a
+// null. Dates use the ISO 8601 layout and timestamps RFC 3339, or
+// "2006-01-02 15:04:05" as spreadsheets copy them.
+// Returns an error wrapping ErrTypeMismatch if text does not parse.
+func parsePastedValue(text string, colType datatable.DataType) (datatable.Value, error) {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return datatable.NewNullValue(colType), nil
+	}
+
+	var raw any
+	var err error
+	switch colType {
+	case datatable.TypeInt:
+		raw, err = strconv.ParseInt(trimmed, 10, 64)
+	case datatable.TypeFloat:
+		raw, err = strconv.ParseFloat(trimmed, 64)
+	case datatable.TypeBool:
+		raw, err = strconv.ParseBool(trimmed)
+	case datatable.TypeDate:
+		raw, err = time.Parse(time.DateOnly, trimmed)
+	case datatable.TypeTimestamp:
+		raw, err = time.Parse(time.RFC3339, trimmed)
+		if err != nil {
+			raw, err = time.Parse(time.DateTime, trimmed)
+		}
+	case datatable.TypeString, datatable.TypeDecimal:
+		raw = text
+	default:
+		return datatable.Value{}, fmt.Errorf("%w: cannot paste into a %s column", datatable.ErrTypeMismatch, colType)
+	}
+	if err != nil {
+		return datatable.Value{}, fmt.Errorf("%w: cannot convert %q to %s", datatable.ErrTypeMismatch, text, colType)
+	}
+	return datatable.NewValue(raw, colType), nil
+}
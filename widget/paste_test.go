@@ -0,0 +1,244 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
+)
+
+// newPasteTestTable builds a table over a mutable Name/Age/Joined source.
+func newPasteTestTable(t *testing.T, mode SelectionMode) (*DataTable, *datatable.TableModel) {
+	t.Helper()
+	test.NewTempApp(t)
+
+	source := datatabletest.NewMutableMockSource(
+		[]datatable.ColumnSchema{
+			{Name: "Name", Type: datatable.TypeString},
+			{Name: "Age", Type: datatable.TypeInt},
+			{Name: "Joined", Type: datatable.TypeDate},
+		},
+		[][]any{
+			{"Alice", int64(30), time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC)},
+			{"Bob", int64(25), time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	)
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	config := DefaultConfig()
+	config.SelectionMode = mode
+	return NewDataTableWithConfig(model, config), model
+}
+
+// visibleRowTexts returns the formatted cells of every visible row.
+func visibleRowTexts(t *testing.T, model *datatable.TableModel) [][]string {
+	t.Helper()
+	rows := make([][]string, model.VisibleRowCount())
+	for row := range rows {
+		for col := 0; col < model.VisibleColumnCount(); col++ {
+			cell, err := model.VisibleCell(row, col)
+			if err != nil {
+				t.Fatalf("VisibleCell(%d, %d) failed: %v", row, col, err)
+			}
+			rows[row] = append(rows[row], cell.Formatted)
+		}
+	}
+	return rows
+}
+
+func TestParseClipboardRows(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want [][]string
+	}{
+		{"single value", "Alice", [][]string{{"Alice"}}},
+		{"block", "a\tb\nc\td\n", [][]string{{"a", "b"}, {"c", "d"}}},
+		{"windows line endings", "a\tb\r\nc\td\r\n", [][]string{{"a", "b"}, {"c", "d"}}},
+		{"empty fields", "a\t\tc", [][]string{{"a", "", "c"}}},
+		{"quoted tab and newline", "\"a\tb\"\t\"line 1\nline 2\"", [][]string{{"a\tb", "line 1\nline 2"}}},
+		{"doubled quotes", `"Smith, ""The Boss"""` + "\tx", [][]string{{`Smith, "The Boss"`, "x"}}},
+		{"bare quote", "5\" screen\t2", [][]string{{`5" screen`, "2"}}},
+		{"ragged rows", "a\tb\tc\nd\ne\tf", [][]string{{"a", "b", "c"}, {"d"}, {"e", "f"}}},
+		{"blank lines skipped", "a\n\nb", [][]string{{"a"}, {"b"}}},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseClipboardRows(tt.text)
+			if err != nil {
+				t.Fatalf("parseClipboardRows() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseClipboardRows() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePastedValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		colType datatable.DataType
+		want    any
+		wantErr bool
+	}{
+		{"int", " 42 ", datatable.TypeInt, int64(42), false},
+		{"float", "1.5", datatable.TypeFloat, 1.5, false},
+		{"bool", "TRUE", datatable.TypeBool, true, false},
+		{"date", "2024-02-29", datatable.TypeDate, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"spreadsheet timestamp", "2024-02-29 08:30:00", datatable.TypeTimestamp,
+			time.Date(2024, 2, 29, 8, 30, 0, 0, time.UTC), false},
+		{"string kept as is", " padded ", datatable.TypeString, " padded ", false},
+		{"empty is null", "  ", datatable.TypeInt, nil, false},
+		{"bad int", "forty", datatable.TypeInt, nil, true},
+		{"bad date", "29/02/2024", datatable.TypeDate, nil, true},
+		{"unsupported type", "x", datatable.TypeList, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePastedValue(tt.text, tt.colType)
+			if tt.wantErr {
+				if !errors.Is(err, datatable.ErrTypeMismatch) {
+					t.Errorf("parsePastedValue() error = %v, want ErrTypeMismatch", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parsePastedValue() error = %v", err)
+			}
+			if got.Type != tt.colType || got.IsNull != (tt.want == nil) || got.Raw != tt.want {
+				t.Errorf("parsePastedValue() = %+v, want %v of type %s", got, tt.want, tt.colType)
+			}
+		})
+	}
+}
+
+func TestPaste_AppendRows(t *testing.T) {
+	dt, model := newPasteTestTable(t, SelectionModeRow)
+
+	if err := dt.Paste("Carol\t41\t2022-03-04\nDan\t\t2023-05-06\n"); err != nil {
+		t.Fatalf("Paste failed: %v", err)
+	}
+	want := [][]string{
+		{"Alice", "30", "2020-01-15"},
+		{"Bob", "25", "2021-06-01"},
+		{"Carol", "41", "2022-03-04"},
+		{"Dan", "", "2023-05-06"},
+	}
+	if got := visibleRowTexts(t, model); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+
+	// Ragged and mistyped blocks are rejected whole
+	if err := dt.Paste("Eve\t22\t2024-01-01\nFrank\t33"); !errors.Is(err, datatable.ErrSchemaMismatch) {
+		t.Errorf("ragged Paste error = %v, want ErrSchemaMismatch", err)
+	}
+	if err := dt.Paste("Eve\t22\t2024-01-01\nFrank\tmany\t2024-01-01"); !errors.Is(err, datatable.ErrTypeMismatch) {
+		t.Errorf("mistyped Paste error = %v, want ErrTypeMismatch", err)
+	}
+	if got := model.VisibleRowCount(); got != 4 {
+		t.Errorf("row count after rejected pastes = %d, want 4", got)
+	}
+
+	// With a column hidden, pasted values follow the visible columns
+	if err := model.SetVisibleColumns([]int{1, 0}); err != nil {
+		t.Fatalf("SetVisibleColumns failed: %v", err)
+	}
+	if err := dt.Paste("52\tGina"); err != nil {
+		t.Fatalf("Paste failed: %v", err)
+	}
+	source := model.GetDataSource()
+	name, _ := source.Cell(4, 0)
+	joined, _ := source.Cell(4, 2)
+	if name.Raw != "Gina" || !joined.IsNull {
+		t.Errorf("appended row = %v, %v, want Gina and a null date", name.Raw, joined)
+	}
+}
+
+func TestPaste_ReplaceCells(t *testing.T) {
+	dt, model := newPasteTestTable(t, SelectionModeCell)
+	dt.selectedCell.row, dt.selectedCell.col = 0, 1
+
+	if err := dt.Paste("31\t2020-02-01\n26\t"); err != nil {
+		t.Fatalf("Paste failed: %v", err)
+	}
+	want := [][]string{
+		{"Alice", "31", "2020-02-01"},
+		{"Bob", "26", ""},
+	}
+	if got := visibleRowTexts(t, model); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+
+	// Blocks that do not fit are rejected before anything is written
+	if err := dt.Paste("1\n2\n3"); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("tall Paste error = %v, want ErrInvalidRow", err)
+	}
+	if err := dt.Paste("1\t2020-01-01\textra"); !errors.Is(err, datatable.ErrSchemaMismatch) {
+		t.Errorf("wide Paste error = %v, want ErrSchemaMismatch", err)
+	}
+	if got := visibleRowTexts(t, model); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows after rejected pastes = %q, want %q", got, want)
+	}
+}
+
+func TestPaste_Handler(t *testing.T) {
+	dt, model := newPasteTestTable(t, SelectionModeRow)
+
+	var got [][]string
+	dt.OnPaste(func(rows [][]string) error {
+		got = rows
+		return nil
+	})
+	if err := dt.Paste("x\ty\nz"); err != nil {
+		t.Fatalf("Paste failed: %v", err)
+	}
+	if want := [][]string{{"x", "y"}, {"z"}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("handler rows = %q, want %q", got, want)
+	}
+	if model.VisibleRowCount() != 2 {
+		t.Error("Expected the handler to replace the default paste")
+	}
+
+	errHandled := errors.New("rejected")
+	dt.OnPaste(func(rows [][]string) error { return errHandled })
+	if err := dt.Paste("x"); !errors.Is(err, errHandled) {
+		t.Errorf("Paste error = %v, want the handler's error", err)
+	}
+}
+
+func TestPaste_ReadOnlySource(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+	if err := dt.Paste("a\tb"); err == nil {
+		t.Error("Expected error pasting into a read-only source")
+	}
+	if err := dt.PasteFromClipboard(); err == nil {
+		t.Error("Expected error pasting without a window")
+	}
+}
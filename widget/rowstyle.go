@@ -0,0 +1,101 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"image/color"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	ttwidget "github.com/dweymouth/fyne-tooltip/widget"
+)
+
+// DefaultStripeColor is the theme color used for zebra stripes.
+const DefaultStripeColor = theme.ColorNameInputBackground
+
+// rowStyle describes how a data cell is drawn.
+type rowStyle struct {
+	importance widget.Importance
+	textStyle  fyne.TextStyle
+	striped    bool // Draw the stripe background behind the cell
+}
+
+// rowStyleFor decides the style of a cell in the given visible row.
+// Selection controls the text; striping controls the background, so a
+// selected odd row keeps its stripe.
+func rowStyleFor(row int, selected bool, zebra bool) rowStyle {
+	style := rowStyle{
+		importance: widget.MediumImportance,
+		striped:    zebra && row%2 == 1,
+	}
+	if selected {
+		style.importance = widget.HighImportance
+		style.textStyle = fyne.TextStyle{Bold: true}
+	}
+	return style
+}
+
+// newCellTemplate creates a data cell: a stripe background under a tooltip
+// label, plus a hidden rich-text view used when search matches are highlighted.
+func newCellTemplate() fyne.CanvasObject {
+	background := canvas.NewRectangle(color.Transparent)
+	label := ttwidget.NewLabel("")
+	// Enable ellipsis truncation for text that's too long
+	label.Truncation = fyne.TextTruncateEllipsis
+	rich := widget.NewRichText()
+	rich.Truncation = fyne.TextTruncateEllipsis
+	rich.Hide()
+	return container.NewStack(background, label, rich)
+}
+
+// cellParts returns the background and label of a cell made by newCellTemplate.
+func cellParts(cell fyne.CanvasObject) (*canvas.Rectangle, *ttwidget.Label) {
+	c := cell.(*fyne.Container)
+	return c.Objects[0].(*canvas.Rectangle), c.Objects[1].(*ttwidget.Label)
+}
+
+// cellHighlight returns the rich-text view of a cell made by newCellTemplate.
+func cellHighlight(cell fyne.CanvasObject) *widget.RichText {
+	return cell.(*fyne.Container).Objects[2].(*widget.RichText)
+}
+
+// applyRowStyle applies style to a cell made by newCellTemplate.
+func (dt *DataTable) applyRowStyle(cell fyne.CanvasObject, style rowStyle) {
+	background, label := cellParts(cell)
+
+	label.Importance = style.importance
+	label.TextStyle = style.textStyle
+
+	fill := color.Color(color.Transparent)
+	if style.striped {
+		fill = theme.Color(dt.stripeColorName())
+	}
+	if background.FillColor != fill {
+		background.FillColor = fill
+		background.Refresh()
+	}
+}
+
+// stripeColorName returns the configured stripe color, falling back to the default.
+func (dt *DataTable) stripeColorName() fyne.ThemeColorName {
+	if dt.config.StripeColor == "" {
+		return DefaultStripeColor
+	}
+	return dt.config.StripeColor
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"image/color"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+func TestRowStyleFor(t *testing.T) {
+	tests := []struct {
+		name       string
+		row        int
+		selected   bool
+		zebra      bool
+		importance widget.Importance
+		bold       bool
+		striped    bool
+	}{
+		{"even row", 0, false, true, widget.MediumImportance, false, false},
+		{"odd row", 1, false, true, widget.MediumImportance, false, true},
+		{"odd row without zebra", 1, false, false, widget.MediumImportance, false, false},
+		{"selected even row", 2, true, true, widget.HighImportance, true, false},
+		{"selected odd row keeps stripe", 3, true, true, widget.HighImportance, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			style := rowStyleFor(tt.row, tt.selected, tt.zebra)
+			if style.importance != tt.importance {
+				t.Errorf("Expected importance %v, got %v", tt.importance, style.importance)
+			}
+			if style.textStyle.Bold != tt.bold {
+				t.Errorf("Expected bold %v, got %v", tt.bold, style.textStyle.Bold)
+			}
+			if style.striped != tt.striped {
+				t.Errorf("Expected striped %v, got %v", tt.striped, style.striped)
+			}
+		})
+	}
+}
+
+func TestApplyRowStyle_StripeColor(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := DefaultConfig()
+	config.ZebraStripe = true
+	config.StripeColor = theme.ColorNamePrimary
+	dt := newClipboardTestTableWithConfig(t, config)
+
+	cell := newCellTemplate()
+	dt.applyRowStyle(cell, rowStyleFor(1, false, true))
+	background, _ := cellParts(cell)
+	if background.FillColor != theme.Color(theme.ColorNamePrimary) {
+		t.Errorf("Expected stripe color %v, got %v", theme.Color(theme.ColorNamePrimary), background.FillColor)
+	}
+
+	dt.applyRowStyle(cell, rowStyleFor(2, false, true))
+	if background.FillColor != color.Transparent {
+		t.Errorf("Expected transparent background, got %v", background.FillColor)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// SearchBox provides a "find anything" entry that filters rows matching
+// the text in any visible column.
+type SearchBox struct {
+	widget.BaseWidget
+
+	dataTable *DataTable
+
+	// UI components
+	searchEntry *widget.Entry
+	clearButton *widget.Button
+	container   *fyne.Container
+
+	debouncer *debouncer
+}
+
+// NewSearchBox creates a new search box for the given DataTable.
+func NewSearchBox(dt *DataTable) *SearchBox {
+	sb := &SearchBox{
+		dataTable: dt,
+		debouncer: newDebouncer(dt.config.FilterDebounce, nil),
+	}
+
+	sb.ExtendBaseWidget(sb)
+	sb.buildUI()
+
+	return sb
+}
+
+// buildUI constructs the search box's UI.
+func (sb *SearchBox) buildUI() {
+	sb.searchEntry = widget.NewEntry()
+	sb.searchEntry.SetPlaceHolder("Search all columns")
+	sb.searchEntry.OnSubmitted = func(term string) {
+		sb.debouncer.Stop()
+		sb.search(term)
+	}
+	sb.searchEntry.OnChanged = func(term string) {
+		// Search once the user pauses typing
+		sb.debouncer.Trigger(func() {
+			fyne.Do(func() {
+				sb.search(term)
+			})
+		})
+	}
+
+	sb.clearButton = widget.NewButton("Clear", func() {
+		sb.searchEntry.SetText("")
+		sb.debouncer.Stop() // SetText triggers OnChanged
+		sb.search("")
+	})
+
+	sb.container = container.NewBorder(
+		nil,
+		nil,
+		widget.NewLabel("Search:"),
+		sb.clearButton,
+		sb.searchEntry,
+	)
+}
+
+// search runs the global search, showing any error in the placeholder.
+func (sb *SearchBox) search(term string) {
+	if err := sb.dataTable.GlobalSearch(term); err != nil {
+		sb.searchEntry.SetPlaceHolder("Error: " + err.Error())
+	}
+}
+
+// CreateRenderer returns the widget's renderer.
+func (sb *SearchBox) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(sb.container)
+}
+
+// SetTerm sets the search text.
+func (sb *SearchBox) SetTerm(term string) {
+	sb.searchEntry.SetText(term)
+}
+
+// GetTerm returns the current search text.
+func (sb *SearchBox) GetTerm() string {
+	return sb.searchEntry.Text
+}
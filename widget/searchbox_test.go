@@ -0,0 +1,105 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/magpierre/fyne-datatable/adapters/memory"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// newEmployeeTestTable builds a table over a subset of the employee sample.
+func newEmployeeTestTable(t *testing.T, config Config) *DataTable {
+	t.Helper()
+	test.NewTempApp(t)
+
+	data := [][]string{
+		{"Alice Johnson", "Software Engineer", "Engineering"},
+		{"Bob Smith", "UI/UX Designer", "Design"},
+		{"Eve Wilson", "DevOps Engineer", "Operations"},
+		{"Frank Miller", "Marketing Specialist", "Marketing"},
+		{"Jack Thompson", "Frontend Developer", "Engineering"},
+	}
+	source, err := memory.NewDataSource(data, []string{"Name", "Position", "Department"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	return NewDataTableWithConfig(model, config)
+}
+
+func TestGlobalSearch(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := dt.model.VisibleRowCount(); got != 3 {
+		t.Errorf("Expected 3 rows matching \"eng\", got %d", got)
+	}
+
+	// Hidden columns are not searched
+	if err := dt.model.SetVisibleColumns([]int{0, 2}); err != nil {
+		t.Fatalf("Failed to set visible columns: %v", err)
+	}
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := dt.model.VisibleRowCount(); got != 2 {
+		t.Errorf("Expected 2 rows matching \"eng\" in Name/Department, got %d", got)
+	}
+
+	// Clearing the term removes the filter
+	if err := dt.GlobalSearch(""); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if got := dt.model.VisibleRowCount(); got != 5 {
+		t.Errorf("Expected all 5 rows after clearing, got %d", got)
+	}
+	if filters := dt.model.GetActiveFilters(); len(filters) != 0 {
+		t.Errorf("Expected no active filters after clearing, got %d", len(filters))
+	}
+}
+
+func TestSearchBox_Entry(t *testing.T) {
+	config := DefaultConfig()
+	config.ShowSearchBox = true
+	config.FilterDebounce = 0
+	dt := newEmployeeTestTable(t, config)
+
+	if dt.searchBox == nil {
+		t.Fatal("Expected search box when ShowSearchBox is set")
+	}
+
+	dt.searchBox.searchEntry.OnSubmitted("designer")
+	if got := dt.model.VisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row matching \"designer\", got %d", got)
+	}
+
+	test.Tap(dt.searchBox.clearButton)
+	if got := dt.model.VisibleRowCount(); got != 5 {
+		t.Errorf("Expected all 5 rows after clearing, got %d", got)
+	}
+	if dt.searchBox.GetTerm() != "" {
+		t.Errorf("Expected empty search term, got %q", dt.searchBox.GetTerm())
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"fmt"
+	"slices"
+
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+// OnSelectionChanged sets a callback for when the set of selected rows
+// changes in row selection mode. The handler receives the complete
+// selection as visible row indices in ascending order; it is empty when the
+// last row is deselected. Unlike OnCellSelected, it reports the effective
+// selection rather than the row that was clicked.
+func (dt *DataTable) OnSelectionChanged(handler func(selected []int)) {
+	dt.selectionChangedHandler = handler
+}
+
+// OnSortChanged sets a callback for when the table's sort keys change,
+// whether through header clicks, SortByColumn, ClearSort, or a filter that
+// resets the sort. The handler receives the sort keys in priority order;
+// it is empty when the table is no longer sorted.
+func (dt *DataTable) OnSortChanged(handler func(states []datatable.SortState)) {
+	dt.sortChangedHandler = handler
+}
+
+// SelectRow selects a row from code. row is a visible row index, as shown in
+// the table after filtering and sorting. It does not scroll; use
+// SelectAndReveal to also bring the row into view.
+// In row selection mode the row replaces the current selection. In cell
+// selection mode the row's first cell is selected, calling the
+// OnCellSelected handler as a click would.
+// Returns ErrInvalidRow if row is out of the visible range.
+func (dt *DataTable) SelectRow(row int) error {
+	if err := dt.checkVisibleRow(row); err != nil {
+		return err
+	}
+
+	if dt.config.SelectionMode == SelectionModeRow {
+		dt.setSelectedRows([]int{row})
+	} else {
+		dt.table.Select(widget.TableCellID{Row: row, Col: 0})
+	}
+	return nil
+}
+
+// SelectAndReveal selects a visible row like SelectRow and scrolls it into
+// view.
+// Returns ErrInvalidRow if row is out of the visible range.
+func (dt *DataTable) SelectAndReveal(row int) error {
+	if err := dt.SelectRow(row); err != nil {
+		return err
+	}
+	dt.ScrollToRow(row)
+	return nil
+}
+
+// ScrollToRow scrolls the table so the visible row is in view. Rows out of
+// range are clamped to the first or last row. Horizontally the table keeps
+// the selected cell's column in view, or the first column if no cell is
+// selected.
+func (dt *DataTable) ScrollToRow(row int) {
+	if target, ok := dt.rowScrollTarget(row); ok {
+		dt.table.ScrollTo(target)
+	}
+}
+
+// ScrollToColumn scrolls the table so the visible column is in view.
+// Columns out of range are clamped to the first or last column. Vertically
+// the table keeps the selected row in view, or the first row if none is
+// selected.
+func (dt *DataTable) ScrollToColumn(col int) {
+	if target, ok := dt.columnScrollTarget(col); ok {
+		dt.table.ScrollTo(target)
+	}
+}
+
+// rowScrollTarget returns the cell to scroll to for revealing row. ok is
+// false when the table has no rows or columns.
+func (dt *DataTable) rowScrollTarget(row int) (target widget.TableCellID, ok bool) {
+	target.Row, ok = clampIndex(row, dt.model.VisibleRowCount())
+	if !ok {
+		return target, false
+	}
+	target.Col, ok = clampIndex(max(dt.selectedCell.col, 0), dt.model.VisibleColumnCount())
+	return target, ok
+}
+
+// columnScrollTarget returns the cell to scroll to for revealing col. ok is
+// false when the table has no rows or columns.
+func (dt *DataTable) columnScrollTarget(col int) (target widget.TableCellID, ok bool) {
+	target.Col, ok = clampIndex(col, dt.model.VisibleColumnCount())
+	if !ok {
+		return target, false
+	}
+	row := dt.selectedCell.row
+	if dt.config.SelectionMode == SelectionModeRow {
+		row = dt.selectedRow
+	}
+	target.Row, ok = clampIndex(max(row, 0), dt.model.VisibleRowCount())
+	return target, ok
+}
+
+// clampIndex limits i to the range [0, n). ok is false when n is 0.
+func clampIndex(i, n int) (int, bool) {
+	if n <= 0 {
+		return 0, false
+	}
+	return min(max(i, 0), n-1), true
+}
+
+// SelectRows replaces the row selection with the given visible row indices.
+// An empty list clears the selection. In cell selection mode only one row
+// can be selected, as with SelectRow.
+// Returns ErrInvalidRow if any index is out of the visible range; the
+// selection is then unchanged.
+func (dt *DataTable) SelectRows(rows []int) error {
+	for _, row := range rows {
+		if err := dt.checkVisibleRow(row); err != nil {
+			return err
+		}
+	}
+
+	if dt.config.SelectionMode != SelectionModeRow {
+		switch len(rows) {
+		case 0:
+			dt.ClearSelection()
+			return nil
+		case 1:
+			return dt.SelectRow(rows[0])
+		}
+		return fmt.Errorf("cannot select %d rows in cell selection mode", len(rows))
+	}
+
+	sorted := slices.Clone(rows)
+	slices.Sort(sorted)
+	dt.setSelectedRows(slices.Compact(sorted))
+	return nil
+}
+
+// GetSelectedRows returns the selected rows as visible row indices in
+// ascending order. Visible indices refer to the rows as currently shown, so
+// they change when the table is filtered or sorted; use the model's
+// GetVisibleRowIndices to map them to data source rows. In cell selection
+// mode the row of the selected cell is returned.
+func (dt *DataTable) GetSelectedRows() []int {
+	if dt.config.SelectionMode != SelectionModeRow {
+		if dt.selectedCell.row < 0 {
+			return []int{}
+		}
+		return []int{dt.selectedCell.row}
+	}
+
+	selected := dt.selectedRowIndices()
+	if selected == nil {
+		selected = []int{}
+	}
+	return selected
+}
+
+// checkVisibleRow returns ErrInvalidRow if row is out of the visible range.
+func (dt *DataTable) checkVisibleRow(row int) error {
+	if count := dt.model.VisibleRowCount(); row < 0 || row >= count {
+		return fmt.Errorf("%w: %d (visible range: 0-%d)", datatable.ErrInvalidRow, row, count-1)
+	}
+	return nil
+}
+
+// SelectAllVisible selects every visible row in row selection mode. Rows
+// hidden by the current filter are not selected.
+func (dt *DataTable) SelectAllVisible() {
+	rows := make([]int, dt.model.VisibleRowCount())
+	for i := range rows {
+		rows[i] = i
+	}
+	dt.setSelectedRows(rows)
+}
+
+// ClearSelection deselects all rows and any selected cell.
+func (dt *DataTable) ClearSelection() {
+	dt.selectedCell.row = -1
+	dt.selectedCell.col = -1
+	dt.focusedCol = -1
+	if dt.table != nil {
+		dt.table.UnselectAll()
+	}
+	dt.setSelectedRows(nil)
+}
+
+// InvertSelection selects the visible rows that are not selected and
+// deselects those that are.
+func (dt *DataTable) InvertSelection() {
+	var rows []int
+	for row := 0; row < dt.model.VisibleRowCount(); row++ {
+		if !dt.selectedRows[row] {
+			rows = append(rows, row)
+		}
+	}
+	dt.setSelectedRows(rows)
+}
+
+// setSelectedRows replaces the row selection with rows, given in ascending
+// order, refreshes the table and reports the change, if any.
+func (dt *DataTable) setSelectedRows(rows []int) {
+	changed := !slices.Equal(dt.selectedRowIndices(), rows)
+
+	dt.selectedRows = make(map[int]bool, len(rows))
+	for _, row := range rows {
+		dt.selectedRows[row] = true
+	}
+	dt.selectedRow = -1
+	if len(rows) > 0 {
+		dt.selectedRow = rows[len(rows)-1]
+	}
+
+	if dt.table != nil {
+		dt.table.Refresh()
+	}
+	dt.Refresh()
+
+	if changed {
+		dt.notifySelectionChanged()
+	}
+}
+
+// toggleRowSelection flips the selection of a visible row, tracks it as the
+// most recently selected row, and reports the new selection.
+func (dt *DataTable) toggleRowSelection(row int) {
+	dt.selectedRows[row] = !dt.selectedRows[row]
+
+	if dt.selectedRows[row] {
+		dt.selectedRow = row
+	} else if dt.selectedRow == row {
+		dt.selectedRow = -1
+	}
+
+	dt.notifySelectionChanged()
+}
+
+// notifySelectionChanged passes the current selection to the selection
+// changed handler, if any.
+func (dt *DataTable) notifySelectionChanged() {
+	if dt.selectionChangedHandler == nil {
+		return
+	}
+	selected := dt.selectedRowIndices()
+	if selected == nil {
+		selected = []int{}
+	}
+	dt.selectionChangedHandler(selected)
+}
+
+// notifySortChanged passes the model's sort keys to the sort changed
+// handler if they differ from those last reported.
+func (dt *DataTable) notifySortChanged() {
+	states := dt.model.GetSortStates()
+	if slices.Equal(states, dt.reportedSort) {
+		return
+	}
+	dt.reportedSort = states
+
+	if dt.sortChangedHandler != nil {
+		dt.sortChangedHandler(slices.Clone(states))
+	}
+}
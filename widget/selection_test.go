@@ -0,0 +1,431 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/adapters/memory"
+	"github.com/magpierre/fyne-datatable/datatable"
+)
+
+func TestOnSelectionChanged(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	var got [][]int
+	var clicked []int
+	dt.OnSelectionChanged(func(selected []int) {
+		got = append(got, selected)
+	})
+	dt.OnCellSelected(func(row, col int) {
+		clicked = append(clicked, row)
+	})
+
+	for _, row := range []int{3, 0, 4, 3, 0, 4} {
+		dt.table.OnSelected(widget.TableCellID{Row: row, Col: 0})
+	}
+
+	want := [][]int{{3}, {0, 3}, {0, 3, 4}, {0, 4}, {4}, {}}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d selection changes, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("change %d: selection = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if got[len(got)-1] == nil {
+		t.Error("Expected an empty, non-nil selection after deselecting everything")
+	}
+
+	// Cell selection still reports the clicked row
+	if !slices.Equal(clicked, []int{3, 0, 4, 3, 0, 4}) {
+		t.Errorf("cell selections = %v", clicked)
+	}
+
+	// Reconfiguring clears the selection
+	dt.table.OnSelected(widget.TableCellID{Row: 1, Col: 0})
+	got = nil
+	dt.Reconfigure(config)
+	if len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("Expected one empty selection after Reconfigure, got %v", got)
+	}
+}
+
+func TestOnSortChanged(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+
+	var got [][]datatable.SortState
+	dt.OnSortChanged(func(states []datatable.SortState) {
+		got = append(got, states)
+	})
+
+	if err := dt.SortByColumn(0, datatable.SortAscending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if err := dt.SortByColumn(0, datatable.SortAscending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if err := dt.SortByColumn(2, datatable.SortDescending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	dt.RestoreSourceOrder()
+
+	// Filtering resets the sort
+	if err := dt.SortByColumn(1, datatable.SortAscending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+
+	want := [][]datatable.SortState{
+		{{Column: 0, Direction: datatable.SortAscending}},
+		{{Column: 2, Direction: datatable.SortDescending}},
+		{},
+		{{Column: 1, Direction: datatable.SortAscending}},
+		{},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d sort changes (repeats are not reported), got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("change %d: states = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBulkSelection(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	var got [][]int
+	dt.OnSelectionChanged(func(selected []int) {
+		got = append(got, selected)
+	})
+
+	// Only the rows left by the filter are selected
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	dt.SelectAllVisible()
+	if want := []int{0, 1, 2}; !slices.Equal(dt.selectedRowIndices(), want) {
+		t.Errorf("after SelectAllVisible: selection = %v, want %v", dt.selectedRowIndices(), want)
+	}
+
+	dt.table.OnSelected(widget.TableCellID{Row: 1, Col: 0}) // Deselect row 1
+	dt.InvertSelection()
+	if want := []int{1}; !slices.Equal(dt.selectedRowIndices(), want) {
+		t.Errorf("after InvertSelection: selection = %v, want %v", dt.selectedRowIndices(), want)
+	}
+
+	dt.ClearSelection()
+	if len(dt.selectedRowIndices()) != 0 {
+		t.Errorf("after ClearSelection: selection = %v, want none", dt.selectedRowIndices())
+	}
+	dt.ClearSelection() // Nothing to clear, no callback
+
+	want := [][]int{{0, 1, 2}, {0, 2}, {1}, {}}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d selection changes, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Errorf("change %d: selection = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSelectAllShortcut(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	window := test.NewWindow(dt)
+	defer window.Close()
+	dt.SetWindow(window)
+
+	window.Canvas().(fyne.Shortcutable).TypedShortcut(&desktop.CustomShortcut{
+		KeyName:  fyne.KeyA,
+		Modifier: fyne.KeyModifierControl,
+	})
+	if got := len(dt.selectedRowIndices()); got != 5 {
+		t.Errorf("Expected Ctrl+A to select 5 rows, got %d", got)
+	}
+}
+
+func TestSelectRows(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	var changes int
+	dt.OnSelectionChanged(func(selected []int) {
+		changes++
+	})
+
+	if err := dt.SelectRow(2); err != nil {
+		t.Fatalf("SelectRow failed: %v", err)
+	}
+	if got := dt.GetSelectedRows(); !slices.Equal(got, []int{2}) {
+		t.Errorf("GetSelectedRows() = %v, want [2]", got)
+	}
+
+	// Rows are returned sorted, without duplicates
+	if err := dt.SelectRows([]int{4, 0, 4}); err != nil {
+		t.Fatalf("SelectRows failed: %v", err)
+	}
+	if got := dt.GetSelectedRows(); !slices.Equal(got, []int{0, 4}) {
+		t.Errorf("GetSelectedRows() = %v, want [0 4]", got)
+	}
+
+	// Indices refer to the visible rows
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	for _, rows := range [][]int{{3}, {0, -1}} {
+		if err := dt.SelectRows(rows); !errors.Is(err, datatable.ErrInvalidRow) {
+			t.Errorf("SelectRows(%v) error = %v, want ErrInvalidRow", rows, err)
+		}
+	}
+	if err := dt.SelectRow(3); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("SelectRow(3) error = %v, want ErrInvalidRow", err)
+	}
+	if got := dt.GetSelectedRows(); !slices.Equal(got, []int{0, 4}) {
+		t.Errorf("GetSelectedRows() = %v after invalid selections, want it unchanged", got)
+	}
+
+	if err := dt.SelectRows(nil); err != nil {
+		t.Fatalf("SelectRows(nil) failed: %v", err)
+	}
+	if got := dt.GetSelectedRows(); got == nil || len(got) != 0 {
+		t.Errorf("GetSelectedRows() = %#v, want empty", got)
+	}
+	if changes != 3 {
+		t.Errorf("Expected 3 selection changes, got %d", changes)
+	}
+}
+
+func TestSelectRows_CellMode(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeCell
+	dt := newEmployeeTestTable(t, config)
+
+	var selected []int
+	dt.OnCellSelected(func(row, col int) {
+		selected = append(selected, row, col)
+	})
+
+	if err := dt.SelectRow(1); err != nil {
+		t.Fatalf("SelectRow failed: %v", err)
+	}
+	if got := dt.GetSelectedRows(); !slices.Equal(got, []int{1}) {
+		t.Errorf("GetSelectedRows() = %v, want [1]", got)
+	}
+	if !slices.Equal(selected, []int{1, 0}) {
+		t.Errorf("OnCellSelected calls = %v, want cell (1, 0)", selected)
+	}
+
+	if err := dt.SelectRows([]int{0, 1}); err == nil {
+		t.Error("Expected an error selecting two rows in cell mode")
+	}
+
+	dt.ClearSelection()
+	if got := dt.GetSelectedRows(); len(got) != 0 {
+		t.Errorf("GetSelectedRows() = %v after ClearSelection, want empty", got)
+	}
+}
+
+func TestSortKey(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeCell
+	dt := newEmployeeTestTable(t, config)
+
+	// Without a selected cell the key does nothing
+	dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyS})
+	if dt.model.IsSorted() {
+		t.Fatal("Expected no sort without a selected cell")
+	}
+
+	dt.table.Select(widget.TableCellID{Row: 1, Col: 2})
+	want := []datatable.SortDirection{datatable.SortAscending, datatable.SortDescending, datatable.SortNone}
+	for _, direction := range want {
+		dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyS})
+		state := dt.model.GetSortState()
+		if direction == datatable.SortNone {
+			if dt.model.IsSorted() {
+				t.Errorf("Expected no sort after cycling, got %v", state)
+			}
+			continue
+		}
+		if state.Column != 2 || state.Direction != direction {
+			t.Errorf("sort state = column %d %s, want column 2 %s", state.Column, state.Direction, direction)
+		}
+	}
+
+	// Other keys and a disabled sort key leave the sort alone
+	dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyD})
+	config.SortKey = ""
+	dt.Reconfigure(config)
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 1})
+	dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyS})
+	if dt.model.IsSorted() {
+		t.Errorf("Expected no sort, got %v", dt.model.GetSortState())
+	}
+}
+
+func TestSortKey_RowMode(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	// The key sorts the column of the last clicked cell
+	dt.table.Select(widget.TableCellID{Row: 1, Col: 2})
+	dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyS})
+	state := dt.model.GetSortState()
+	if state.Column != 2 || state.Direction != datatable.SortAscending {
+		t.Errorf("sort state = column %d %s, want column 2 %s", state.Column, state.Direction, datatable.SortAscending)
+	}
+
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 0})
+	dt.TypedKey(&fyne.KeyEvent{Name: fyne.KeyS})
+	if state := dt.model.GetSortState(); state.Column != 0 {
+		t.Errorf("sort state column = %d, want 0", state.Column)
+	}
+}
+
+func TestAutoDetectSortTypes(t *testing.T) {
+	test.NewTempApp(t)
+
+	// The memory source reports every column as a string
+	source, err := memory.NewDataSource([][]string{{"Pen", "9"}, {"Lamp", "100"}, {"Book", "10"}}, []string{"Item", "Qty"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+
+	qtyColumn := func() []string {
+		values := make([]string, model.VisibleRowCount())
+		for row := range values {
+			cell, _ := model.VisibleCell(row, 1)
+			values[row] = cell.Formatted
+		}
+		return values
+	}
+
+	config := DefaultConfig()
+	dt := NewDataTableWithConfig(model, config)
+	if err := dt.SortByColumn(1, datatable.SortAscending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if got, want := qtyColumn(), []string{"10", "100", "9"}; !slices.Equal(got, want) {
+		t.Errorf("text order = %v, want %v", got, want)
+	}
+
+	config.AutoDetectSortTypes = true
+	dt.Reconfigure(config)
+	if err := dt.SortByColumn(1, datatable.SortAscending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+	if got, want := qtyColumn(), []string{"9", "10", "100"}; !slices.Equal(got, want) {
+		t.Errorf("detected order = %v, want %v", got, want)
+	}
+}
+
+func TestClampIndex(t *testing.T) {
+	tests := []struct {
+		i, n   int
+		want   int
+		wantOK bool
+	}{
+		{0, 5, 0, true},
+		{3, 5, 3, true},
+		{5, 5, 4, true},
+		{99, 5, 4, true},
+		{-1, 5, 0, true},
+		{0, 0, 0, false},
+		{2, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := clampIndex(tt.i, tt.n)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("clampIndex(%d, %d) = %d, %v, want %d, %v", tt.i, tt.n, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestScrollTargets(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeCell
+	dt := newEmployeeTestTable(t, config)
+
+	// Without a selection the other axis starts at 0
+	if target, ok := dt.rowScrollTarget(10); !ok || target != (widget.TableCellID{Row: 4, Col: 0}) {
+		t.Errorf("rowScrollTarget(10) = %v, %v, want row 4, col 0", target, ok)
+	}
+
+	// The selected cell stays in view on the other axis
+	dt.table.Select(widget.TableCellID{Row: 3, Col: 2})
+	if target, ok := dt.rowScrollTarget(1); !ok || target != (widget.TableCellID{Row: 1, Col: 2}) {
+		t.Errorf("rowScrollTarget(1) = %v, %v, want row 1, col 2", target, ok)
+	}
+	if target, ok := dt.columnScrollTarget(-3); !ok || target != (widget.TableCellID{Row: 3, Col: 0}) {
+		t.Errorf("columnScrollTarget(-3) = %v, %v, want row 3, col 0", target, ok)
+	}
+
+	// Nothing to scroll to in an empty view
+	if err := dt.GlobalSearch("no such employee"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if _, ok := dt.rowScrollTarget(0); ok {
+		t.Error("Expected no row target for an empty table")
+	}
+	dt.ScrollToRow(0) // Must not panic
+	dt.ScrollToColumn(0)
+}
+
+func TestSelectAndReveal(t *testing.T) {
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := newEmployeeTestTable(t, config)
+
+	if err := dt.SelectAndReveal(4); err != nil {
+		t.Fatalf("SelectAndReveal failed: %v", err)
+	}
+	if got := dt.GetSelectedRows(); !slices.Equal(got, []int{4}) {
+		t.Errorf("GetSelectedRows() = %v, want [4]", got)
+	}
+	if target, _ := dt.columnScrollTarget(1); target.Row != 4 {
+		t.Errorf("columnScrollTarget(1) row = %d, want the selected row 4", target.Row)
+	}
+	if err := dt.SelectAndReveal(5); !errors.Is(err, datatable.ErrInvalidRow) {
+		t.Errorf("SelectAndReveal(5) error = %v, want ErrInvalidRow", err)
+	}
+}
@@ -30,11 +30,15 @@ type SettingsDialog struct {
 
 	// UI components
 	filterBarCheck      *widget.Check
+	searchBoxCheck      *widget.Check
 	statusBarCheck      *widget.Check
+	statusDetailCheck   *widget.Check
 	columnSelectorCheck *widget.Check
 	autoAdjustCheck     *widget.Check
+	zebraStripeCheck    *widget.Check
 	selectionModeSelect *widget.RadioGroup
 	minWidthEntry       *widget.Entry
+	floatFormatEntry    *widget.Entry
 
 	dialog dialog.Dialog
 }
@@ -56,12 +60,21 @@ func (sd *SettingsDialog) buildDialog() {
 	sd.filterBarCheck = widget.NewCheck("Show Filter Bar", nil)
 	sd.filterBarCheck.Checked = sd.dataTable.config.ShowFilterBar
 
+	sd.searchBoxCheck = widget.NewCheck("Show Search Box", nil)
+	sd.searchBoxCheck.Checked = sd.dataTable.config.ShowSearchBox
+
 	sd.statusBarCheck = widget.NewCheck("Show Status Bar", nil)
 	sd.statusBarCheck.Checked = sd.dataTable.config.ShowStatusBar
 
+	sd.statusDetailCheck = widget.NewCheck("Show Filter and Sort in Status Bar", nil)
+	sd.statusDetailCheck.Checked = sd.dataTable.config.ShowStatusDetail
+
 	sd.columnSelectorCheck = widget.NewCheck("Show Column Selector", nil)
 	sd.columnSelectorCheck.Checked = sd.dataTable.config.ShowColumnSelector
 
+	sd.zebraStripeCheck = widget.NewCheck("Zebra Stripe Rows", nil)
+	sd.zebraStripeCheck.Checked = sd.dataTable.config.ZebraStripe
+
 	sd.autoAdjustCheck = widget.NewCheck("Auto-Adjust Column Widths", nil)
 	sd.autoAdjustCheck.Checked = sd.dataTable.config.AutoAdjustColumnWidths
 
@@ -82,12 +95,21 @@ func (sd *SettingsDialog) buildDialog() {
 	sd.minWidthEntry.SetPlaceHolder("100")
 	sd.minWidthEntry.SetText(formatInt(sd.dataTable.config.MinColumnWidth))
 
+	// Float display format entry (decimals or a format string)
+	sd.floatFormatEntry = widget.NewEntry()
+	sd.floatFormatEntry.SetPlaceHolder("As provided (e.g. 4 or %.3e)")
+	sd.floatFormatEntry.SetText(sd.dataTable.config.FloatFormat)
+
 	// Create form layout
 	formItems := []fyne.CanvasObject{
 		widget.NewLabel("Display Options:"),
 		sd.filterBarCheck,
+		sd.searchBoxCheck,
 		sd.statusBarCheck,
+		sd.statusDetailCheck,
 		sd.columnSelectorCheck,
+		sd.zebraStripeCheck,
+		container.NewBorder(nil, nil, widget.NewLabel("Float Format:"), nil, sd.floatFormatEntry),
 		widget.NewSeparator(),
 		widget.NewLabel("Column Options:"),
 		sd.autoAdjustCheck,
@@ -97,18 +119,16 @@ func (sd *SettingsDialog) buildDialog() {
 		sd.selectionModeSelect,
 	}
 
-	// Add Expression Editor button if callback is set
-	if sd.dataTable.expressionEditorHandler != nil {
+	// Add Expression Editor button if a handler is set or the source
+	// supports the built-in editor
+	if sd.dataTable.hasExpressionEditor() {
 		formItems = append(formItems,
 			widget.NewSeparator(),
 			widget.NewLabel("Data Editor:"),
 			widget.NewButton("Open Expression Editor", func() {
 				// Hide the settings dialog first
 				sd.dialog.Hide()
-				// Call the expression editor handler
-				if sd.dataTable.expressionEditorHandler != nil {
-					sd.dataTable.expressionEditorHandler()
-				}
+				sd.dataTable.openExpressionEditor()
 			}),
 		)
 	}
@@ -143,9 +163,12 @@ func (sd *SettingsDialog) applySettings() {
 	newConfig := sd.dataTable.config
 
 	newConfig.ShowFilterBar = sd.filterBarCheck.Checked
+	newConfig.ShowSearchBox = sd.searchBoxCheck.Checked
 	newConfig.ShowStatusBar = sd.statusBarCheck.Checked
+	newConfig.ShowStatusDetail = sd.statusDetailCheck.Checked
 	newConfig.ShowColumnSelector = sd.columnSelectorCheck.Checked
 	newConfig.AutoAdjustColumnWidths = sd.autoAdjustCheck.Checked
+	newConfig.ZebraStripe = sd.zebraStripeCheck.Checked
 
 	// Update selection mode
 	if sd.selectionModeSelect.Selected == "Row Selection (entire rows)" {
@@ -159,6 +182,11 @@ func (sd *SettingsDialog) applySettings() {
 		newConfig.MinColumnWidth = width
 	}
 
+	// Update float display format (invalid input keeps the current format)
+	if format, err := parseFloatFormat(sd.floatFormatEntry.Text); err == nil {
+		newConfig.FloatFormat = format
+	}
+
 	// Apply new configuration by reconfiguring the DataTable
 	sd.dataTable.Reconfigure(newConfig)
 }
@@ -15,13 +15,23 @@
 package widget
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/container"
 	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/datatable"
 )
 
+// statusProgressInterval is how often the status bar is redrawn while a
+// filter pass runs in the background.
+const statusProgressInterval = 250 * time.Millisecond
+
 // StatusBar displays information about the current table state.
 type StatusBar struct {
 	widget.BaseWidget
@@ -29,10 +39,31 @@ type StatusBar struct {
 	dataTable *DataTable
 
 	// UI components
-	rowCountLabel *widget.Label
-	filterLabel   *widget.Label
-	sortLabel     *widget.Label
-	container     *fyne.Container
+	statusLabel *widget.Label
+	container   *fyne.Container
+
+	// Schedules trackProgress redraws; nil uses real timers
+	progressClock clock
+
+	// Null count of the focused column, kept until the filters or the
+	// focused column change so selection clicks do not rescan the rows
+	nullCached     bool
+	nullGeneration uint64
+	nullCol        int
+	nullColName    string
+	nullCount      int
+}
+
+// statusInfo is the table state summarized by the status bar.
+type statusInfo struct {
+	filter        string // Active filter description (empty if none)
+	sortColumn    string // Sorted column name (empty if unsorted)
+	sortDirection datatable.SortDirection
+	visibleRows   int
+	totalRows     int
+	exact         bool   // False while visibleRows is still being counted
+	nullColumn    string // Selected column name (empty if none selected)
+	nullCount     int    // Nulls in nullColumn among the visible rows
 }
 
 // NewStatusBar creates a new status bar for the given DataTable.
@@ -50,61 +81,142 @@ func NewStatusBar(dt *DataTable) *StatusBar {
 
 // buildUI constructs the status bar's UI.
 func (sb *StatusBar) buildUI() {
-	sb.rowCountLabel = widget.NewLabel("")
-	sb.filterLabel = widget.NewLabel("")
-	sb.sortLabel = widget.NewLabel("")
-
-	sb.container = container.NewHBox(
-		sb.rowCountLabel,
-		widget.NewLabel("|"),
-		sb.filterLabel,
-		widget.NewLabel("|"),
-		sb.sortLabel,
-	)
+	sb.statusLabel = widget.NewLabel("")
+	sb.container = container.NewHBox(sb.statusLabel)
 }
 
 // Update updates the status bar's display.
 func (sb *StatusBar) Update() {
-	// Update row count
-	visibleRows := sb.dataTable.model.VisibleRowCount()
-	totalRows := sb.dataTable.model.OriginalRowCount()
-
-	if visibleRows == totalRows {
-		sb.rowCountLabel.SetText(fmt.Sprintf("Rows: %d", totalRows))
-	} else {
-		sb.rowCountLabel.SetText(fmt.Sprintf("Rows: %d of %d", visibleRows, totalRows))
+	sb.statusLabel.SetText(statusText(sb.currentStatus(), sb.dataTable.config.ShowStatusDetail))
+	sb.Refresh()
+}
+
+// trackProgress redraws the status bar every statusProgressInterval until
+// the returned stop function is called or ctx is done, so a filter pass
+// running off the UI goroutine shows its "counting… ~N" estimate as it
+// advances. No redraw is scheduled once stop has returned.
+func (sb *StatusBar) trackProgress(ctx context.Context) (stop func()) {
+	c := sb.progressClock
+	if c == nil {
+		c = realClock{}
 	}
 
-	// Update filter status
-	if sb.dataTable.model.IsFiltered() {
-		filters := sb.dataTable.model.GetActiveFilters()
-		if len(filters) > 0 {
-			sb.filterLabel.SetText(fmt.Sprintf("Filtered: %s", filters[0].Description()))
-		} else {
-			sb.filterLabel.SetText("Filtered: (columns hidden)")
+	var (
+		mu      sync.Mutex
+		stopped bool
+		pending timer
+	)
+	var tick func()
+	tick = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped || ctx.Err() != nil {
+			return
 		}
-	} else {
-		sb.filterLabel.SetText("No filter")
+		fyne.Do(sb.Update)
+		pending = c.AfterFunc(statusProgressInterval, tick)
 	}
 
-	// Update sort status
-	sortState := sb.dataTable.model.GetSortState()
-	if sortState.IsSorted() {
-		colName, err := sb.dataTable.model.VisibleColumnName(sortState.Column)
-		if err != nil {
-			sb.sortLabel.SetText("Sorted")
+	mu.Lock()
+	pending = c.AfterFunc(statusProgressInterval, tick)
+	mu.Unlock()
+
+	return func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+		pending.Stop()
+	}
+}
+
+// currentStatus reads the status bar's view of the table model.
+func (sb *StatusBar) currentStatus() statusInfo {
+	model := sb.dataTable.model
+
+	info := statusInfo{totalRows: model.OriginalRowCount()}
+	info.visibleRows, info.exact = model.EstimatedVisibleRowCount()
+
+	if filters := model.GetActiveFilters(); len(filters) > 0 {
+		info.filter = filters[0].Description()
+	}
+
+	if sortState := model.GetSortState(); sortState.IsSorted() {
+		info.sortDirection = sortState.Direction
+		if colName, err := model.VisibleColumnName(sortState.Column); err == nil {
+			info.sortColumn = colName
 		} else {
+			info.sortColumn = "?"
+		}
+	}
+
+	// Null counts need the final set of visible rows
+	if col := sb.dataTable.focusedCol; col >= 0 && info.exact {
+		if colName, err := model.VisibleColumnName(col); err == nil {
+			info.nullColumn = colName
+			info.nullCount = sb.cachedNullCount(col, colName)
+		}
+	}
+
+	return info
+}
+
+// cachedNullCount returns the number of nulls in visible column col, named
+// colName, among the visible rows. The count is recomputed only when the
+// filter generation or the column changes.
+func (sb *StatusBar) cachedNullCount(col int, colName string) int {
+	dt := sb.dataTable
+	dt.filterMu.Lock()
+	generation := dt.filterGeneration
+	dt.filterMu.Unlock()
+
+	if sb.nullCached && generation == sb.nullGeneration && col == sb.nullCol && colName == sb.nullColName {
+		return sb.nullCount
+	}
+
+	sb.nullCount = dt.model.NullCount(col)
+	sb.nullCached = true
+	sb.nullGeneration = generation
+	sb.nullCol = col
+	sb.nullColName = colName
+	return sb.nullCount
+}
+
+// statusText renders a status line such as
+// "Filter: age > 28 | Sort: Age ↓ | Age: 2 nulls | 3 of 20 rows". Without
+// detail only the row count is shown.
+func statusText(info statusInfo, detail bool) string {
+	parts := make([]string, 0, 4)
+
+	if detail {
+		if info.filter != "" {
+			parts = append(parts, "Filter: "+info.filter)
+		}
+		if info.sortColumn != "" {
 			direction := "↑"
-			if sortState.Direction == 2 { // SortDescending
+			if info.sortDirection == datatable.SortDescending {
 				direction = "↓"
 			}
-			sb.sortLabel.SetText(fmt.Sprintf("Sorted: %s %s", colName, direction))
+			parts = append(parts, fmt.Sprintf("Sort: %s %s", info.sortColumn, direction))
+		}
+		if info.nullColumn != "" {
+			noun := "nulls"
+			if info.nullCount == 1 {
+				noun = "null"
+			}
+			parts = append(parts, fmt.Sprintf("%s: %d %s", info.nullColumn, info.nullCount, noun))
 		}
-	} else {
-		sb.sortLabel.SetText("No sort")
 	}
 
-	sb.Refresh()
+	switch {
+	case !info.exact:
+		parts = append(parts, fmt.Sprintf("counting… ~%d of %d rows", info.visibleRows, info.totalRows))
+	case info.visibleRows == info.totalRows:
+		parts = append(parts, fmt.Sprintf("%d rows", info.totalRows))
+	default:
+		parts = append(parts, fmt.Sprintf("%d of %d rows", info.visibleRows, info.totalRows))
+	}
+
+	return strings.Join(parts, " | ")
 }
 
 // CreateRenderer returns the widget's renderer.
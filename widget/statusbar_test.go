@@ -0,0 +1,281 @@
+// Copyright 2025 Magnus Pierre
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package widget
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/magpierre/fyne-datatable/adapters/slice"
+	"github.com/magpierre/fyne-datatable/datatable"
+	"github.com/magpierre/fyne-datatable/datatable/datatabletest"
+	"github.com/magpierre/fyne-datatable/internal/filter"
+)
+
+func TestStatusText(t *testing.T) {
+	tests := []struct {
+		name   string
+		info   statusInfo
+		detail bool
+		want   string
+	}{
+		{
+			name:   "unfiltered",
+			info:   statusInfo{visibleRows: 20, totalRows: 20, exact: true},
+			detail: true,
+			want:   "20 rows",
+		},
+		{
+			name: "filter and sort",
+			info: statusInfo{
+				filter:        "age > 28",
+				sortColumn:    "Age",
+				sortDirection: datatable.SortDescending,
+				visibleRows:   3,
+				totalRows:     20,
+				exact:         true,
+			},
+			detail: true,
+			want:   "Filter: age > 28 | Sort: Age ↓ | 3 of 20 rows",
+		},
+		{
+			name:   "ascending sort only",
+			info:   statusInfo{sortColumn: "Name", sortDirection: datatable.SortAscending, visibleRows: 20, totalRows: 20, exact: true},
+			detail: true,
+			want:   "Sort: Name ↑ | 20 rows",
+		},
+		{
+			name:   "detail off",
+			info:   statusInfo{filter: "age > 28", sortColumn: "Age", visibleRows: 3, totalRows: 20, exact: true},
+			detail: false,
+			want:   "3 of 20 rows",
+		},
+		{
+			name:   "selected column nulls",
+			info:   statusInfo{nullColumn: "Age", nullCount: 2, visibleRows: 3, totalRows: 20, exact: true},
+			detail: true,
+			want:   "Age: 2 nulls | 3 of 20 rows",
+		},
+		{
+			name:   "single null, detail off",
+			info:   statusInfo{nullColumn: "Age", nullCount: 1, visibleRows: 20, totalRows: 20, exact: true},
+			detail: false,
+			want:   "20 rows",
+		},
+		{
+			name:   "still counting",
+			info:   statusInfo{filter: "age > 28", visibleRows: 7, totalRows: 1000},
+			detail: true,
+			want:   "Filter: age > 28 | counting… ~7 of 1000 rows",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusText(tt.info, tt.detail); got != tt.want {
+				t.Errorf("statusText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStatusBar_Update(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+
+	if err := dt.GlobalSearch("eng"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	if err := dt.SortByColumn(0, datatable.SortDescending); err != nil {
+		t.Fatalf("SortByColumn failed: %v", err)
+	}
+
+	want := `Filter: any column contains "eng" | Sort: Name ↓ | 3 of 5 rows`
+	if got := dt.statusBar.statusLabel.Text; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+
+	dt.config.ShowStatusDetail = false
+	dt.statusBar.Update()
+	if got, want := dt.statusBar.statusLabel.Text, "3 of 5 rows"; got != want {
+		t.Errorf("Status without ShowStatusDetail = %q, want %q", got, want)
+	}
+}
+
+func TestDefaultConfig_ShowStatusDetail(t *testing.T) {
+	if !DefaultConfig().ShowStatusDetail {
+		t.Error("Expected ShowStatusDetail to default to true")
+	}
+}
+
+func TestStatusBar_NullCount(t *testing.T) {
+	test.NewTempApp(t)
+
+	data := [][]any{
+		{"Alice", 30, "Engineering"},
+		{"Bob", nil, "Design"},
+		{"Charlie", nil, "Engineering"},
+		{"Dana", 41, "Engineering"},
+	}
+	source, err := slice.NewFromInterfaces(data, []string{"Name", "Age", "Department"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeCell
+	dt := NewDataTableWithConfig(model, config)
+
+	// Nothing is reported until a column is selected
+	if got := dt.statusBar.statusLabel.Text; got != "4 rows" {
+		t.Errorf("Status = %q, want %q", got, "4 rows")
+	}
+
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 1})
+	if got, want := dt.statusBar.statusLabel.Text, "Age: 2 nulls | 4 rows"; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+
+	if err := dt.GlobalSearch("design"); err != nil {
+		t.Fatalf("GlobalSearch failed: %v", err)
+	}
+	want := `Filter: any column contains "design" | Age: 1 null | 1 of 4 rows`
+	if got := dt.statusBar.statusLabel.Text; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+}
+
+func TestStatusBar_NullCount_RowMode(t *testing.T) {
+	test.NewTempApp(t)
+
+	data := [][]any{
+		{"Alice", 30},
+		{"Bob", nil},
+	}
+	source, err := slice.NewFromInterfaces(data, []string{"Name", "Age"})
+	if err != nil {
+		t.Fatalf("Failed to create data source: %v", err)
+	}
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeRow
+	dt := NewDataTableWithConfig(model, config)
+
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 1})
+	if got, want := dt.statusBar.statusLabel.Text, "Age: 1 null | 2 rows"; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+}
+
+func TestStatusBar_NullCount_Cached(t *testing.T) {
+	test.NewTempApp(t)
+
+	source := datatabletest.NewMutableMockSource(
+		[]datatable.ColumnSchema{
+			{Name: "Name", Type: datatable.TypeString},
+			{Name: "Age", Type: datatable.TypeInt},
+		},
+		[][]any{
+			{"Alice", int64(30)},
+			{"Bob", nil},
+		},
+	)
+	model, err := datatable.NewTableModel(source)
+	if err != nil {
+		t.Fatalf("Failed to create model: %v", err)
+	}
+	config := DefaultConfig()
+	config.SelectionMode = SelectionModeCell
+	dt := NewDataTableWithConfig(model, config)
+
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 1})
+	if got, want := dt.statusBar.statusLabel.Text, "Age: 1 null | 2 rows"; got != want {
+		t.Errorf("Status = %q, want %q", got, want)
+	}
+
+	// Selecting within the same column reuses the count
+	if err := source.SetCell(0, 1, datatable.NewNullValue(datatable.TypeInt)); err != nil {
+		t.Fatalf("SetCell failed: %v", err)
+	}
+	dt.table.Select(widget.TableCellID{Row: 1, Col: 1})
+	if got, want := dt.statusBar.statusLabel.Text, "Age: 1 null | 2 rows"; got != want {
+		t.Errorf("Status after selecting in the same column = %q, want cached %q", got, want)
+	}
+
+	// Filtering recounts
+	if err := dt.SetFilter(&filter.SimpleFilter{Column: "Name", Operator: filter.OpIsNotNull}); err != nil {
+		t.Fatalf("SetFilter failed: %v", err)
+	}
+	dt.table.Select(widget.TableCellID{Row: 0, Col: 1})
+	if got, want := dt.statusBar.statusLabel.Text, "Age: 2 nulls"; !strings.Contains(got, want) {
+		t.Errorf("Status after filtering = %q, want it to contain %q", got, want)
+	}
+}
+
+// gateFilter passes every row and blocks on the third until released.
+type gateFilter struct {
+	calls   int
+	reached chan struct{}
+	release chan struct{}
+}
+
+func (f *gateFilter) Evaluate(row []datatable.Value, columnNames []string) (bool, error) {
+	f.calls++
+	if f.calls == 3 {
+		close(f.reached)
+		<-f.release
+	}
+	return true, nil
+}
+
+func (f *gateFilter) Description() string {
+	return "gate"
+}
+
+func TestStatusBar_ShowsFilterProgress(t *testing.T) {
+	dt := newEmployeeTestTable(t, DefaultConfig())
+
+	clk := &fakeClock{}
+	dt.statusBar.progressClock = clk
+
+	f := &gateFilter{reached: make(chan struct{}), release: make(chan struct{})}
+	done := make(chan error)
+	go func() {
+		done <- dt.applyUserFilter(context.Background(), f)
+	}()
+	<-f.reached
+
+	// Redraw the status bar while the pass is blocked midway
+	clk.fireAll()
+	text := dt.statusBar.statusLabel.Text
+
+	close(f.release)
+	if err := <-done; err != nil {
+		t.Fatalf("applyUserFilter failed: %v", err)
+	}
+
+	if want := "counting… ~5 of 5 rows"; !strings.Contains(text, want) {
+		t.Errorf("Status during the pass = %q, want it to contain %q", text, want)
+	}
+}